@@ -0,0 +1,55 @@
+package enigma
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/goccy/go-json"
+)
+
+// Call invokes a method with positional params on a remote object and decodes the result into T. It is meant for
+// methods missing from the generated API, for instance of newer engines or extensions, and goes through the same
+// interceptors. Results with a single property, such as {"qReturn": ...}, are unwrapped so that T is the type of the
+// property. Use the RemoteObject field of generated types, for instance doc.RemoteObject, as object.
+func Call[T any](ctx context.Context, object *RemoteObject, method string, params ...interface{}) (T, error) {
+	return call[T](ctx, &Invocation{RemoteObject: object, Method: method, Params: ensureAllEncodable(params)})
+}
+
+// CallNamed works like Call but sends the params by name, as a JSON object, for instance
+// map[string]interface{}{"qId": "sheet"}
+func CallNamed[T any](ctx context.Context, object *RemoteObject, method string, params map[string]interface{}) (T, error) {
+	namedParams := make(map[string]interface{}, len(params))
+	for name, param := range params {
+		namedParams[name] = ensureEncodable(param)
+	}
+	return call[T](ctx, &Invocation{RemoteObject: object, Method: method, NamedParams: namedParams})
+}
+
+func call[T any](ctx context.Context, invocation *Invocation) (T, error) {
+	var result T
+	if invocation.RemoteObject == nil || invocation.RemoteObject.session == nil {
+		return result, errors.New("cannot call " + invocation.Method + " on an object that is not connected to a session")
+	}
+	response := invocation.RemoteObject.interceptorChain(ctx, invocation)
+	if response.Error != nil || len(response.Result) == 0 {
+		return result, response.Error
+	}
+	return result, json.Unmarshal(unwrapResult(response.Result), &result)
+}
+
+// unwrapResult returns the value of the only property of a result object, or the result itself if it has none or
+// several properties
+func unwrapResult(result json.RawMessage) json.RawMessage {
+	if !bytes.HasPrefix(bytes.TrimSpace(result), []byte("{")) {
+		return result
+	}
+	properties := map[string]json.RawMessage{}
+	if err := json.Unmarshal(result, &properties); err != nil || len(properties) != 1 {
+		return result
+	}
+	for _, value := range properties {
+		return value
+	}
+	return result
+}
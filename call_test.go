@@ -0,0 +1,68 @@
+package enigma
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCall(t *testing.T) {
+	ctx := context.Background()
+	var sentParams []string
+	fake := NewFakeEngine().
+		On("Global", "OpenDoc", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qReturn": call.NewObject("Doc", "", "app")}, nil
+		}).
+		On("Doc", "GetCustomInfo", func(call *FakeCall) (interface{}, error) {
+			sentParams = append(sentParams, string(call.Params))
+			return map[string]interface{}{"qInfo": map[string]interface{}{"qId": "custom", "qType": "info"}}, nil
+		}).
+		On("Doc", "GetCustomPair", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qFirst": 1, "qSecond": 2}, nil
+		})
+	var invocations []*Invocation
+	recorder := func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+		invocations = append(invocations, invocation)
+		return next(ctx, invocation)
+	}
+	global, err := Dialer{CreateSocket: fake.CreateSocket, Interceptors: []Interceptor{recorder}}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+
+	// Single property results are unwrapped
+	info, err := Call[*NxInfo](ctx, doc.RemoteObject, "GetCustomInfo", "a", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &NxInfo{Id: "custom", Type: "info"}, info)
+
+	info, err = CallNamed[*NxInfo](ctx, doc.RemoteObject, "GetCustomInfo", map[string]interface{}{"qId": "a", "qRaw": []byte(`{"b":true}`)})
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", info.Id)
+	assert.Equal(t, []string{`["a",1]`, `{"qId":"a","qRaw":{"b":true}}`}, sentParams)
+
+	// Results with several properties are decoded as a whole
+	pair, err := Call[map[string]int](ctx, doc.RemoteObject, "GetCustomPair")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"qFirst": 1, "qSecond": 2}, pair)
+
+	_, err = Call[*NxInfo](ctx, doc.RemoteObject, "Unknown")
+	assert.EqualError(t, err, "Doc.Unknown: Method not found (-32601 JSON RPC METHOD NOT FOUND)")
+
+	// All calls go through the interceptors
+	assert.Len(t, invocations, 5)
+	assert.Equal(t, "GetCustomInfo", invocations[2].Method)
+	assert.Nil(t, invocations[2].Params)
+	key, err := invocationCacheKey(invocations[2])
+	assert.NoError(t, err)
+	assert.Equal(t, `GetCustomInfo{"qId":"a","qRaw":{"b":true}}`, key)
+
+	// Objects without a session fail instead of panicking
+	_, err = Call[*NxInfo](ctx, nil, "GetCustomInfo")
+	assert.EqualError(t, err, "cannot call GetCustomInfo on an object that is not connected to a session")
+	_, err = CallNamed[*NxInfo](ctx, (&Doc{}).RemoteObject, "GetCustomInfo", nil)
+	assert.EqualError(t, err, "cannot call GetCustomInfo on an object that is not connected to a session")
+	_, err = Call[*NxInfo](ctx, &RemoteObject{}, "GetCustomInfo")
+	assert.Error(t, err)
+}
@@ -0,0 +1,197 @@
+package enigma
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+)
+
+type (
+	// EngineSchema lists the methods of a version of the QIX schema keyed by object type and method name, for instance
+	// "Doc.GetObject". Generated packages register their schema with RegisterEngineSchema.
+	EngineSchema struct {
+		Version string
+		Methods map[string]bool
+	}
+
+	// Capabilities tells which methods are available on a Qlik Associative Engine based on its version and the
+	// registered schemas
+	Capabilities struct {
+		// EngineVersion is the component version reported by the engine
+		EngineVersion string
+		// Schema is the registered schema best matching the engine version: the newest one not newer than the engine,
+		// or the oldest one if the engine is older than all of them. It is nil if no schema is registered.
+		Schema *EngineSchema
+		known  map[string]bool
+	}
+
+	// UnsupportedMethodError is returned for calls to methods that the connected engine does not have
+	UnsupportedMethodError struct {
+		ObjectType    string
+		Method        string
+		EngineVersion string
+		SchemaVersion string
+	}
+)
+
+var (
+	engineSchemasMutex sync.Mutex
+	engineSchemas      []*EngineSchema
+)
+
+func (err *UnsupportedMethodError) Error() string {
+	return fmt.Sprintf("%s.%s is not supported by Qlik Associative Engine %s (schema %s)", err.ObjectType, err.Method, err.EngineVersion, err.SchemaVersion)
+}
+
+// RegisterEngineSchema adds a schema version used to decide which methods an engine has. Registering a version
+// again replaces the methods.
+func RegisterEngineSchema(version string, methods map[string]bool) {
+	engineSchemasMutex.Lock()
+	defer engineSchemasMutex.Unlock()
+	for _, schema := range engineSchemas {
+		if schema.Version == version {
+			schema.Methods = methods
+			return
+		}
+	}
+	engineSchemas = append(engineSchemas, &EngineSchema{Version: version, Methods: methods})
+	sort.Slice(engineSchemas, func(i, j int) bool {
+		return compareVersions(engineSchemas[i].Version, engineSchemas[j].Version) < 0
+	})
+}
+
+// RegisteredEngineSchemas returns the registered schemas, oldest first
+func RegisteredEngineSchemas() []*EngineSchema {
+	engineSchemasMutex.Lock()
+	defer engineSchemasMutex.Unlock()
+	return append([]*EngineSchema(nil), engineSchemas...)
+}
+
+// compareVersions compares dot separated versions such as 12.1306.0 part by part, numerically when possible.
+// Missing parts count as 0.
+func compareVersions(a string, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		partA, partB := "0", "0"
+		if i < len(partsA) {
+			partA = partsA[i]
+		}
+		if i < len(partsB) {
+			partB = partsB[i]
+		}
+		numberA, errA := strconv.Atoi(partA)
+		numberB, errB := strconv.Atoi(partB)
+		switch {
+		case errA == nil && errB == nil && numberA != numberB:
+			if numberA < numberB {
+				return -1
+			}
+			return 1
+		case (errA != nil || errB != nil) && partA != partB:
+			return strings.Compare(partA, partB)
+		}
+	}
+	return 0
+}
+
+// CapabilitiesFor returns the capabilities of an engine with the given component version
+func CapabilitiesFor(engineVersion string) *Capabilities {
+	schemas := RegisteredEngineSchemas()
+	capabilities := &Capabilities{EngineVersion: engineVersion, known: map[string]bool{}}
+	for _, schema := range schemas {
+		for method := range schema.Methods {
+			capabilities.known[method] = true
+		}
+		if capabilities.Schema == nil || compareVersions(schema.Version, engineVersion) <= 0 {
+			capabilities.Schema = schema
+		}
+	}
+	return capabilities
+}
+
+// NegotiateCapabilities asks the engine for its version and returns its capabilities
+func NegotiateCapabilities(ctx context.Context, global *Global) (*Capabilities, error) {
+	version, err := global.EngineVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return CapabilitiesFor(version.ComponentVersion), nil
+}
+
+// Supports tells if the engine has the method on objects of the given type, for instance "Doc" and "GetObject".
+// Methods not found in any registered schema, such as methods of extension APIs, are assumed to be supported.
+func (c *Capabilities) Supports(objectType string, method string) bool {
+	key := objectType + "." + method
+	if c.Schema == nil || !c.known[key] {
+		return true
+	}
+	return c.Schema.Methods[key]
+}
+
+// Intercept implements the Interceptor function type, calls to unsupported methods fail with an *UnsupportedMethodError
+// without being sent to the engine
+func (c *Capabilities) Intercept(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+	if err := c.check(invocation); err != nil {
+		return &InvocationResponse{Error: err}
+	}
+	return next(ctx, invocation)
+}
+
+func (c *Capabilities) check(invocation *Invocation) error {
+	if invocation.RemoteObject == nil || invocation.RemoteObject.ObjectInterface == nil {
+		return nil
+	}
+	if c.Supports(invocation.RemoteObject.Type, invocation.Method) {
+		return nil
+	}
+	return &UnsupportedMethodError{ObjectType: invocation.RemoteObject.Type, Method: invocation.Method, EngineVersion: c.EngineVersion, SchemaVersion: c.Schema.Version}
+}
+
+// NewCapabilityInterceptor creates an interceptor that negotiates the capabilities of the engine with an EngineVersion
+// call on the first invocation of each session. Calls to methods that the engine does not have then fail with an
+// *UnsupportedMethodError instead of being sent. If the negotiation fails the invocation is sent anyway.
+func NewCapabilityInterceptor() Interceptor {
+	var mutex sync.Mutex
+	sessions := map[*session]*Capabilities{}
+	return func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+		remoteObject := invocation.RemoteObject
+		if remoteObject == nil || remoteObject.session == nil || remoteObject.ObjectInterface == nil {
+			return next(ctx, invocation)
+		}
+		mutex.Lock()
+		capabilities := sessions[remoteObject.session]
+		mutex.Unlock()
+		if capabilities == nil {
+			global := remoteObject.session.getRemoteObject(&ObjectInterface{Handle: -1, Type: "Global"})
+			response := next(ctx, &Invocation{RemoteObject: global, Method: "EngineVersion"})
+			if response.Error != nil {
+				return next(ctx, invocation)
+			}
+			result := &struct {
+				Version NxEngineVersion `json:"qVersion"`
+			}{}
+			if err := json.Unmarshal(response.Result, result); err != nil {
+				return next(ctx, invocation)
+			}
+			capabilities = CapabilitiesFor(result.Version.ComponentVersion)
+			mutex.Lock()
+			if sessions[remoteObject.session] == nil {
+				sessions[remoteObject.session] = capabilities
+				go func(s *session) {
+					<-s.Disconnected()
+					mutex.Lock()
+					delete(sessions, s)
+					mutex.Unlock()
+				}(remoteObject.session)
+			}
+			mutex.Unlock()
+		}
+		return capabilities.Intercept(ctx, invocation, next)
+	}
+}
@@ -0,0 +1,136 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// registerOlderSchema registers, for the duration of the test, a schema older than the generated one that lacks the
+// given methods
+func registerOlderSchema(t *testing.T, version string, missing ...string) {
+	engineSchemasMutex.Lock()
+	saved := append([]*EngineSchema(nil), engineSchemas...)
+	engineSchemasMutex.Unlock()
+	t.Cleanup(func() {
+		engineSchemasMutex.Lock()
+		engineSchemas = saved
+		engineSchemasMutex.Unlock()
+	})
+	methods := map[string]bool{}
+	for method := range SchemaMethods {
+		methods[method] = true
+	}
+	for _, method := range missing {
+		assert.True(t, methods[method], method)
+		delete(methods, method)
+	}
+	RegisterEngineSchema(version, methods)
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert.Equal(t, 0, compareVersions("12.1306.0", "12.1306.0"))
+	assert.Equal(t, -1, compareVersions("12.999.0", "12.1306.0"))
+	assert.Equal(t, 1, compareVersions("13.0", "12.1306.0"))
+	assert.Equal(t, -1, compareVersions("12.1306", "12.1306.1"))
+	assert.Equal(t, 0, compareVersions("12.1306", "12.1306.0"))
+}
+
+func TestRegisteredEngineSchemas(t *testing.T) {
+	schemas := RegisteredEngineSchemas()
+	assert.Len(t, schemas, 1)
+	assert.Equal(t, QIX_SCHEMA_VERSION, schemas[0].Version)
+	assert.Equal(t, SchemaMethods, schemas[0].Methods)
+	assert.True(t, SchemaMethods["Doc.GetObject"])
+
+	for _, engineVersion := range []string{"12.1306.0", QIX_SCHEMA_VERSION, "99.0.0"} {
+		capabilities := CapabilitiesFor(engineVersion)
+		assert.Same(t, schemas[0], capabilities.Schema)
+		for method := range SchemaMethods {
+			objectType, name, _ := strings.Cut(method, ".")
+			assert.True(t, capabilities.Supports(objectType, name), method)
+		}
+	}
+}
+
+func TestCapabilitiesFor(t *testing.T) {
+	registerOlderSchema(t, "12.1306.0", "Doc.CommitScript", "Doc.GetTableProfileData")
+	schemas := RegisteredEngineSchemas()
+	assert.Equal(t, "12.1306.0", schemas[0].Version)
+	assert.Equal(t, QIX_SCHEMA_VERSION, schemas[len(schemas)-1].Version)
+
+	assert.Equal(t, "12.1306.0", CapabilitiesFor("12.1000.0").Schema.Version)
+	assert.Equal(t, "12.1306.0", CapabilitiesFor("12.1500.0").Schema.Version)
+	assert.Equal(t, QIX_SCHEMA_VERSION, CapabilitiesFor(QIX_SCHEMA_VERSION).Schema.Version)
+	assert.Equal(t, QIX_SCHEMA_VERSION, CapabilitiesFor("99.0.0").Schema.Version)
+
+	capabilities := CapabilitiesFor("12.1500.0")
+	assert.True(t, capabilities.Supports("Doc", "GetObject"))
+	assert.False(t, capabilities.Supports("Doc", "CommitScript"))
+	assert.False(t, capabilities.Supports("Doc", "GetTableProfileData"))
+	// Methods unknown to all schemas are assumed to exist
+	assert.True(t, capabilities.Supports("Global", "MyExtensionMethod"))
+	assert.True(t, CapabilitiesFor(QIX_SCHEMA_VERSION).Supports("Doc", "CommitScript"))
+}
+
+func TestCapabilityInterceptor(t *testing.T) {
+	registerOlderSchema(t, "12.1306.0", "Doc.CommitScript", "Doc.GetTableProfileData")
+	ctx := context.Background()
+	var versionCalls int32
+	fake := NewFakeEngine().
+		On("Global", "EngineVersion", func(call *FakeCall) (interface{}, error) {
+			atomic.AddInt32(&versionCalls, 1)
+			return map[string]interface{}{"qVersion": map[string]interface{}{"qComponentVersion": "12.1500.0"}}, nil
+		}).
+		On("Global", "OpenDoc", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qReturn": call.NewObject("Doc", "", "app")}, nil
+		}).
+		On("Doc", "GetSetAnalysis", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qSetExpression": "{1}"}, nil
+		})
+	global, err := Dialer{CreateSocket: fake.CreateSocket, Interceptors: []Interceptor{NewCapabilityInterceptor()}}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+	assert.NotNil(t, doc)
+	setExpression, err := doc.GetSetAnalysis(ctx, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "{1}", setExpression)
+
+	// The methods are experimental, RPC keeps the test building with the enigma_no_experimental tag
+	err = doc.RPC(ctx, "CommitScript", nil, "message")
+	unsupported := &UnsupportedMethodError{}
+	assert.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, &UnsupportedMethodError{ObjectType: "Doc", Method: "CommitScript", EngineVersion: "12.1500.0", SchemaVersion: "12.1306.0"}, unsupported)
+	assert.Equal(t, "Doc.CommitScript is not supported by Qlik Associative Engine 12.1500.0 (schema 12.1306.0)", err.Error())
+
+	err = doc.RPC(ctx, "GetTableProfileData", nil, "table")
+	assert.True(t, errors.As(err, &unsupported))
+
+	version, err := global.EngineVersion(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "12.1500.0", version.ComponentVersion)
+	// The version is asked for once per session
+	assert.Equal(t, int32(2), atomic.LoadInt32(&versionCalls))
+}
+
+func TestNegotiateCapabilities(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeEngine().On("Global", "EngineVersion", func(call *FakeCall) (interface{}, error) {
+		return map[string]interface{}{"qVersion": map[string]interface{}{"qComponentVersion": QIX_SCHEMA_VERSION}}, nil
+	})
+	global, err := Dialer{CreateSocket: fake.CreateSocket}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+
+	capabilities, err := NegotiateCapabilities(ctx, global)
+	assert.NoError(t, err)
+	assert.Equal(t, QIX_SCHEMA_VERSION, capabilities.EngineVersion)
+	assert.True(t, capabilities.Supports("Global", "GetActiveDoc"))
+}
@@ -0,0 +1,83 @@
+// Command enigma-traffic converts a traffic log written by enigma-go into a HAR file or a self-contained HTML timeline.
+//
+//	go run github.com/qlik-oss/enigma-go/v4/cmd/enigma-traffic -har traffic.har -html traffic.html traffic.jsonl
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+func main() {
+	harFile := flag.String("har", "", "write a HAR file to the given path")
+	htmlFile := flag.String("html", "", "write an HTML timeline to the given path")
+	handles := flag.String("handle", "", "comma separated list of handles to include")
+	methods := flag.String("method", "", "comma separated list of method patterns to include, for instance Get*")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <traffic log>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 || (*harFile == "" && *htmlFile == "") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0), *harFile, *htmlFile, *handles, *methods); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(logFile, harFile, htmlFile, handles, methods string) error {
+	timeline, err := enigma.ReadTrafficTimeline(logFile)
+	if err != nil {
+		return err
+	}
+	filter := enigma.TrafficTimelineFilter{}
+	for _, handle := range splitList(handles) {
+		h, err := strconv.Atoi(handle)
+		if err != nil {
+			return fmt.Errorf("invalid handle %q", handle)
+		}
+		filter.Handles = append(filter.Handles, h)
+	}
+	filter.Methods = splitList(methods)
+	timeline = timeline.Filter(filter)
+
+	if harFile != "" {
+		if err := writeFile(harFile, timeline.WriteHAR); err != nil {
+			return err
+		}
+	}
+	if htmlFile != "" {
+		if err := writeFile(htmlFile, timeline.WriteHTML); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitList(list string) []string {
+	if list == "" {
+		return nil
+	}
+	return strings.Split(list, ",")
+}
+
+func writeFile(fileName string, write func(w io.Writer) error) error {
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
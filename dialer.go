@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"net/http"
+	"strings"
 )
 
 type (
@@ -16,6 +17,9 @@ type (
 		Method string
 		// Params contains the function call parameters as provided in the top level API. Parameter types can be both primitives, structs and raw json (byte arrays) depending on what api level function is used.
 		Params []interface{}
+		// NamedParams contains the parameters by name for methods invoked with named parameters, see CallNamed. They are sent as
+		// a JSON object instead of Params.
+		NamedParams map[string]interface{}
 	}
 
 	// InvocationResponse represents a QIX engine response message
@@ -59,17 +63,28 @@ type (
 		// An array of interceptors that can be used to inject behaviour in the call chain
 		Interceptors []Interceptor
 
+		// An array of interceptors that can be used to inject behaviour in the whole session life cycle including dialing,
+		// inbound notifications and change lists and closing of the socket
+		SessionInterceptors []SessionInterceptor
+
 		// An optional traffic logger. Note that this can not be used in conjunction with the TrafficDumpFile parameter.
 		TrafficLogger TrafficLogger
 
 		// Specifies the path to a protocol traffic log file. When the MockMode parameter is set to false the a traffic logger writes the traffic to the specified file.
+		// File names ending with .jsonl or .jsonl.gz are written in the streaming JSON Lines format, see StreamingTrafficLogger, other files as a single JSON array.
 		// If MockMode is set to true the requests and responses recorded in the log file are used to respond to QIX API calls - in effect replaying a previously recorded scenario.
 		TrafficDumpFile string
 
+		// Optional redaction and method filtering applied to the traffic logged to TrafficLogger or TrafficDumpFile.
+		TrafficLogRedaction *RedactionOptions
+
 		// When set to true a mock socket replaying previously recorded traffic is used instead of a real one.
 		// TrafficDumpFile specified what log file to use.
 		MockMode bool
 
+		// Configures how the mock socket matches requests against the recorded traffic when MockMode is set to true.
+		MockSocketOptions MockSocketOptions
+
 		// Jar specifies the cookie jar.
 		// If Jar is nil, cookies are not sent in requests and ignored
 		// in responses.
@@ -77,6 +92,17 @@ type (
 	}
 )
 
+// params returns the params to send, NamedParams if set and otherwise Params
+func (invocation *Invocation) params() interface{} {
+	if invocation.NamedParams != nil {
+		return invocation.NamedParams
+	}
+	if invocation.Params == nil {
+		return []interface{}{}
+	}
+	return invocation.Params
+}
+
 // DialRaw establishes a connection to Qlik Associative Engine using the settings set in the Dialer.
 // The returned remote object points to the Global object of the session with handle -1.
 // DialRaw can be used with custom specifications by wrapping the returned RemoteObject in a generated schema type like so:
@@ -91,7 +117,7 @@ func (dialer Dialer) DialRaw(ctx context.Context, url string, httpHeader http.He
 
 	if dialer.MockMode {
 		dialer.CreateSocket = func(ctx context.Context, url string, httpHeader http.Header) (Socket, error) {
-			socket, err := NewMockSocket(dialer.TrafficDumpFile)
+			socket, err := NewMockSocketWithOptions(dialer.TrafficDumpFile, dialer.MockSocketOptions)
 			return socket, err
 		}
 	} else {
@@ -99,9 +125,22 @@ func (dialer Dialer) DialRaw(ctx context.Context, url string, httpHeader http.He
 		if dialer.CreateSocket == nil {
 			setupDefaultDialer(&dialer)
 		}
-		if dialer.TrafficDumpFile != "" {
+		if isStreamingTrafficLogFile(dialer.TrafficDumpFile) {
+			trafficLogger, err := NewStreamingTrafficLogger(StreamingTrafficLogOptions{FileName: dialer.TrafficDumpFile, Gzip: strings.HasSuffix(dialer.TrafficDumpFile, ".gz")})
+			if err != nil {
+				return nil, err
+			}
+			dialer.TrafficLogger = trafficLogger
+		} else if dialer.TrafficDumpFile != "" {
 			dialer.TrafficLogger = newFileTrafficLogger(dialer.TrafficDumpFile)
 		}
+		if dialer.TrafficLogger != nil && dialer.TrafficLogRedaction != nil {
+			trafficLogger, err := NewRedactingTrafficLogger(dialer.TrafficLogger, *dialer.TrafficLogRedaction)
+			if err != nil {
+				return nil, err
+			}
+			dialer.TrafficLogger = trafficLogger
+		}
 	}
 
 	enigmaSession := newSession(&dialer)
@@ -19,3 +19,5 @@
 // See the example below for an illustration of how it may look. For more detail examples look at
 // the examples in https://github.com/qlik-oss/enigma-go/tree/master/examples. See respective README.md file for further information
 package enigma
+
+//go:generate go run ./schema -schema ./schema/engine-rpc.json -companion ./schema/schema-companion.json -out ./qix_generated.go -enigma-import= -mocks ./enigmamock/qix_mocks_generated.go -build-tags experimental,stable,deprecated,raw -split-objects
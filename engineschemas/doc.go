@@ -0,0 +1,9 @@
+// Package engineschemas is the place for packages registering the methods of older versions of Qlik Associative Engine,
+// one per version. No older versions are bundled yet: enigma-go only registers the methods of the schema it is generated
+// from, so engines older than that are assumed to have all of them.
+//
+// A package for a version is generated from the OpenRPC specification of that version, as served by the engine on
+// /api/engine/openrpc, with the -methods-only option of the generator, and registers its methods when imported:
+//
+//	go run ./schema -methods-only -schema <spec.json> -out ./engineschemas/<package>/qix_methods_generated.go -package <package>
+package engineschemas
@@ -0,0 +1,298 @@
+package enginetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+// Fault is a failure injected by a FaultSocket
+type Fault int
+
+const (
+	// NoFault passes the frame through unchanged
+	NoFault Fault = iota
+	// DelayRead delays a received frame by FaultOptions.ReadDelay
+	DelayRead
+	// DelayWrite delays a sent frame by FaultOptions.WriteDelay
+	DelayWrite
+	// DropFrame silently discards a received frame, or a sent frame when scheduled in FaultOptions.WriteSchedule
+	DropFrame
+	// DuplicateFrame delivers a received frame twice, or sends a frame twice when scheduled in FaultOptions.WriteSchedule
+	DuplicateFrame
+	// TruncateFrame delivers the first half of a received frame, leaving invalid JSON
+	TruncateFrame
+	// Disconnect closes the wrapped socket instead of delivering a received frame
+	Disconnect
+	// ReorderFrame delivers a received frame after the frame following it. The following frame gets its own fault, so
+	// it can for instance be dropped or disconnect, which also discards the held frame. If no frame follows within
+	// FaultOptions.ReorderTimeout the held frame is delivered on its own.
+	ReorderFrame
+)
+
+var faultNames = map[Fault]string{
+	NoFault:        "NoFault",
+	DelayRead:      "DelayRead",
+	DelayWrite:     "DelayWrite",
+	DropFrame:      "DropFrame",
+	DuplicateFrame: "DuplicateFrame",
+	TruncateFrame:  "TruncateFrame",
+	Disconnect:     "Disconnect",
+	ReorderFrame:   "ReorderFrame",
+}
+
+// String returns the name of the fault
+func (f Fault) String() string {
+	return faultNames[f]
+}
+
+// ErrInjectedDisconnect is returned by ReadMessage when a Disconnect fault has been injected
+var ErrInjectedDisconnect = errors.New("enginetest: injected disconnect")
+
+type (
+	// FaultOptions configures the failures injected by a FaultSocket. Probabilities are per frame and between 0 and 1.
+	// All random decisions are made from a source seeded with Seed so a given sequence of frames always gets the same faults.
+	FaultOptions struct {
+		// Seed seeds the random decisions
+		Seed int64
+
+		// ReadDelayProbability is the probability of DelayRead for a received frame
+		ReadDelayProbability float64
+		// ReadDelay is how long DelayRead holds a received frame
+		ReadDelay time.Duration
+		// WriteDelayProbability is the probability of DelayWrite for a sent frame
+		WriteDelayProbability float64
+		// WriteDelay is how long DelayWrite holds a sent frame
+		WriteDelay time.Duration
+		// DropProbability is the probability of DropFrame for a received frame
+		DropProbability float64
+		// DuplicateProbability is the probability of DuplicateFrame for a received frame
+		DuplicateProbability float64
+		// TruncateProbability is the probability of TruncateFrame for a received frame
+		TruncateProbability float64
+		// DisconnectProbability is the probability of Disconnect for a received frame
+		DisconnectProbability float64
+		// ReorderProbability is the probability of ReorderFrame for a received frame
+		ReorderProbability float64
+		// ReorderTimeout is how long a frame held by ReorderFrame waits for the next one, 100 milliseconds if zero.
+		// Without it a single pending call would never get its response.
+		ReorderTimeout time.Duration
+
+		// ReadSchedule injects faults at given received frames, counted from 1, regardless of the probabilities
+		ReadSchedule map[int]Fault
+		// WriteSchedule injects DelayWrite, DropFrame or DuplicateFrame at given sent frames, counted from 1, regardless
+		// of the probabilities. NoFault sends the frame unchanged and other faults make WriteMessage fail.
+		WriteSchedule map[int]Fault
+	}
+
+	// InjectedFault records a fault injected by a FaultSocket
+	InjectedFault struct {
+		// Frame is the number of the received or sent frame, counted from 1
+		Frame int
+		Fault Fault
+	}
+
+	readResult struct {
+		messageType int
+		message     []byte
+		err         error
+	}
+
+	// FaultSocket is an enigma.Socket decorator that injects failures into the frames of the wrapped socket
+	FaultSocket struct {
+		socket      enigma.Socket
+		options     FaultOptions
+		readRand    *rand.Rand
+		writeRand   *rand.Rand
+		readFrames  int
+		writeFrames int
+		queued      [][]byte
+		queuedErr   error
+		held        [][]byte
+		pulled      *readResult
+		pendingRead chan readResult
+		mutex       sync.Mutex
+		injected    []InjectedFault
+	}
+)
+
+// NewFaultSocket wraps a socket
+func NewFaultSocket(socket enigma.Socket, options FaultOptions) *FaultSocket {
+	return &FaultSocket{
+		socket:    socket,
+		options:   options,
+		readRand:  rand.New(rand.NewSource(options.Seed)),
+		writeRand: rand.New(rand.NewSource(options.Seed + 1)),
+	}
+}
+
+// FaultInjection creates a session interceptor wrapping the socket of the session in a FaultSocket
+func FaultInjection(options FaultOptions) enigma.SessionInterceptor {
+	return enigma.SessionInterceptor{
+		Dial: func(ctx context.Context, url string, httpHeader http.Header, next enigma.DialContinuation) (enigma.Socket, error) {
+			socket, err := next(ctx, url, httpHeader)
+			if err != nil {
+				return nil, err
+			}
+			return NewFaultSocket(socket, options), nil
+		},
+	}
+}
+
+// Injected returns the faults injected so far
+func (f *FaultSocket) Injected() []InjectedFault {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]InjectedFault(nil), f.injected...)
+}
+
+func (f *FaultSocket) record(frame int, fault Fault) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.injected = append(f.injected, InjectedFault{Frame: frame, Fault: fault})
+}
+
+// nextReadFault draws one number per fault type so that the sequence of draws does not depend on the outcome
+func (f *FaultSocket) nextReadFault() Fault {
+	if fault, ok := f.options.ReadSchedule[f.readFrames]; ok {
+		return fault
+	}
+	candidates := []struct {
+		fault       Fault
+		probability float64
+	}{
+		{Disconnect, f.options.DisconnectProbability},
+		{DropFrame, f.options.DropProbability},
+		{TruncateFrame, f.options.TruncateProbability},
+		{DuplicateFrame, f.options.DuplicateProbability},
+		{ReorderFrame, f.options.ReorderProbability},
+		{DelayRead, f.options.ReadDelayProbability},
+	}
+	result := NoFault
+	for _, candidate := range candidates {
+		if f.readRand.Float64() < candidate.probability && result == NoFault {
+			result = candidate.fault
+		}
+	}
+	return result
+}
+
+// ReadMessage implements the enigma.Socket interface. It is not safe for concurrent use, just like the sockets it wraps.
+func (f *FaultSocket) ReadMessage() (int, []byte, error) {
+	if len(f.queued) > 0 {
+		message := f.queued[0]
+		f.queued = f.queued[1:]
+		return 1, message, nil
+	}
+	if f.queuedErr != nil {
+		return 0, nil, f.queuedErr
+	}
+	for {
+		messageType, message, err := f.readWrapped()
+		if err != nil {
+			return messageType, message, err
+		}
+		f.readFrames++
+		fault := f.nextReadFault()
+		if fault != NoFault {
+			f.record(f.readFrames, fault)
+		}
+		switch fault {
+		case DelayRead:
+			time.Sleep(f.options.ReadDelay)
+		case DropFrame:
+			continue
+		case DuplicateFrame:
+			f.queued = append(f.queued, append([]byte(nil), message...))
+		case TruncateFrame:
+			message = message[:len(message)/2]
+		case Disconnect:
+			f.held = nil
+			f.socket.Close()
+			return 0, nil, ErrInjectedDisconnect
+		case ReorderFrame:
+			timeout := f.options.ReorderTimeout
+			if timeout == 0 {
+				timeout = 100 * time.Millisecond
+			}
+			next := make(chan readResult, 1)
+			go func() {
+				messageType, message, err := f.socket.ReadMessage()
+				next <- readResult{messageType: messageType, message: message, err: err}
+			}()
+			select {
+			case result := <-next:
+				if result.err == nil {
+					// The following frame goes through fault selection like any other, the held frame is
+					// delivered after it
+					f.held = append(f.held, message)
+					f.pulled = &result
+					continue
+				}
+				f.queuedErr = result.err
+			case <-time.After(timeout):
+				f.pendingRead = next
+			}
+		}
+		f.queued = append(f.queued, f.held...)
+		f.held = nil
+		return messageType, message, nil
+	}
+}
+
+// readWrapped returns the frame read ahead by ReorderFrame, waits for a read started by ReorderFrame that timed out
+// or reads from the wrapped socket
+func (f *FaultSocket) readWrapped() (int, []byte, error) {
+	if f.pulled != nil {
+		result := f.pulled
+		f.pulled = nil
+		return result.messageType, result.message, result.err
+	}
+	if f.pendingRead != nil {
+		result := <-f.pendingRead
+		f.pendingRead = nil
+		return result.messageType, result.message, result.err
+	}
+	return f.socket.ReadMessage()
+}
+
+// WriteMessage implements the enigma.Socket interface
+func (f *FaultSocket) WriteMessage(messageType int, message []byte) error {
+	f.mutex.Lock()
+	f.writeFrames++
+	frame := f.writeFrames
+	fault, scheduled := f.options.WriteSchedule[frame]
+	if !scheduled && f.writeRand.Float64() < f.options.WriteDelayProbability {
+		fault = DelayWrite
+	}
+	f.mutex.Unlock()
+	switch fault {
+	case NoFault:
+		return f.socket.WriteMessage(messageType, message)
+	case DelayWrite:
+		f.record(frame, fault)
+		time.Sleep(f.options.WriteDelay)
+		return f.socket.WriteMessage(messageType, message)
+	case DropFrame:
+		f.record(frame, fault)
+		return nil
+	case DuplicateFrame:
+		f.record(frame, fault)
+		if err := f.socket.WriteMessage(messageType, message); err != nil {
+			return err
+		}
+		return f.socket.WriteMessage(messageType, message)
+	}
+	return fmt.Errorf("enginetest: %s cannot be injected into sent frame %d", fault, frame)
+}
+
+// Close implements the enigma.Socket interface
+func (f *FaultSocket) Close() error {
+	return f.socket.Close()
+}
@@ -0,0 +1,128 @@
+package enginetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type frameSocket struct {
+	frames  []string
+	written []string
+}
+
+func (s *frameSocket) ReadMessage() (int, []byte, error) {
+	if len(s.frames) == 0 {
+		return 0, nil, errors.New("no more frames")
+	}
+	frame := s.frames[0]
+	s.frames = s.frames[1:]
+	return 1, []byte(frame), nil
+}
+
+func (s *frameSocket) WriteMessage(messageType int, message []byte) error {
+	s.written = append(s.written, string(message))
+	return nil
+}
+
+func (s *frameSocket) Close() error {
+	return nil
+}
+
+func readFrames(t *testing.T, socket *FaultSocket, count int) []string {
+	result := []string{}
+	for i := 0; i < count; i++ {
+		_, message, err := socket.ReadMessage()
+		if err != nil {
+			result = append(result, err.Error())
+			break
+		}
+		result = append(result, string(message))
+	}
+	return result
+}
+
+func TestFaultSocketSchedule(t *testing.T) {
+	engine := enigma.NewFakeEngine()
+	socket := NewFaultSocket(engine, FaultOptions{ReadSchedule: map[int]Fault{1: DropFrame, 2: DuplicateFrame, 3: ReorderFrame, 5: TruncateFrame, 6: Disconnect}})
+	for i := 1; i <= 6; i++ {
+		engine.Notify(fmt.Sprint("N", i), nil)
+	}
+	frames := readFrames(t, socket, 7)
+	assert.Equal(t, []string{
+		`{"jsonrpc":"2.0","method":"N2"}`,
+		`{"jsonrpc":"2.0","method":"N2"}`,
+		`{"jsonrpc":"2.0","method":"N4"}`,
+		`{"jsonrpc":"2.0","method":"N3"}`,
+		`{"jsonrpc":"2.0`,
+		ErrInjectedDisconnect.Error(),
+	}, frames)
+	assert.Equal(t, []InjectedFault{{1, DropFrame}, {2, DuplicateFrame}, {3, ReorderFrame}, {5, TruncateFrame}, {6, Disconnect}}, socket.Injected())
+}
+
+func TestFaultSocketWriteSchedule(t *testing.T) {
+	wrapped := &frameSocket{}
+	socket := NewFaultSocket(wrapped, FaultOptions{WriteSchedule: map[int]Fault{1: DropFrame, 2: DuplicateFrame, 3: NoFault, 4: TruncateFrame}, WriteDelayProbability: 1})
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, socket.WriteMessage(1, []byte(fmt.Sprint("W", i))))
+	}
+	assert.EqualError(t, socket.WriteMessage(1, []byte("W4")), "enginetest: TruncateFrame cannot be injected into sent frame 4")
+	assert.Equal(t, []string{"W2", "W2", "W3"}, wrapped.written)
+	assert.Equal(t, []InjectedFault{{1, DropFrame}, {2, DuplicateFrame}}, socket.Injected())
+}
+
+func TestFaultSocketReorderedFrameGetsItsOwnFault(t *testing.T) {
+	frames := &frameSocket{frames: []string{"N1", "N2", "N3", "N4", "N5"}}
+	socket := NewFaultSocket(frames, FaultOptions{ReadSchedule: map[int]Fault{1: ReorderFrame, 2: DropFrame, 4: ReorderFrame, 5: Disconnect}})
+	assert.Equal(t, []string{"N3", "N1", ErrInjectedDisconnect.Error()}, readFrames(t, socket, 3))
+	assert.Equal(t, []InjectedFault{{1, ReorderFrame}, {2, DropFrame}, {4, ReorderFrame}, {5, Disconnect}}, socket.Injected())
+}
+
+func TestFaultSocketReorderTimeout(t *testing.T) {
+	engine := enigma.NewFakeEngine()
+	socket := NewFaultSocket(engine, FaultOptions{ReadSchedule: map[int]Fault{1: ReorderFrame}, ReorderTimeout: 10 * time.Millisecond})
+	engine.Notify("N1", nil)
+	// No frame follows, so the held frame is delivered on its own
+	assert.Equal(t, []string{`{"jsonrpc":"2.0","method":"N1"}`}, readFrames(t, socket, 1))
+	engine.Notify("N2", nil)
+	assert.Equal(t, []string{`{"jsonrpc":"2.0","method":"N2"}`}, readFrames(t, socket, 1))
+}
+
+func TestFaultSocketIsDeterministic(t *testing.T) {
+	options := FaultOptions{Seed: 42, DropProbability: 0.2, DuplicateProbability: 0.2, TruncateProbability: 0.2}
+	run := func() []InjectedFault {
+		frames := &frameSocket{}
+		for i := 0; i < 50; i++ {
+			frames.frames = append(frames.frames, fmt.Sprintf(`{"method":"N%d"}`, i))
+		}
+		socket := NewFaultSocket(frames, options)
+		readFrames(t, socket, 100)
+		return socket.Injected()
+	}
+	first := run()
+	assert.NotEmpty(t, first)
+	assert.Equal(t, first, run())
+}
+
+func TestFaultInjectionDisconnectsSession(t *testing.T) {
+	server := NewServer(Options{Backend: Handlers(openDocHandlers)})
+	defer server.Close()
+
+	// The first frame is the OnConnected notification, the second one the response to OpenDoc
+	dialer := enigma.Dialer{SessionInterceptors: []enigma.SessionInterceptor{FaultInjection(FaultOptions{
+		ReadSchedule:  map[int]Fault{2: Disconnect},
+		WriteSchedule: map[int]Fault{1: DelayWrite},
+		WriteDelay:    10 * time.Millisecond,
+	})}}
+	global, err := dialer.Dial(context.Background(), server.URL, nil)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.ErrorIs(t, err, ErrInjectedDisconnect)
+}
@@ -0,0 +1,192 @@
+// Package enginetest provides an in-process WebSocket server standing in for Qlik Associative Engine so that code using
+// the default enigma-go dialer can be tested end to end without a running engine.
+//
+// Each connection is served by an enigma.Socket, typically an enigma.FakeEngine with registered handlers or an
+// enigma.MockSocket replaying recorded traffic. The server forwards frames between the WebSocket connection and that
+// socket and can simulate latency, slow reads, dropped connections, close codes and rejected upgrades.
+package enginetest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+type (
+	// Backend creates the socket serving a new connection
+	Backend func(r *http.Request) (enigma.Socket, error)
+
+	// Options configures a Server
+	Options struct {
+		// Backend creates the socket serving each connection, see Handlers and Replay
+		Backend Backend
+
+		// Authorize is called before the upgrade. Returning a status code other than zero, for instance
+		// http.StatusUnauthorized or http.StatusForbidden, rejects the upgrade with that status.
+		Authorize func(r *http.Request) int
+
+		// Latency delays every message sent to the client
+		Latency time.Duration
+
+		// ReadDelay delays reading every message sent by the client, simulating an engine that reads slowly
+		ReadDelay time.Duration
+
+		// DropAfter abruptly closes the underlying connection, without a close frame, once this many messages have been
+		// sent to the client. Zero never drops the connection.
+		DropAfter int
+
+		// CloseAfter sends a close frame with CloseCode and CloseText once this many messages have been sent
+		// to the client. Zero never closes the connection. A zero CloseCode means normal closure.
+		CloseAfter int
+		CloseCode  int
+		CloseText  string
+	}
+
+	// Server is an httptest server upgrading every request to a WebSocket connection
+	Server struct {
+		// URL is the ws:// URL of the server
+		URL string
+
+		options     Options
+		server      *httptest.Server
+		upgrader    websocket.Upgrader
+		mutex       sync.Mutex
+		connections int
+	}
+)
+
+// Handlers creates a Backend serving every connection with a new enigma.FakeEngine configured by setup
+func Handlers(setup func(engine *enigma.FakeEngine)) Backend {
+	return func(r *http.Request) (enigma.Socket, error) {
+		engine := enigma.NewFakeEngine()
+		if setup != nil {
+			setup(engine)
+		}
+		return engine.CreateSocket(r.Context(), r.URL.String(), r.Header)
+	}
+}
+
+// Replay creates a Backend serving every connection with an enigma.MockSocket replaying the recorded traffic log
+func Replay(fileName string, options enigma.MockSocketOptions) Backend {
+	return func(r *http.Request) (enigma.Socket, error) {
+		return enigma.NewMockSocketWithOptions(fileName, options)
+	}
+}
+
+// NewServer starts a Server. Close it when done.
+func NewServer(options Options) *Server {
+	s := &Server{
+		options:  options,
+		upgrader: websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = "ws" + strings.TrimPrefix(s.server.URL, "http")
+	return s
+}
+
+// Close shuts down the server and closes all connections
+func (s *Server) Close() {
+	s.server.CloseClientConnections()
+	s.server.Close()
+}
+
+// Connections returns the number of connections accepted so far
+func (s *Server) Connections() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.connections
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.options.Authorize != nil {
+		if status := s.options.Authorize(r); status != 0 {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+	}
+	if s.options.Backend == nil {
+		http.Error(w, "no backend configured", http.StatusInternalServerError)
+		return
+	}
+	backend, err := s.options.Backend(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		backend.Close()
+		return
+	}
+	s.mutex.Lock()
+	s.connections++
+	s.mutex.Unlock()
+	s.bridge(conn, backend)
+}
+
+// bridge forwards messages in both directions until either side is closed
+func (s *Server) bridge(conn *websocket.Conn, backend enigma.Socket) {
+	done := make(chan struct{})
+	var once sync.Once
+	stop := func() {
+		once.Do(func() {
+			close(done)
+			backend.Close()
+			conn.Close()
+		})
+	}
+
+	go func() {
+		defer stop()
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if s.options.ReadDelay > 0 {
+				time.Sleep(s.options.ReadDelay)
+			}
+			if err := backend.WriteMessage(websocket.TextMessage, message); err != nil {
+				return
+			}
+		}
+	}()
+
+	defer stop()
+	sent := 0
+	for {
+		_, message, err := backend.ReadMessage()
+		if err != nil {
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			return
+		}
+		if s.options.Latency > 0 {
+			select {
+			case <-time.After(s.options.Latency):
+			case <-done:
+				return
+			}
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+			return
+		}
+		sent++
+		if s.options.DropAfter > 0 && sent >= s.options.DropAfter {
+			conn.UnderlyingConn().Close()
+			return
+		}
+		if s.options.CloseAfter > 0 && sent >= s.options.CloseAfter {
+			code := s.options.CloseCode
+			if code == 0 {
+				code = websocket.CloseNormalClosure
+			}
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, s.options.CloseText), time.Now().Add(time.Second))
+			return
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package enginetest
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func openDocHandlers(engine *enigma.FakeEngine) {
+	engine.On("Global", "OpenDoc", func(call *enigma.FakeCall) (interface{}, error) {
+		return map[string]interface{}{"qReturn": call.NewObject("Doc", "", "app")}, nil
+	})
+}
+
+func TestServerWithHandlers(t *testing.T) {
+	server := NewServer(Options{Backend: Handlers(openDocHandlers), Latency: 20 * time.Millisecond})
+	defer server.Close()
+
+	ctx := context.Background()
+	global, err := enigma.Dialer{}.Dial(ctx, server.URL, nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	start := time.Now()
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, doc.Handle)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, 1, server.Connections())
+}
+
+func TestServerReplay(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "traffic.jsonl")
+	os.WriteFile(fileName, []byte(`{"Sent":{"jsonrpc":"2.0","delta":false,"method":"OpenDoc","handle":-1,"id":1,"params":["app","","","",false]}}
+{"Received":{"jsonrpc":"2.0","id":1,"result":{"qReturn":{"qType":"Doc","qHandle":1}}}}
+`), 0644)
+	server := NewServer(Options{Backend: Replay(fileName, enigma.MockSocketOptions{})})
+	defer server.Close()
+
+	ctx := context.Background()
+	global, err := enigma.Dialer{}.Dial(ctx, server.URL, nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, doc.Handle)
+}
+
+func TestServerRejectsUpgrade(t *testing.T) {
+	server := NewServer(Options{Backend: Handlers(nil), Authorize: func(r *http.Request) int {
+		if r.Header.Get("Authorization") == "" {
+			return http.StatusUnauthorized
+		}
+		return http.StatusForbidden
+	}})
+	defer server.Close()
+
+	_, err := enigma.Dialer{}.Dial(context.Background(), server.URL, nil)
+	assert.ErrorContains(t, err, "401 from ws server")
+	_, err = enigma.Dialer{}.Dial(context.Background(), server.URL, http.Header{"Authorization": []string{"Bearer x"}})
+	assert.ErrorContains(t, err, "403 from ws server")
+	assert.Equal(t, 0, server.Connections())
+}
+
+func TestServerCloseCode(t *testing.T) {
+	// The first message sent is the OnConnected notification
+	server := NewServer(Options{Backend: Handlers(openDocHandlers), CloseAfter: 1, CloseCode: 4000, CloseText: "going away"})
+	defer server.Close()
+
+	global, err := enigma.Dialer{}.Dial(context.Background(), server.URL, nil)
+	assert.NoError(t, err)
+	select {
+	case <-global.Disconnected():
+	case <-time.After(5 * time.Second):
+		t.Fatal("not disconnected")
+	}
+	_, err = global.OpenDoc(context.Background(), "app", "", "", "", false)
+	assert.ErrorContains(t, err, "close 4000")
+}
+
+func TestServerDropsConnection(t *testing.T) {
+	server := NewServer(Options{Backend: Handlers(openDocHandlers), DropAfter: 1, ReadDelay: 10 * time.Millisecond})
+	defer server.Close()
+
+	global, err := enigma.Dialer{}.Dial(context.Background(), server.URL, nil)
+	assert.NoError(t, err)
+	select {
+	case <-global.Disconnected():
+	case <-time.After(5 * time.Second):
+		t.Fatal("not disconnected")
+	}
+	_, err = global.OpenDoc(context.Background(), "app", "", "", "", false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,89 @@
+// Package enigmamock contains testify mocks of the remote object interfaces in enigma-go, for instance Doc implementing
+// enigma.DocAPI. The mocks are generated from the engine schema together with the rest of the QIX API.
+//
+//	doc := &enigmamock.Doc{}
+//	object := &enigma.GenericObject{RemoteObject: &enigma.RemoteObject{ObjectInterface: &enigma.ObjectInterface{Type: "GenericObject", GenericId: "sheet1"}}}
+//	doc.On("GetObject", mock.Anything, "sheet1").Return(object, nil)
+//
+// Methods returning remote objects return the concrete types, for instance *enigma.GenericObject, just like the
+// interfaces they implement. Returned objects need a RemoteObject with an ObjectInterface, since their fields such as
+// GenericId are read through it.
+package enigmamock
+
+import (
+	"context"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+// RemoteObject is a mock of the methods shared by all remote objects and of the session methods. It is embedded in
+// all generated mocks.
+type RemoteObject struct {
+	mock.Mock
+}
+
+var _ enigma.RemoteObjectAPI = (*RemoteObject)(nil)
+var _ enigma.SessionAPI = (*RemoteObject)(nil)
+
+// returnValue returns the value at index or the zero value if it is nil
+func returnValue[T any](args mock.Arguments, index int) T {
+	value, _ := args.Get(index).(T)
+	return value
+}
+
+// ChangedChannel mocks RemoteObject.ChangedChannel
+func (m *RemoteObject) ChangedChannel() chan struct{} {
+	return returnValue[chan struct{}](m.Called(), 0)
+}
+
+// RemoveChangeChannel mocks RemoteObject.RemoveChangeChannel
+func (m *RemoteObject) RemoveChangeChannel(channel chan struct{}) {
+	m.Called(channel)
+}
+
+// Closed mocks RemoteObject.Closed
+func (m *RemoteObject) Closed() chan struct{} {
+	return returnValue[chan struct{}](m.Called(), 0)
+}
+
+// RPC mocks RemoteObject.RPC
+func (m *RemoteObject) RPC(ctx context.Context, method string, apiResponse interface{}, params ...interface{}) error {
+	return m.Called(ctx, method, apiResponse, params).Error(0)
+}
+
+// DisconnectFromServer mocks Global.DisconnectFromServer
+func (m *RemoteObject) DisconnectFromServer() {
+	m.Called()
+}
+
+// Disconnected mocks Global.Disconnected
+func (m *RemoteObject) Disconnected() chan struct{} {
+	return returnValue[chan struct{}](m.Called(), 0)
+}
+
+// SessionMessageChannel mocks Global.SessionMessageChannel
+func (m *RemoteObject) SessionMessageChannel(topics ...string) chan enigma.SessionMessage {
+	return returnValue[chan enigma.SessionMessage](m.Called(topics), 0)
+}
+
+// CloseSessionMessageChannel mocks Global.CloseSessionMessageChannel
+func (m *RemoteObject) CloseSessionMessageChannel(channel chan enigma.SessionMessage) {
+	m.Called(channel)
+}
+
+// SessionState mocks Global.SessionState
+func (m *RemoteObject) SessionState(ctx context.Context) (string, error) {
+	args := m.Called(ctx)
+	return args.String(0), args.Error(1)
+}
+
+// ChangeListsChannel mocks Global.ChangeListsChannel
+func (m *RemoteObject) ChangeListsChannel(pushedOnly bool) chan enigma.ChangeLists {
+	return returnValue[chan enigma.ChangeLists](m.Called(pushedOnly), 0)
+}
+
+// CloseChangeListsChannel mocks Global.CloseChangeListsChannel
+func (m *RemoteObject) CloseChangeListsChannel(channel chan enigma.ChangeLists) {
+	m.Called(channel)
+}
@@ -0,0 +1,45 @@
+package enigmamock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func objectID(ctx context.Context, doc enigma.DocAPI, id string) (string, error) {
+	object, err := doc.GetObject(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return object.GenericId, nil
+}
+
+func TestDocMock(t *testing.T) {
+	ctx := context.Background()
+	doc := &Doc{}
+	doc.On("GetObject", mock.Anything, "sheet1").Return(&enigma.GenericObject{RemoteObject: &enigma.RemoteObject{ObjectInterface: &enigma.ObjectInterface{GenericId: "sheet1"}}}, nil)
+	doc.On("GetObject", mock.Anything, "missing").Return(nil, errors.New("not found"))
+
+	id, err := objectID(ctx, doc, "sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, "sheet1", id)
+	_, err = objectID(ctx, doc, "missing")
+	assert.EqualError(t, err, "not found")
+	doc.AssertExpectations(t)
+}
+
+func TestGlobalMock(t *testing.T) {
+	global := &Global{}
+	global.On("EngineVersion", mock.Anything).Return(&enigma.NxEngineVersion{ComponentVersion: "1.0"}, nil)
+	global.On("DisconnectFromServer").Return()
+	var api enigma.GlobalAPI = global
+	version, err := api.EngineVersion(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", version.ComponentVersion)
+	api.DisconnectFromServer()
+	global.AssertExpectations(t)
+}
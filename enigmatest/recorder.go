@@ -0,0 +1,214 @@
+// Package enigmatest contains helpers for tests that run scenarios against Qlik Associative Engine once, record the traffic
+// as a golden file and replay it on every following run without an engine.
+package enigmatest
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+// update is namespaced so that it does not clash with the -update flag that test packages often define for golden
+// files of their own, such a flag is honored as well
+var update = flag.Bool("enigmatest.update", false, "re-record the enigma traffic golden files in testdata")
+
+type (
+	// Normalizer rewrites nondeterministic values in a message, for instance random ids or timestamps
+	Normalizer func(message []byte) []byte
+
+	// RecorderOptions configures a recorder created by RecorderWithOptions
+	RecorderOptions struct {
+		// Dir is the directory holding the golden files, "testdata" if empty
+		Dir string
+
+		// Dialer is the base for the returned Dialer, for instance with TLS configuration needed when recording
+		Dialer *enigma.Dialer
+
+		// Normalizers are applied to every recorded message and, when replaying, to every message sent
+		// before it is compared with the recording. DefaultNormalizers is used if nil.
+		Normalizers []Normalizer
+
+		// MockSocketOptions configures how sent messages are matched against the recording when replaying
+		MockSocketOptions enigma.MockSocketOptions
+	}
+
+	trafficRow struct {
+		Sent     json.RawMessage `json:"Sent,omitempty"`
+		Received json.RawMessage `json:"Received,omitempty"`
+	}
+
+	goldenFileLogger struct {
+		t           testing.TB
+		fileName    string
+		normalizers []Normalizer
+		mutex       sync.Mutex
+		rows        []trafficRow
+		sessions    int
+	}
+
+	normalizingSocket struct {
+		enigma.Socket
+		normalizers []Normalizer
+	}
+)
+
+// DefaultNormalizers replaces UUIDs, including the ones in session app ids, and RFC 3339 timestamps with fixed values
+var DefaultNormalizers = []Normalizer{
+	ReplaceRegexp(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`, "00000000-0000-0000-0000-000000000000"),
+	ReplaceRegexp(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`, "2000-01-01T00:00:00Z"),
+}
+
+// ReplaceRegexp creates a Normalizer replacing all matches of the regular expression, see regexp.ReplaceAll
+func ReplaceRegexp(pattern string, replacement string) Normalizer {
+	re := regexp.MustCompile(pattern)
+	return func(message []byte) []byte {
+		return re.ReplaceAll(message, []byte(replacement))
+	}
+}
+
+// Recorder returns a Dialer replaying testdata/<name>.traffic. When the test is run with the -enigmatest.update flag,
+// or with an -update flag defined by the test package, the Dialer connects to the engine and records the traffic to the
+// same file instead. A recording holds a single session, so the Dialer must only be used for one Dial.
+func Recorder(t testing.TB, name string) *enigma.Dialer {
+	t.Helper()
+	return RecorderWithOptions(t, name, RecorderOptions{})
+}
+
+func updating() bool {
+	if *update {
+		return true
+	}
+	userFlag := flag.Lookup("update")
+	if userFlag == nil {
+		return false
+	}
+	getter, ok := userFlag.Value.(flag.Getter)
+	if !ok {
+		return false
+	}
+	value, _ := getter.Get().(bool)
+	return value
+}
+
+// RecorderWithOptions is like Recorder but with options. When replaying, a request that does not match the recording fails
+// the test and so does a recorded request that has not been sent when the test ends.
+func RecorderWithOptions(t testing.TB, name string, options RecorderOptions) *enigma.Dialer {
+	t.Helper()
+	if options.Dir == "" {
+		options.Dir = "testdata"
+	}
+	if options.Normalizers == nil {
+		options.Normalizers = DefaultNormalizers
+	}
+	dialer := &enigma.Dialer{}
+	if options.Dialer != nil {
+		*dialer = *options.Dialer
+	}
+	fileName := filepath.Join(options.Dir, name+".traffic")
+
+	if updating() {
+		if err := os.MkdirAll(options.Dir, 0755); err != nil {
+			t.Fatalf("enigmatest: %s", err)
+		}
+		logger := &goldenFileLogger{t: t, fileName: fileName, normalizers: options.Normalizers}
+		// The recording is written when the test ends so that the traffic after a session is closed is kept as well
+		t.Cleanup(logger.write)
+		dialer.TrafficLogger = logger
+		dialer.TrafficDumpFile = ""
+		dialer.MockMode = false
+		return dialer
+	}
+
+	if _, err := os.Stat(fileName); err != nil {
+		t.Fatalf("enigmatest: no recording for %s, run the test with -enigmatest.update against an engine to create it: %s", name, err)
+	}
+	mockSocketOptions := options.MockSocketOptions
+	if mockSocketOptions.T == nil {
+		mockSocketOptions.T = t
+	}
+	socket, err := enigma.NewMockSocketWithOptions(fileName, mockSocketOptions)
+	if err != nil {
+		t.Fatalf("enigmatest: %s", err)
+	}
+	t.Cleanup(func() {
+		t.Helper()
+		socket.AssertAllExpectationsMet(t)
+	})
+	dialer.MockMode = false
+	dialer.TrafficDumpFile = ""
+	dialer.TrafficLogger = nil
+	var mutex sync.Mutex
+	dialed := false
+	dialer.CreateSocket = func(ctx context.Context, url string, httpHeader http.Header) (enigma.Socket, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if dialed {
+			return nil, fmt.Errorf("enigmatest: the recording %s holds a single session, use one Recorder per Dial", fileName)
+		}
+		dialed = true
+		return &normalizingSocket{Socket: socket, normalizers: options.Normalizers}, nil
+	}
+	return dialer
+}
+
+func normalize(normalizers []Normalizer, message []byte) []byte {
+	for _, normalizer := range normalizers {
+		message = normalizer(message)
+	}
+	return message
+}
+
+// WriteMessage normalizes the message before it is matched against the recording
+func (s *normalizingSocket) WriteMessage(messageType int, message []byte) error {
+	return s.Socket.WriteMessage(messageType, normalize(s.normalizers, message))
+}
+
+// Opened implements the enigma.TrafficLogger interface. A second session is reported since it could not be replayed.
+func (l *goldenFileLogger) Opened() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.sessions++
+	if l.sessions == 2 {
+		l.t.Errorf("enigmatest: the recording %s holds a single session, use one Recorder per Dial", l.fileName)
+	}
+}
+
+// Sent implements the enigma.TrafficLogger interface
+func (l *goldenFileLogger) Sent(message []byte) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.rows = append(l.rows, trafficRow{Sent: normalize(l.normalizers, append([]byte(nil), message...))})
+}
+
+// Received implements the enigma.TrafficLogger interface
+func (l *goldenFileLogger) Received(message []byte) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.rows = append(l.rows, trafficRow{Received: normalize(l.normalizers, append([]byte(nil), message...))})
+}
+
+// Closed implements the enigma.TrafficLogger interface
+func (l *goldenFileLogger) Closed() {
+}
+
+// write stores the recording when the test ends
+func (l *goldenFileLogger) write() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	bytes, err := json.MarshalIndent(l.rows, "", "\t")
+	if err == nil {
+		err = os.WriteFile(l.fileName, bytes, 0644)
+	}
+	if err != nil {
+		l.t.Errorf("enigmatest: writing %s: %s", l.fileName, err)
+	}
+}
@@ -0,0 +1,128 @@
+package enigmatest
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/qlik-oss/enigma-go/v4"
+	"github.com/qlik-oss/enigma-go/v4/enginetest"
+	"github.com/stretchr/testify/assert"
+)
+
+// goldenUpdate is the -update flag that test packages usually define for golden files of their own
+var goldenUpdate = flag.Bool("update", false, "update the golden files")
+
+type recordingT struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func runScenario(t *testing.T, dialer *enigma.Dialer, url string, appID string) {
+	ctx := context.Background()
+	global, err := dialer.Dial(ctx, url, nil)
+	assert.NoError(t, err)
+	doc, err := global.CreateSessionApp(ctx)
+	if assert.NoError(t, err) {
+		doc.SetScript(ctx, "Load "+appID)
+	}
+	global.DisconnectFromServer()
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	server := enginetest.NewServer(enginetest.Options{Backend: enginetest.Handlers(func(engine *enigma.FakeEngine) {
+		engine.On("Global", "CreateSessionApp", func(call *enigma.FakeCall) (interface{}, error) {
+			return map[string]interface{}{
+				"qReturn":       call.NewObject("Doc", "", ""),
+				"qSessionAppId": "SessionApp_9ff821c1-28ca-4b23-9ad5-1f2e6606f475",
+			}, nil
+		})
+		engine.On("Doc", "SetScript", func(call *enigma.FakeCall) (interface{}, error) {
+			return nil, nil
+		})
+	})})
+	defer server.Close()
+
+	*update = true
+	t.Run("record", func(t *testing.T) {
+		runScenario(t, RecorderWithOptions(t, "scenario", RecorderOptions{Dir: dir}), server.URL, "3c1d3e9a-62b4-4ea1-9d4a-d2a6bf1c0f7e")
+	})
+	*update = false
+	recording, err := os.ReadFile(filepath.Join(dir, "scenario.traffic"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(recording), "SessionApp_00000000-0000-0000-0000-000000000000")
+	assert.NotContains(t, string(recording), "9ff821c1")
+
+	t.Run("replay", func(t *testing.T) {
+		// A different random id is normalized to the recorded one
+		runScenario(t, RecorderWithOptions(t, "scenario", RecorderOptions{Dir: dir}), "", "0e7f8a4c-1111-2222-3333-444455556666")
+	})
+
+	diverging := &recordingT{TB: t}
+	t.Run("diverging", func(t *testing.T) {
+		diverging.TB = t
+		dialer := RecorderWithOptions(diverging, "scenario", RecorderOptions{Dir: dir})
+		ctx := context.Background()
+		global, err := dialer.Dial(ctx, "", nil)
+		assert.NoError(t, err)
+		_, err = global.CreateSessionAppFromApp(ctx, "other")
+		assert.Error(t, err)
+		global.DisconnectFromServer()
+	})
+	// The unexpected request and the requests that were never sent are reported
+	assert.Len(t, diverging.errors, 3)
+	assert.True(t, strings.Contains(diverging.errors[0], "unexpected request"))
+}
+
+func TestRecorderHoldsASingleSession(t *testing.T) {
+	dir := t.TempDir()
+	server := enginetest.NewServer(enginetest.Options{Backend: enginetest.Handlers(func(engine *enigma.FakeEngine) {})})
+	defer server.Close()
+	ctx := context.Background()
+
+	*update = true
+	recording := &recordingT{TB: t}
+	t.Run("record", func(t *testing.T) {
+		recording.TB = t
+		dialer := RecorderWithOptions(recording, "sessions", RecorderOptions{Dir: dir})
+		for i := 0; i < 2; i++ {
+			global, err := dialer.Dial(ctx, server.URL, nil)
+			if assert.NoError(t, err) {
+				global.DisconnectFromServer()
+			}
+		}
+	})
+	*update = false
+	assert.Len(t, recording.errors, 1)
+	assert.Contains(t, recording.errors[0], "holds a single session")
+
+	t.Run("replay", func(t *testing.T) {
+		dialer := RecorderWithOptions(t, "sessions", RecorderOptions{Dir: dir})
+		global, err := dialer.Dial(ctx, "", nil)
+		assert.NoError(t, err)
+		global.DisconnectFromServer()
+		_, err = dialer.Dial(ctx, "", nil)
+		assert.ErrorContains(t, err, "holds a single session")
+	})
+}
+
+func TestUpdateFlags(t *testing.T) {
+	assert.False(t, updating())
+	*goldenUpdate = true
+	assert.True(t, updating())
+	*goldenUpdate = false
+	*update = true
+	assert.True(t, updating())
+	*update = false
+}
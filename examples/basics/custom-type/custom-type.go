@@ -1,3 +1,5 @@
+//go:build !enigma_no_raw
+
 package main
 
 import (
@@ -0,0 +1,74 @@
+package enigma
+
+import (
+	"context"
+
+	"github.com/goccy/go-json"
+)
+
+// ExtendedLayout is the layout of a generic object together with the properties that are not in the schema, for
+// instance those of a visualization extension, decoded into Custom
+type ExtendedLayout[T any] struct {
+	*GenericObjectLayout
+	Custom T
+}
+
+// GetLayoutAs gets the layout of a generic object and decodes it both into GenericObjectLayout and into T. T only
+// declares the custom properties and should not embed GenericObjectLayout, since its UnmarshalJSON method would be
+// promoted and leave the fields of T empty:
+//
+//	type chartLayout struct {
+//		Color string `json:"color"`
+//	}
+//	layout, err := enigma.GetLayoutAs[chartLayout](ctx, object)
+//	fmt.Println(layout.Info.Id, layout.Custom.Color)
+func GetLayoutAs[T any](ctx context.Context, object *GenericObject) (*ExtendedLayout[T], error) {
+	result := &struct {
+		Layout json.RawMessage `json:"qLayout"`
+	}{}
+	if err := object.RPC(ctx, "GetLayout", result); err != nil {
+		return nil, err
+	}
+	layout := &ExtendedLayout[T]{}
+	if err := layout.UnmarshalJSON(result.Layout); err != nil {
+		return nil, err
+	}
+	return layout, nil
+}
+
+// UnmarshalJSON decodes the layout both into GenericObjectLayout, which is allocated if nil, and into Custom
+func (layout *ExtendedLayout[T]) UnmarshalJSON(data []byte) error {
+	if layout.GenericObjectLayout == nil {
+		layout.GenericObjectLayout = &GenericObjectLayout{}
+	}
+	if err := json.Unmarshal(data, layout.GenericObjectLayout); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &layout.Custom)
+}
+
+// MarshalJSON marshals the layout together with the properties of Custom, which take precedence
+func (layout ExtendedLayout[T]) MarshalJSON() ([]byte, error) {
+	properties := map[string]json.RawMessage{}
+	if layout.GenericObjectLayout != nil {
+		data, err := json.Marshal(layout.GenericObjectLayout)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &properties); err != nil {
+			return nil, err
+		}
+	}
+	data, err := json.Marshal(layout.Custom)
+	if err != nil {
+		return nil, err
+	}
+	custom := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &custom); err != nil {
+		return nil, err
+	}
+	for name, value := range custom {
+		properties[name] = value
+	}
+	return json.Marshal(properties)
+}
@@ -0,0 +1,54 @@
+package enigma
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLayoutAs(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeEngine().
+		On("Global", "OpenDoc", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qReturn": call.NewObject("Doc", "", "app")}, nil
+		}).
+		On("Doc", "GetObject", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qReturn": call.NewObject("GenericObject", "my-chart", "chart")}, nil
+		}).
+		On("GenericObject", "GetLayout", func(call *FakeCall) (interface{}, error) {
+			return json.RawMessage(`{"qLayout":{"qInfo":{"qId":"chart","qType":"my-chart"},"color":"red","size":3}}`), nil
+		})
+	global, err := Dialer{CreateSocket: fake.CreateSocket}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+	object, err := doc.GetObject(ctx, "chart")
+	assert.NoError(t, err)
+
+	type chartLayout struct {
+		Color string `json:"color"`
+	}
+	layout, err := GetLayoutAs[chartLayout](ctx, object)
+	assert.NoError(t, err)
+	assert.Equal(t, "chart", layout.Info.Id)
+	assert.Equal(t, "red", layout.Custom.Color)
+	assert.Equal(t, json.RawMessage(`3`), layout.Extra["size"])
+
+	layout.Custom.Color = "blue"
+	bytes, err := json.Marshal(layout)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"qInfo":{"qId":"chart","qType":"my-chart"},"color":"blue","size":3}`, string(bytes))
+
+	// The layout round-trips through a zero ExtendedLayout
+	decoded := ExtendedLayout[chartLayout]{}
+	assert.NoError(t, json.Unmarshal(bytes, &decoded))
+	assert.Equal(t, "chart", decoded.Info.Id)
+	assert.Equal(t, "blue", decoded.Custom.Color)
+	assert.Equal(t, json.RawMessage(`3`), decoded.Extra["size"])
+	again, err := json.Marshal(decoded)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(bytes), string(again))
+}
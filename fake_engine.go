@@ -0,0 +1,277 @@
+package enigma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+type (
+	// FakeEngine is a programmable Socket that answers requests by calling handlers registered per object type and method.
+	// It keeps track of the objects it has handed out so that handles are allocated automatically and can be used to look
+	// up the handler of later calls. Use CreateSocket as the CreateSocket function of a Dialer.
+	FakeEngine struct {
+		mutex          sync.Mutex
+		handlers       map[string]FakeHandler
+		objects        map[int]*ObjectInterface
+		nextHandle     int
+		pendingChanged []int
+		pendingClosed  []int
+		messages       chan json.RawMessage
+		closed         chan struct{}
+	}
+
+	// FakeHandler handles a call to a FakeEngine. The returned value is marshaled as the result of the call. Returning an
+	// Error, for instance created by NewEngineError, sends its code, parameter and message to the caller; any other error
+	// is sent as an internal error.
+	FakeHandler func(call *FakeCall) (interface{}, error)
+
+	// FakeCall describes a call received by a FakeEngine
+	FakeCall struct {
+		// Engine is the engine that received the call
+		Engine *FakeEngine
+		// Object is the object the method was called on, the Global object has handle -1
+		Object *ObjectInterface
+		// Method is the name of the called method
+		Method string
+		// Params contains the raw params of the request
+		Params  json.RawMessage
+		changed []int
+		closed  []int
+	}
+
+	fakeEngineRequest struct {
+		Method string          `json:"method"`
+		Handle int             `json:"handle"`
+		ID     int             `json:"id"`
+		Params json.RawMessage `json:"params"`
+	}
+
+	fakeEngineResponse struct {
+		JSONRPC string      `json:"jsonrpc"`
+		ID      int         `json:"id,omitempty"`
+		Method  string      `json:"method,omitempty"`
+		Params  interface{} `json:"params,omitempty"`
+		Result  interface{} `json:"result,omitempty"`
+		Error   *qixError   `json:"error,omitempty"`
+		Change  []int       `json:"change,omitempty"`
+		Close   []int       `json:"close,omitempty"`
+	}
+)
+
+// NewEngineError creates an Error with the same code, parameter and message as an error sent by Qlik Associative Engine
+func NewEngineError(code int, parameter string, message string) Error {
+	return &qixError{ErrorCode: code, ErrorParameter: parameter, ErrorMessage: message}
+}
+
+// NewFakeEngine creates a FakeEngine that only knows about the Global object
+func NewFakeEngine() *FakeEngine {
+	return &FakeEngine{
+		handlers:   make(map[string]FakeHandler),
+		objects:    map[int]*ObjectInterface{-1: {Type: "Global", Handle: -1}},
+		nextHandle: 1,
+		messages:   make(chan json.RawMessage, 100),
+		closed:     make(chan struct{}),
+	}
+}
+
+// On registers a handler for a method on all objects of the given type, for instance "Doc" and "GetObject".
+// The object type "*" matches objects of any type that have no handler of their own.
+func (f *FakeEngine) On(objectType string, method string, handler FakeHandler) *FakeEngine {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.handlers[objectType+"."+method] = handler
+	return f
+}
+
+// CreateSocket returns the engine itself and pushes the OnConnected notification. It has the signature of the CreateSocket
+// function in the Dialer. An engine closed by an earlier session is reopened, so that it can be dialed again, but only one
+// session can be connected at a time.
+func (f *FakeEngine) CreateSocket(ctx context.Context, url string, httpHeader http.Header) (Socket, error) {
+	f.mutex.Lock()
+	select {
+	case <-f.closed:
+		f.messages = make(chan json.RawMessage, 100)
+		f.closed = make(chan struct{})
+	default:
+	}
+	f.mutex.Unlock()
+	f.Notify("OnConnected", map[string]string{"qSessionState": "SESSION_CREATED"})
+	return f, nil
+}
+
+// NewObject registers a new object and allocates a handle for it
+func (f *FakeEngine) NewObject(objectType string, genericType string, genericID string) *ObjectInterface {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	object := &ObjectInterface{Type: objectType, Handle: f.nextHandle, GenericType: genericType, GenericId: genericID}
+	f.objects[object.Handle] = object
+	f.nextHandle++
+	return object
+}
+
+// Object returns the object registered with the given handle or nil
+func (f *FakeEngine) Object(handle int) *ObjectInterface {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.objects[handle]
+}
+
+// Changed adds handles to the change list sent with the next response
+func (f *FakeEngine) Changed(handles ...int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.pendingChanged = append(f.pendingChanged, handles...)
+}
+
+// Closed closes objects and adds their handles to the close list sent with the next response
+func (f *FakeEngine) Closed(handles ...int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, handle := range handles {
+		delete(f.objects, handle)
+	}
+	f.pendingClosed = append(f.pendingClosed, handles...)
+}
+
+// PushChangeLists sends the pending change and close lists immediately without waiting for a response
+func (f *FakeEngine) PushChangeLists() {
+	f.mutex.Lock()
+	message := &fakeEngineResponse{JSONRPC: "2.0", Change: f.pendingChanged, Close: f.pendingClosed}
+	f.pendingChanged, f.pendingClosed = nil, nil
+	f.mutex.Unlock()
+	f.send(message)
+}
+
+// Notify pushes a notification, for instance OnConnected
+func (f *FakeEngine) Notify(method string, params interface{}) {
+	f.send(&fakeEngineResponse{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+func (f *FakeEngine) send(message *fakeEngineResponse) {
+	bytes, err := json.Marshal(message)
+	if err != nil {
+		bytes, _ = json.Marshal(&fakeEngineResponse{JSONRPC: "2.0", ID: message.ID, Error: &qixError{ErrorCode: -128, ErrorMessage: err.Error()}})
+	}
+	messages, closed := f.channels()
+	select {
+	case messages <- bytes:
+	case <-closed:
+	}
+}
+
+func (f *FakeEngine) channels() (chan json.RawMessage, chan struct{}) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.messages, f.closed
+}
+
+func (f *FakeEngine) handler(object *ObjectInterface, method string) FakeHandler {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if handler := f.handlers[object.Type+"."+method]; handler != nil {
+		return handler
+	}
+	return f.handlers["*."+method]
+}
+
+// WriteMessage implements the Socket interface
+func (f *FakeEngine) WriteMessage(messageType int, message []byte) error {
+	request := &fakeEngineRequest{}
+	if err := json.Unmarshal(message, request); err != nil {
+		return err
+	}
+	response := &fakeEngineResponse{JSONRPC: "2.0", ID: request.ID}
+	call := &FakeCall{Engine: f, Object: f.Object(request.Handle), Method: request.Method, Params: request.Params}
+	var err error
+	if call.Object == nil {
+		err = NewEngineError(-32602, fmt.Sprint(request.Handle), "Invalid handle")
+	} else if handler := f.handler(call.Object, request.Method); handler == nil {
+		err = NewEngineError(-32601, call.Object.Type+"."+request.Method, "Method not found")
+	} else {
+		response.Result, err = handler(call)
+		if response.Result == nil {
+			response.Result = struct{}{}
+		}
+	}
+	if err != nil {
+		var engineError Error
+		if !errors.As(err, &engineError) {
+			engineError = NewEngineError(-128, "", err.Error())
+		}
+		response.Result = nil
+		response.Error = &qixError{ErrorCode: engineError.Code(), ErrorParameter: engineError.Parameter(), ErrorMessage: engineError.Message()}
+	}
+	f.Changed(call.changed...)
+	f.Closed(call.closed...)
+	f.mutex.Lock()
+	response.Change, response.Close = f.pendingChanged, f.pendingClosed
+	f.pendingChanged, f.pendingClosed = nil, nil
+	f.mutex.Unlock()
+	f.send(response)
+	return nil
+}
+
+// ReadMessage implements the Socket interface
+func (f *FakeEngine) ReadMessage() (int, []byte, error) {
+	messages, closed := f.channels()
+	select {
+	case message := <-messages:
+		return 1, message, nil
+	case <-closed:
+		return 0, nil, errors.New("fake engine closed")
+	}
+}
+
+// Close implements the Socket interface
+func (f *FakeEngine) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	select {
+	case <-f.closed:
+	default:
+		close(f.closed)
+	}
+	return nil
+}
+
+// Bind unmarshals the positional params of the call into the supplied pointers. Params missing in the request leave the
+// corresponding values untouched.
+func (c *FakeCall) Bind(values ...interface{}) error {
+	if len(c.Params) == 0 {
+		return nil
+	}
+	params := []json.RawMessage{}
+	if err := json.Unmarshal(c.Params, &params); err != nil {
+		return NewEngineError(-32602, c.Method, "Invalid params: "+err.Error())
+	}
+	for i, value := range values {
+		if i >= len(params) {
+			break
+		}
+		if err := json.Unmarshal(params[i], value); err != nil {
+			return NewEngineError(-32602, c.Method, fmt.Sprintf("Invalid param %d: %s", i, err))
+		}
+	}
+	return nil
+}
+
+// NewObject registers a new object and allocates a handle for it, use it to implement methods returning objects:
+//
+//	return map[string]interface{}{"qReturn": call.NewObject("GenericObject", "sheet", id)}, nil
+func (c *FakeCall) NewObject(objectType string, genericType string, genericID string) *ObjectInterface {
+	return c.Engine.NewObject(objectType, genericType, genericID)
+}
+
+// Changed adds handles to the change list of the response to the call
+func (c *FakeCall) Changed(handles ...int) {
+	c.changed = append(c.changed, handles...)
+}
+
+// Closed closes objects and adds their handles to the close list of the response to the call
+func (c *FakeCall) Closed(handles ...int) {
+	c.closed = append(c.closed, handles...)
+}
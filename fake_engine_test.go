@@ -0,0 +1,122 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFakeEngine(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeEngine().
+		On("Global", "OpenDoc", func(call *FakeCall) (interface{}, error) {
+			var appID string
+			if err := call.Bind(&appID); err != nil {
+				return nil, err
+			}
+			if appID != "app" {
+				return nil, NewEngineError(1002, appID, "App not found")
+			}
+			return map[string]interface{}{"qReturn": call.NewObject("Doc", "", appID)}, nil
+		}).
+		On("Doc", "GetObject", func(call *FakeCall) (interface{}, error) {
+			var id string
+			call.Bind(&id)
+			return map[string]interface{}{"qReturn": call.NewObject("GenericObject", "sheet", id)}, nil
+		}).
+		On("GenericObject", "GetLayout", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qLayout": map[string]interface{}{"qInfo": map[string]string{"qId": call.Object.GenericId, "qType": call.Object.GenericType}}}, nil
+		}).
+		On("GenericObject", "SetProperties", func(call *FakeCall) (interface{}, error) {
+			call.Changed(call.Object.Handle)
+			return nil, nil
+		}).
+		On("*", "Fail", func(call *FakeCall) (interface{}, error) {
+			return nil, errors.New("broken")
+		})
+
+	global, err := Dialer{CreateSocket: fake.CreateSocket}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+
+	_, err = global.OpenDoc(ctx, "missing", "", "", "", false)
+	var engineError Error
+	assert.True(t, errors.As(err, &engineError))
+	assert.Equal(t, 1002, engineError.Code())
+	assert.Equal(t, "missing", engineError.Parameter())
+
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, doc.Handle)
+	object, err := doc.GetObject(ctx, "sheet1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, object.Handle)
+	layout, err := object.GetLayout(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "sheet1", layout.Info.Id)
+	assert.Equal(t, "sheet", layout.Info.Type)
+
+	changed := object.ChangedChannel()
+	assert.NoError(t, object.SetProperties(ctx, &GenericObjectProperties{}))
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		t.Error("no change signal")
+	}
+
+	// Methods without a handler fail with method not found
+	_, err = doc.GetAppLayout(ctx)
+	assert.True(t, errors.As(err, &engineError))
+	assert.Equal(t, -32601, engineError.Code())
+	assert.Equal(t, "Doc.GetAppLayout", engineError.Parameter())
+
+	// Plain errors are sent as internal errors
+	err = doc.RPC(ctx, "Fail", nil)
+	assert.True(t, errors.As(err, &engineError))
+	assert.Equal(t, -128, engineError.Code())
+
+	// Closing an object sends a close list
+	fake.Closed(object.Handle)
+	fake.PushChangeLists()
+	select {
+	case <-object.Closed():
+	case <-time.After(time.Second):
+		t.Error("no close signal")
+	}
+	assert.Nil(t, fake.Object(object.Handle))
+}
+
+func TestFakeEngineNotifications(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeEngine()
+	global, err := Dialer{CreateSocket: fake.CreateSocket}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	messages := global.SessionMessageChannel("OnMaxParallelSessionsExceeded")
+	fake.Notify("OnMaxParallelSessionsExceeded", map[string]string{})
+	select {
+	case message := <-messages:
+		assert.Equal(t, "OnMaxParallelSessionsExceeded", message.Topic)
+	case <-time.After(time.Second):
+		t.Error("no notification")
+	}
+}
+
+func TestFakeEngineRedial(t *testing.T) {
+	ctx := context.Background()
+	fake := NewFakeEngine().On("Global", "EngineVersion", func(call *FakeCall) (interface{}, error) {
+		return map[string]interface{}{"qVersion": map[string]string{"qComponentVersion": "12.1306.0"}}, nil
+	})
+	for i := 0; i < 2; i++ {
+		global, err := Dialer{CreateSocket: fake.CreateSocket}.Dial(ctx, "", nil)
+		assert.NoError(t, err)
+		version, err := global.EngineVersion(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "12.1306.0", version.ComponentVersion)
+		global.DisconnectFromServer()
+		<-global.Disconnected()
+	}
+}
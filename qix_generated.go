@@ -3,19 +3,133 @@
 package enigma
 
 import (
-	"context"
 	"encoding/json"
+	"fmt"
 )
 
 // Version of the schema used to generate the enigma.go QIX API
 const QIX_SCHEMA_VERSION = "12.2528.0"
 
-// Deprecated: This will be removed in a future version
-type AlfaNumString struct {
-	// Calculated value.
-	String string `json:"qString,omitempty"`
-	// Is set to true if the value is a numeric.
-	IsNum bool `json:"qIsNum,omitempty"`
+// unmarshalEnum decodes an enum sent either by name or by numeric value. Unknown names are kept as is so that
+// values added in later versions of Qlik Associative Engine can be read, use IsValid to check them.
+func unmarshalEnum[T ~string](data []byte, values map[T]int, typeName string) (T, error) {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		return T(name), nil
+	}
+	var number int
+	if err := json.Unmarshal(data, &number); err != nil {
+		return "", fmt.Errorf("invalid %s: %s", typeName, data)
+	}
+	for value, n := range values {
+		if n == number {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("invalid %s: %d", typeName, number)
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func indexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
+
+func validateRange(path string, value int, min int, max int) error {
+	if value < min || value > max {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("%d is out of range [%d, %d]", value, min, max)}
+	}
+	return nil
+}
+
+func validateEnum[T interface {
+	~string
+	IsValid() bool
+}](path string, value T) error {
+	if value != "" && !value.IsValid() {
+		return &ValidationError{Path: path, Message: fmt.Sprintf("unknown value %q", string(value))}
+	}
+	return nil
+}
+
+// marshalWithExtra marshals a struct and adds the extra properties not set by its fields
+func marshalWithExtra(value interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	properties := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	for name, value := range extra {
+		if _, ok := properties[name]; !ok {
+			properties[name] = value
+		}
+	}
+	return json.Marshal(properties)
+}
+
+// hasUnknownProperties scans the keys of a JSON object without decoding its values and tells if any of them is not in
+// known. Escaped keys are reported as unknown.
+func hasUnknownProperties(data []byte, known map[string]bool) bool {
+	depth := 0
+	expectKey := false
+	for i := 0; i < len(data); i++ {
+		switch c := data[i]; c {
+		case '{', '[':
+			depth++
+			expectKey = c == '{' && depth == 1
+		case '}', ']':
+			depth--
+		case ',':
+			expectKey = depth == 1
+		case '"':
+			start := i + 1
+			escaped := false
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					escaped = true
+					i++
+				}
+			}
+			if expectKey {
+				if escaped || i > len(data) || !known[string(data[start:i])] {
+					return true
+				}
+				expectKey = false
+			}
+		}
+	}
+	return false
+}
+
+// unmarshalExtra returns the properties of a JSON object that are not in known, nil if there are none. The object is
+// only decoded a second time when it has unknown properties.
+func unmarshalExtra(data []byte, known map[string]bool) (map[string]json.RawMessage, error) {
+	if !hasUnknownProperties(data, known) {
+		return nil, nil
+	}
+	properties := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &properties); err != nil {
+		return nil, err
+	}
+	var extra map[string]json.RawMessage
+	for name, value := range properties {
+		if known[name] {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]json.RawMessage{}
+		}
+		extra[name] = value
+	}
+	return extra, nil
 }
 
 type AlternateStateData struct {
@@ -26,6 +140,23 @@ type AlternateStateData struct {
 	FieldItems []*BookmarkFieldItem `json:"qFieldItems,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *AlternateStateData) Validate() error {
+	return v.validate("")
+}
+
+func (v *AlternateStateData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.FieldItems {
+		if err := item0.validate(indexPath(joinPath(path, "qFieldItems"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type AppEntry struct {
 	// Identifier of the app.
 	ID string `json:"qID,omitempty"`
@@ -73,42 +204,63 @@ type AppScript struct {
 	IsLocked bool `json:"qIsLocked,omitempty"`
 }
 
-// Stability: experimental
-type AppScriptMeta struct {
-	// Information about publishing and permissions.
-	// This parameter is optional.
-	Meta *NxMeta `json:"qMeta,omitempty"`
-	// True if user is temporarily locked from modifying the script. Meta contains the ID of the last modifier. Only applicable to QCS.
-	IsLocked bool `json:"qIsLocked,omitempty"`
+type ApplyGroupStateWarningType string
+
+const (
+	ApplyGroupStateWarningTypeGroupMissing       ApplyGroupStateWarningType = "group_missing"
+	ApplyGroupStateWarningTypeGroupNotApplicable ApplyGroupStateWarningType = "group_not_applicable"
+	ApplyGroupStateWarningTypeFielddefMissing    ApplyGroupStateWarningType = "fielddef_missing"
+)
+
+var valuesOfApplyGroupStateWarningType = map[ApplyGroupStateWarningType]int{
+	ApplyGroupStateWarningTypeGroupMissing:       0,
+	ApplyGroupStateWarningTypeGroupNotApplicable: 1,
+	ApplyGroupStateWarningTypeFielddefMissing:    2,
 }
 
-// Stability: experimental
-type ApplyGroupStateWarning struct {
-	// Group state that could not be applied.
-	State *GroupState `json:"qState,omitempty"`
-	// Nature of the warning.
-	//
-	// One of:
-	//
-	// • group_missing or GROUP_MISSING
-	//
-	// • group_not_applicable or GROUP_NOT_APPLICABLE
-	//
-	// • fielddef_missing or FIELDDEF_MISSING
-	Type string `json:"qType,omitempty"`
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e ApplyGroupStateWarningType) String() string {
+	return string(e)
 }
 
-// Result of applying GroupState to multiple cyclic groups.
-// Stability: experimental
-type ApplyGroupStatesResult struct {
-	// When true, the operation was successful.
-	ApplySuccess bool `json:"qApplySuccess,omitempty"`
-	// Lists which states failed to be applied and why.
-	Warnings []*ApplyGroupStateWarning `json:"qWarnings,omitempty"`
+// IsValid tells if the value is one of the ApplyGroupStateWarningType constants
+func (e ApplyGroupStateWarningType) IsValid() bool {
+	_, ok := valuesOfApplyGroupStateWarningType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e ApplyGroupStateWarningType) Int() (int, bool) {
+	n, ok := valuesOfApplyGroupStateWarningType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *ApplyGroupStateWarningType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfApplyGroupStateWarningType, "ApplyGroupStateWarningType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type ArrayOfNxValuePoint []*NxPivotValuePoint
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v ArrayOfNxValuePoint) Validate() error {
+	return v.validate("")
+}
+
+func (v ArrayOfNxValuePoint) validate(path string) error {
+	for i0, item0 := range v {
+		if err := item0.validate(indexPath(path, i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type AssociationScore struct {
 	// Pair of fields.
 	// _< FieldName1>_ / < FieldName2>
@@ -141,6 +293,21 @@ type AssociationScore struct {
 	Field2Scores *FieldScores `json:"qField2Scores,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *AssociationScore) Validate() error {
+	return v.validate("")
+}
+
+func (v *AssociationScore) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qScoreSummary"), v.ScoreSummary, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type BNFDef struct {
 	// Array of token references that all together build up the definition of the current token.
 	// Generally, if the array is not empty, the definition is a BNF rule (_qIsBnfRule_ is set to true). However, some BNF  rules do have an empty array (_qIsBnfRule_ is set to true, but qBnf is empty).
@@ -249,7 +416,7 @@ type BNFDef struct {
 	// • DB or FUNC_GROUP_DB_NATIVE
 	//
 	// • WINDOW or FUNC_GROUP_WINDOW
-	FG string `json:"qFG,omitempty"`
+	FG FunctionGroup `json:"qFG,omitempty"`
 	// If set to true, the definition is related to a field.
 	// This parameter is optional. The default value is false.
 	FieldFlag bool `json:"qFieldFlag,omitempty"`
@@ -264,13 +431,132 @@ type BNFDef struct {
 	// • R or META_RET_TYPE
 	//
 	// • V or META_DEFAULT_VALUE
-	MT string `json:"qMT,omitempty"`
+	MT BNFDefMetaType `json:"qMT,omitempty"`
 	// Indicates whether a script statement, a chart or a script function is deprecated (not recommended for use).
 	// If set to true, the script statement or the function is not recommended for use in Qlik Sense.
 	// This parameter is optional. The default value is false.
 	Depr bool `json:"qDepr,omitempty"`
 	// List of groups the function belongs to.
-	FGList []string `json:"qFGList,omitempty"`
+	FGList []FunctionGroup `json:"qFGList,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *BNFDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *BNFDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Bnf {
+		if err := validateRange(indexPath(joinPath(path, "qBnf"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qNbr"), v.Nbr, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qPNbr"), v.PNbr, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHelpId"), v.HelpId, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qFG"), v.FG); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qMT"), v.MT); err != nil {
+		return err
+	}
+	for i0, item0 := range v.FGList {
+		if err := validateEnum(indexPath(joinPath(path, "qFGList"), i0), item0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type BNFDefMetaType string
+
+const (
+	BNFDefMetaTypeNotMeta          BNFDefMetaType = "N"
+	BNFDefMetaTypeMetaDocName      BNFDefMetaType = "D"
+	BNFDefMetaTypeMetaRetType      BNFDefMetaType = "R"
+	BNFDefMetaTypeMetaDefaultValue BNFDefMetaType = "V"
+)
+
+var valuesOfBNFDefMetaType = map[BNFDefMetaType]int{
+	BNFDefMetaTypeNotMeta:          0,
+	BNFDefMetaTypeMetaDocName:      1,
+	BNFDefMetaTypeMetaRetType:      2,
+	BNFDefMetaTypeMetaDefaultValue: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e BNFDefMetaType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the BNFDefMetaType constants
+func (e BNFDefMetaType) IsValid() bool {
+	_, ok := valuesOfBNFDefMetaType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e BNFDefMetaType) Int() (int, bool) {
+	n, ok := valuesOfBNFDefMetaType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *BNFDefMetaType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfBNFDefMetaType, "BNFDefMetaType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type BNFType string
+
+const (
+	BNFTypeScript     BNFType = "S"
+	BNFTypeExpression BNFType = "E"
+)
+
+var valuesOfBNFType = map[BNFType]int{
+	BNFTypeScript:     0,
+	BNFTypeExpression: 1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e BNFType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the BNFType constants
+func (e BNFType) IsValid() bool {
+	_, ok := valuesOfBNFType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e BNFType) Int() (int, bool) {
+	n, ok := valuesOfBNFType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *BNFType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfBNFType, "BNFType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type Bookmark struct {
@@ -302,14 +588,44 @@ type Bookmark struct {
 	IncludeAllVariables   bool                  `json:"qIncludeAllVariables,omitempty"`
 }
 
-// Stability: experimental
-type BookmarkApplyAndVerifyResult struct {
-	// Apply successfully or not *
-	ApplySuccess bool `json:"qApplySuccess,omitempty"`
-	// Field values verfication result *
-	Warnings []*BookmarkFieldVerifyWarning `json:"qWarnings,omitempty"`
-	// Result of applying group states (if any).
-	GroupStateResult *ApplyGroupStatesResult `json:"qGroupStateResult,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Bookmark) Validate() error {
+	return v.validate("")
+}
+
+func (v *Bookmark) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qRecallCount"), v.RecallCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.FieldItems {
+		if err := item0.validate(indexPath(joinPath(path, "qFieldItems"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Objects {
+		if err := item0.validate(indexPath(joinPath(path, "qObjects"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Groups {
+		if err := item0.validate(indexPath(joinPath(path, "qGroups"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ObjectsLayout {
+		if err := item0.validate(indexPath(joinPath(path, "qObjectsLayout"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AlternateStateData {
+		if err := item0.validate(indexPath(joinPath(path, "qAlternateStateData"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type BookmarkFieldItem struct {
@@ -339,6 +655,34 @@ type BookmarkFieldItem struct {
 	ExcludedValuesCount *int `json:"qExcludedValuesCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *BookmarkFieldItem) Validate() error {
+	return v.validate("")
+}
+
+func (v *BookmarkFieldItem) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Def.validate(joinPath(path, "qDef")); err != nil {
+		return err
+	}
+	if err := v.SelectInfo.validate(joinPath(path, "qSelectInfo")); err != nil {
+		return err
+	}
+	if v.ValuesCount != nil {
+		if err := validateRange(joinPath(path, "qValuesCount"), *v.ValuesCount, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if v.ExcludedValuesCount != nil {
+		if err := validateRange(joinPath(path, "qExcludedValuesCount"), *v.ExcludedValuesCount, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines the range of the bookmark fields that are returned.
 type BookmarkFieldPage struct {
 	// The start value of the range.
@@ -347,6 +691,24 @@ type BookmarkFieldPage struct {
 	EndIndex int `json:"qEndIndex,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *BookmarkFieldPage) Validate() error {
+	return v.validate("")
+}
+
+func (v *BookmarkFieldPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qStartIndex"), v.StartIndex, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qEndIndex"), v.EndIndex, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type BookmarkFieldPageEx struct {
 	// The name of the selected state.
 	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
@@ -357,27 +719,67 @@ type BookmarkFieldPageEx struct {
 	EndIndex int `json:"qEndIndex,omitempty"`
 }
 
-// Stability: experimental
-type BookmarkFieldVerifyWarning struct {
-	// Alternate State *
-	State string `json:"qState,omitempty"`
-	// Field Name *
-	Field string `json:"qField,omitempty"`
-	// Field/values verfication result *
-	// Defines result of ApplyAndVerify.
-	// One of:
-	//
-	// • NOT_VERIFIED
-	//
-	// • FIELD_VALUE_MATCH_ALL
-	//
-	// • FIELD_MISSING
-	//
-	// • FIELD_VALUE_MISSING
-	//
-	// • STATE_MISSING
-	VerifyResult  string   `json:"qVerifyResult,omitempty"`
-	MissingValues []string `json:"qMissingValues,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *BookmarkFieldPageEx) Validate() error {
+	return v.validate("")
+}
+
+func (v *BookmarkFieldPageEx) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qStartIndex"), v.StartIndex, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qEndIndex"), v.EndIndex, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type BookmarkFieldVerifyResultState string
+
+const (
+	BookmarkFieldVerifyResultStateNotVerified        BookmarkFieldVerifyResultState = "NOT_VERIFIED"
+	BookmarkFieldVerifyResultStateFieldValueMatchAll BookmarkFieldVerifyResultState = "FIELD_VALUE_MATCH_ALL"
+	BookmarkFieldVerifyResultStateFieldMissing       BookmarkFieldVerifyResultState = "FIELD_MISSING"
+	BookmarkFieldVerifyResultStateFieldValueMissing  BookmarkFieldVerifyResultState = "FIELD_VALUE_MISSING"
+	BookmarkFieldVerifyResultStateStateMissing       BookmarkFieldVerifyResultState = "STATE_MISSING"
+)
+
+var valuesOfBookmarkFieldVerifyResultState = map[BookmarkFieldVerifyResultState]int{
+	BookmarkFieldVerifyResultStateNotVerified:        0,
+	BookmarkFieldVerifyResultStateFieldValueMatchAll: 1,
+	BookmarkFieldVerifyResultStateFieldMissing:       2,
+	BookmarkFieldVerifyResultStateFieldValueMissing:  3,
+	BookmarkFieldVerifyResultStateStateMissing:       4,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e BookmarkFieldVerifyResultState) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the BookmarkFieldVerifyResultState constants
+func (e BookmarkFieldVerifyResultState) IsValid() bool {
+	_, ok := valuesOfBookmarkFieldVerifyResultState[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e BookmarkFieldVerifyResultState) Int() (int, bool) {
+	n, ok := valuesOfBookmarkFieldVerifyResultState[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *BookmarkFieldVerifyResultState) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfBookmarkFieldVerifyResultState, "BookmarkFieldVerifyResultState")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 // Lists the bookmarks. Is the layout for BookmarkListDef.
@@ -401,6 +803,23 @@ type BookmarkStateFieldPages struct {
 	StatePages []*BookmarkFieldPageEx `json:"qStatePages,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *BookmarkStateFieldPages) Validate() error {
+	return v.validate("")
+}
+
+func (v *BookmarkStateFieldPages) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.StatePages {
+		if err := item0.validate(indexPath(joinPath(path, "qStatePages"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BookmarkVariableItem struct {
 	// Name of the variable.
 	Name string `json:"qName,omitempty"`
@@ -421,6 +840,45 @@ type CalendarStrings struct {
 	LongMonthNames []string `json:"qLongMonthNames,omitempty"`
 }
 
+type CharEncodingType string
+
+const (
+	CharEncodingTypeUtf8  CharEncodingType = "Utf8"
+	CharEncodingTypeUtf16 CharEncodingType = "Utf16"
+)
+
+var valuesOfCharEncodingType = map[CharEncodingType]int{
+	CharEncodingTypeUtf8:  0,
+	CharEncodingTypeUtf16: 1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e CharEncodingType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the CharEncodingType constants
+func (e CharEncodingType) IsValid() bool {
+	_, ok := valuesOfCharEncodingType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e CharEncodingType) Int() (int, bool) {
+	n, ok := valuesOfCharEncodingType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *CharEncodingType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfCharEncodingType, "CharEncodingType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type CharRange struct {
 	// Position of the first search occurrence.
 	CharPos int `json:"qCharPos,omitempty"`
@@ -428,6 +886,24 @@ type CharRange struct {
 	CharCount int `json:"qCharCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *CharRange) Validate() error {
+	return v.validate("")
+}
+
+func (v *CharRange) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCharPos"), v.CharPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCharCount"), v.CharCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Lists the children of a generic object. Is the layout for ChildListDef.
 // ChildList is used by the GetLayout Method to list the children of a generic object.
 type ChildList struct {
@@ -452,6 +928,21 @@ type CodePage struct {
 	Description string `json:"qDescription,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *CodePage) Validate() error {
+	return v.validate("")
+}
+
+func (v *CodePage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qNumber"), v.Number, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type CondDef struct {
 	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
 	Always     *bool      `json:"qAlways,omitempty"`
@@ -521,7 +1012,22 @@ type Connection struct {
 	// • LOG_ON_SERVICE_USER
 	//
 	// • LOG_ON_CURRENT_USER
-	LogOn string `json:"qLogOn,omitempty"`
+	LogOn LogOnType `json:"qLogOn,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Connection) Validate() error {
+	return v.validate("")
+}
+
+func (v *Connection) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qLogOn"), v.LogOn); err != nil {
+		return err
+	}
+	return nil
 }
 
 type ContentLibraryList struct {
@@ -554,8 +1060,23 @@ type CustomConnector struct {
 	// • CONNECT_64
 	//
 	// • CONNECT_32
-	MachineMode          string `json:"qMachineMode,omitempty"`
-	SupportFileStreaming bool   `json:"qSupportFileStreaming,omitempty"`
+	MachineMode          GenericConnectMachine `json:"qMachineMode,omitempty"`
+	SupportFileStreaming bool                  `json:"qSupportFileStreaming,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *CustomConnector) Validate() error {
+	return v.validate("")
+}
+
+func (v *CustomConnector) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qMachineMode"), v.MachineMode); err != nil {
+		return err
+	}
+	return nil
 }
 
 type CyclicGroupPosition struct {
@@ -565,6 +1086,21 @@ type CyclicGroupPosition struct {
 	ActiveField int `json:"qActiveField,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *CyclicGroupPosition) Validate() error {
+	return v.validate("")
+}
+
+func (v *CyclicGroupPosition) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qActiveField"), v.ActiveField, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type DataField struct {
 	// Name of the field.
 	Name string `json:"qName,omitempty"`
@@ -677,6 +1213,21 @@ type DelimiterInfo struct {
 	IsMultiple bool `json:"qIsMultiple,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *DelimiterInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *DelimiterInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qNumber"), v.Number, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type DerivedFieldsInTableData struct {
 	// Name of the derived definition.
 	DefinitionName string `json:"qDefinitionName,omitempty"`
@@ -732,6 +1283,21 @@ type DoReloadExParams struct {
 	RowLimit *int `json:"qRowLimit,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *DoReloadExParams) Validate() error {
+	return v.validate("")
+}
+
+func (v *DoReloadExParams) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qMode"), v.Mode, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // The result and path to script log for a reload.
 type DoReloadExResult struct {
 	// The reload is successful if True.
@@ -744,6 +1310,21 @@ type DoReloadExResult struct {
 	FailureData *FailureData `json:"qFailureData,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *DoReloadExResult) Validate() error {
+	return v.validate("")
+}
+
+func (v *DoReloadExResult) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.FailureData.validate(joinPath(path, "qFailureData")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type DocListEntry struct {
 	// Name of the app.
 	DocName string `json:"qDocName,omitempty"`
@@ -786,7 +1367,25 @@ type DocListEntry struct {
 	// • DATAFLOW_PREP
 	//
 	// • SINGLE_TABLE_PREP
-	Usage string `json:"qUsage,omitempty"`
+	Usage UsageEnum `json:"qUsage,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *DocListEntry) Validate() error {
+	return v.validate("")
+}
+
+func (v *DocListEntry) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qConnectedUsers"), v.ConnectedUsers, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qUsage"), v.Usage); err != nil {
+		return err
+	}
+	return nil
 }
 
 type DriveInfo struct {
@@ -814,26 +1413,103 @@ type DriveInfo struct {
 	// • RAM
 	//
 	// • UNKNOWN_TYPE
-	TypeIdentifier string `json:"qTypeIdentifier,omitempty"`
-	UnnamedDrive   bool   `json:"qUnnamedDrive,omitempty"`
+	TypeIdentifier DriveType `json:"qTypeIdentifier,omitempty"`
+	UnnamedDrive   bool      `json:"qUnnamedDrive,omitempty"`
 }
 
-type EditorBreakpoint struct {
-	// Name of the breakpoint.
-	BufferName string `json:"qbufferName,omitempty"`
-	// Line number in the script where the breakpoint is set.
-	LineIx int `json:"qlineIx,omitempty"`
-	// If set to true then the breakpoint is enabled (in use).
-	Enabled bool `json:"qEnabled,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *DriveInfo) Validate() error {
+	return v.validate("")
 }
 
-// Renders the embedded snapshot in an object.
-// The following is returned:
-//
-// • Any dynamic properties defined in the bookmark
-//
-// • Any properties defined in qEmbeddedSnapshot
-//
+func (v *DriveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qTypeIdentifier"), v.TypeIdentifier); err != nil {
+		return err
+	}
+	return nil
+}
+
+type DriveType string
+
+const (
+	DriveTypeRemovable   DriveType = "REMOVABLE"
+	DriveTypeFixed       DriveType = "FIXED"
+	DriveTypeNetwork     DriveType = "NETWORK"
+	DriveTypeCdRom       DriveType = "CD_ROM"
+	DriveTypeRam         DriveType = "RAM"
+	DriveTypeUnknownType DriveType = "UNKNOWN_TYPE"
+)
+
+var valuesOfDriveType = map[DriveType]int{
+	DriveTypeRemovable:   0,
+	DriveTypeFixed:       1,
+	DriveTypeNetwork:     2,
+	DriveTypeCdRom:       3,
+	DriveTypeRam:         4,
+	DriveTypeUnknownType: 5,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e DriveType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the DriveType constants
+func (e DriveType) IsValid() bool {
+	_, ok := valuesOfDriveType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e DriveType) Int() (int, bool) {
+	n, ok := valuesOfDriveType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *DriveType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfDriveType, "DriveType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type EditorBreakpoint struct {
+	// Name of the breakpoint.
+	BufferName string `json:"qbufferName,omitempty"`
+	// Line number in the script where the breakpoint is set.
+	LineIx int `json:"qlineIx,omitempty"`
+	// If set to true then the breakpoint is enabled (in use).
+	Enabled bool `json:"qEnabled,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *EditorBreakpoint) Validate() error {
+	return v.validate("")
+}
+
+func (v *EditorBreakpoint) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qlineIx"), v.LineIx, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Renders the embedded snapshot in an object.
+// The following is returned:
+//
+// • Any dynamic properties defined in the bookmark
+//
+// • Any properties defined in qEmbeddedSnapshot
+//
 // Properties:
 //
 // "qEmbeddedSnapshot": {}
@@ -864,15 +1540,89 @@ type ErrorData struct {
 	// • EDC_WARNING
 	//
 	// • EDC_CIRCULAR_REFERENCE
-	ErrorDataCode string           `json:"qErrorDataCode,omitempty"`
+	ErrorDataCode ErrorDataCode    `json:"qErrorDataCode,omitempty"`
 	Message       *ProgressMessage `json:"qMessage,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ErrorData) Validate() error {
+	return v.validate("")
+}
+
+func (v *ErrorData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qErrorDataCode"), v.ErrorDataCode); err != nil {
+		return err
+	}
+	if err := v.Message.validate(joinPath(path, "qMessage")); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ErrorDataCode string
+
+const (
+	ErrorDataCodeError             ErrorDataCode = "EDC_ERROR"
+	ErrorDataCodeWarning           ErrorDataCode = "EDC_WARNING"
+	ErrorDataCodeCircularReference ErrorDataCode = "EDC_CIRCULAR_REFERENCE"
+)
+
+var valuesOfErrorDataCode = map[ErrorDataCode]int{
+	ErrorDataCodeError:             0,
+	ErrorDataCodeWarning:           1,
+	ErrorDataCodeCircularReference: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e ErrorDataCode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the ErrorDataCode constants
+func (e ErrorDataCode) IsValid() bool {
+	_, ok := valuesOfErrorDataCode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e ErrorDataCode) Int() (int, bool) {
+	n, ok := valuesOfErrorDataCode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *ErrorDataCode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfErrorDataCode, "ErrorDataCode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type ExpansionData struct {
 	ExcludeList bool          `json:"qExcludeList,omitempty"`
 	Pos         *PositionMark `json:"qPos,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ExpansionData) Validate() error {
+	return v.validate("")
+}
+
+func (v *ExpansionData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ExtendedLayoutBookmarkData struct {
 	Id                 string                `json:"qId,omitempty"`
 	Active             bool                  `json:"qActive,omitempty"`
@@ -914,11 +1664,34 @@ type ExtendedLayoutBookmarkData struct {
 	// • GRAPH_MODE_MEKKO
 	//
 	// • GRAPH_MODE_LAST
-	GraphMode                    string                  `json:"qGraphMode,omitempty"`
+	GraphMode                    GraphMode               `json:"qGraphMode,omitempty"`
 	ActiveContainerChildObjectId string                  `json:"qActiveContainerChildObjectId,omitempty"`
 	ExtendedPivotState           *ExtendedPivotStateData `json:"qExtendedPivotState,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ExtendedLayoutBookmarkData) Validate() error {
+	return v.validate("")
+}
+
+func (v *ExtendedLayoutBookmarkData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.ScrollPos.validate(joinPath(path, "qScrollPos")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.ExpansionInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qExpansionInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qGraphMode"), v.GraphMode); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ExtendedPivotStateData struct {
 	ExpressionPosition     byte     `json:"qExpressionPosition,omitempty"`
 	NumberOfLeftDimensions byte     `json:"qNumberOfLeftDimensions,omitempty"`
@@ -940,6 +1713,78 @@ type FailureData struct {
 	Errors []*ReloadError `json:"qErrors,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FailureData) Validate() error {
+	return v.validate("")
+}
+
+func (v *FailureData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Errors {
+		if err := item0.validate(indexPath(joinPath(path, "qErrors"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type FieldAttrType string
+
+const (
+	FieldAttrTypeUnknown   FieldAttrType = "U"
+	FieldAttrTypeAscii     FieldAttrType = "A"
+	FieldAttrTypeInteger   FieldAttrType = "I"
+	FieldAttrTypeReal      FieldAttrType = "R"
+	FieldAttrTypeFix       FieldAttrType = "F"
+	FieldAttrTypeMoney     FieldAttrType = "M"
+	FieldAttrTypeDate      FieldAttrType = "D"
+	FieldAttrTypeTime      FieldAttrType = "T"
+	FieldAttrTypeTimestamp FieldAttrType = "TS"
+	FieldAttrTypeInterval  FieldAttrType = "IV"
+)
+
+var valuesOfFieldAttrType = map[FieldAttrType]int{
+	FieldAttrTypeUnknown:   0,
+	FieldAttrTypeAscii:     1,
+	FieldAttrTypeInteger:   10,
+	FieldAttrTypeReal:      2,
+	FieldAttrTypeFix:       11,
+	FieldAttrTypeMoney:     12,
+	FieldAttrTypeDate:      3,
+	FieldAttrTypeTime:      4,
+	FieldAttrTypeTimestamp: 5,
+	FieldAttrTypeInterval:  6,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FieldAttrType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FieldAttrType constants
+func (e FieldAttrType) IsValid() bool {
+	_, ok := valuesOfFieldAttrType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FieldAttrType) Int() (int, bool) {
+	n, ok := valuesOfFieldAttrType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FieldAttrType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFieldAttrType, "FieldAttrType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // Sets the formatting of a field.
 // The properties of qFieldAttributes and the formatting mechanism are described below.
 //
@@ -1022,7 +1867,7 @@ type FieldAttributes struct {
 	// • TS or TIMESTAMP
 	//
 	// • IV or INTERVAL
-	Type string `json:"qType,omitempty"`
+	Type FieldAttrType `json:"qType,omitempty"`
 	// Number of decimals.
 	// Default is 10.
 	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
@@ -1048,6 +1893,29 @@ type FieldAttributes struct {
 	Thou string `json:"qThou,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldAttributes) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldAttributes) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if v.NDec != nil {
+		if err := validateRange(joinPath(path, "qnDec"), *v.NDec, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qUseThou"), v.UseThou, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type FieldDefEx struct {
 	// Name of the field.
 	Name string `json:"qName,omitempty"`
@@ -1070,7 +1938,22 @@ type FieldDefEx struct {
 	// • IS_IMPLICIT
 	//
 	// • IS_DETAIL
-	Type string `json:"qType,omitempty"`
+	Type FieldType `json:"qType,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldDefEx) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldDefEx) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	return nil
 }
 
 type FieldDescription struct {
@@ -1123,6 +2006,27 @@ type FieldDescription struct {
 	ByteSize int `json:"qByteSize,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldDescription) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldDescription) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qInternalNumber"), v.InternalNumber, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qPossibleCount_OBSOLETE"), v.PossibleCount_OBSOLETE, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type FieldInTableData struct {
 	// Name of the field.
 	Name string `json:"qName,omitempty"`
@@ -1160,7 +2064,7 @@ type FieldInTableData struct {
 	// • PRIMARY_KEY
 	//
 	// • PERFECT_KEY
-	KeyType string `json:"qKeyType,omitempty"`
+	KeyType KeyType `json:"qKeyType,omitempty"`
 	// Comment related to the field.
 	Comment string `json:"qComment,omitempty"`
 	// List of tags related to the field.
@@ -1171,6 +2075,27 @@ type FieldInTableData struct {
 	ReadableName    string                      `json:"qReadableName,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldInTableData) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldInTableData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qnTotalDistinctValues"), v.NTotalDistinctValues, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qnPresentDistinctValues"), v.NPresentDistinctValues, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qKeyType"), v.KeyType); err != nil {
+		return err
+	}
+	return nil
+}
+
 type FieldInTableProfilingData struct {
 	// Name of the field.
 	Name string `json:"qName,omitempty"`
@@ -1237,12 +2162,47 @@ type FieldInTableProfilingData struct {
 	DataEvenness Float64 `json:"qDataEvenness,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldInTableProfilingData) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldInTableProfilingData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.NumberFormat.validate(joinPath(path, "qNumberFormat")); err != nil {
+		return err
+	}
+	if err := v.FrequencyDistribution.validate(joinPath(path, "qFrequencyDistribution")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Lists the fields present in the data model viewer. Is the layout for FieldListDef.
 type FieldList struct {
 	// Array of items.
 	Items []*NxFieldDescription `json:"qItems,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FieldList) Validate() error {
+	return v.validate("")
+}
+
+func (v *FieldList) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Items {
+		if err := item0.validate(indexPath(joinPath(path, "qItems"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines the fields to show.
 type FieldListDef struct {
 	// Shows the system tables if set to true.
@@ -1291,6 +2251,57 @@ type FieldScores struct {
 	RowScore Float64 `json:"qRowScore,omitempty"`
 }
 
+type FieldType string
+
+const (
+	FieldTypeNotPresent    FieldType = "NOT_PRESENT"
+	FieldTypePresent       FieldType = "PRESENT"
+	FieldTypeIsCyclicGroup FieldType = "IS_CYCLIC_GROUP"
+	FieldTypeIsDrillGroup  FieldType = "IS_DRILL_GROUP"
+	FieldTypeIsVar         FieldType = "IS_VAR"
+	FieldTypeIsExpr        FieldType = "IS_EXPR"
+	FieldTypeIsImplicit    FieldType = "IS_IMPLICIT"
+	FieldTypeIsDetail      FieldType = "IS_DETAIL"
+)
+
+var valuesOfFieldType = map[FieldType]int{
+	FieldTypeNotPresent:    0,
+	FieldTypePresent:       1,
+	FieldTypeIsCyclicGroup: 2,
+	FieldTypeIsDrillGroup:  3,
+	FieldTypeIsVar:         4,
+	FieldTypeIsExpr:        5,
+	FieldTypeIsImplicit:    6,
+	FieldTypeIsDetail:      7,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FieldType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FieldType constants
+func (e FieldType) IsValid() bool {
+	_, ok := valuesOfFieldType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FieldType) Int() (int, bool) {
+	n, ok := valuesOfFieldType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FieldType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFieldType, "FieldType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type FieldValue struct {
 	// Text related to the field value.
 	// This parameter is optional.
@@ -1359,7 +2370,7 @@ type FileDataFormat struct {
 	// • KML or FILE_TYPE_KML
 	//
 	// • PARQUET or FILE_TYPE_PARQUET
-	Type string `json:"qType,omitempty"`
+	Type FileType `json:"qType,omitempty"`
 	// One of:
 	//
 	// • Embedded labels (field names are present in the file)
@@ -1409,14 +2420,157 @@ type FileDataFormat struct {
 	FixedWidthDelimiters string `json:"qFixedWidthDelimiters,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FileDataFormat) Validate() error {
+	return v.validate("")
+}
+
+func (v *FileDataFormat) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := v.Delimiter.validate(joinPath(path, "qDelimiter")); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCodePage"), v.CodePage, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHeaderSize"), v.HeaderSize, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qRecordSize"), v.RecordSize, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTabSize"), v.TabSize, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type FileType string
+
+const (
+	FileTypeCsv        FileType = "CSV"
+	FileTypeFix        FileType = "FIX"
+	FileTypeDif        FileType = "DIF"
+	FileTypeExcelBiff  FileType = "EXCEL_BIFF"
+	FileTypeExcelOoxml FileType = "EXCEL_OOXML"
+	FileTypeHtml       FileType = "HTML"
+	FileTypeQvd        FileType = "QVD"
+	FileTypeXml        FileType = "XML"
+	FileTypeQvx        FileType = "QVX"
+	FileTypeJson       FileType = "JSON"
+	FileTypeKml        FileType = "KML"
+	FileTypeParquet    FileType = "PARQUET"
+)
+
+var valuesOfFileType = map[FileType]int{
+	FileTypeCsv:        0,
+	FileTypeFix:        1,
+	FileTypeDif:        2,
+	FileTypeExcelBiff:  3,
+	FileTypeExcelOoxml: 4,
+	FileTypeHtml:       5,
+	FileTypeQvd:        6,
+	FileTypeXml:        7,
+	FileTypeQvx:        8,
+	FileTypeJson:       9,
+	FileTypeKml:        10,
+	FileTypeParquet:    11,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FileType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FileType constants
+func (e FileType) IsValid() bool {
+	_, ok := valuesOfFileType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FileType) Int() (int, bool) {
+	n, ok := valuesOfFileType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FileType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFileType, "FileType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type FilterInfo struct {
 	// One of:
 	//
 	// • NONE or FILTER_TYPE_NONE
 	//
 	// • RAW or FILTER_TYPE_RAW
-	Type           string `json:"qType,omitempty"`
-	WherePredicate string `json:"qWherePredicate,omitempty"`
+	Type           FilterType `json:"qType,omitempty"`
+	WherePredicate string     `json:"qWherePredicate,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FilterInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *FilterInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	return nil
+}
+
+type FilterType string
+
+const (
+	FilterTypeNone FilterType = "NONE"
+	FilterTypeRaw  FilterType = "RAW"
+)
+
+var valuesOfFilterType = map[FilterType]int{
+	FilterTypeNone: 0,
+	FilterTypeRaw:  1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FilterType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FilterType constants
+func (e FilterType) IsValid() bool {
+	_, ok := valuesOfFilterType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FilterType) Int() (int, bool) {
+	n, ok := valuesOfFilterType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FilterType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFilterType, "FilterType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type FolderItem struct {
@@ -1431,7 +2585,63 @@ type FolderItem struct {
 	// • FILE or FOLDER_ITEM_FILE
 	//
 	// • OTHER or FOLDER_ITEM_OTHER
-	Type string `json:"qType,omitempty"`
+	Type FolderItemType `json:"qType,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FolderItem) Validate() error {
+	return v.validate("")
+}
+
+func (v *FolderItem) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	return nil
+}
+
+type FolderItemType string
+
+const (
+	FolderItemTypeFolder FolderItemType = "FOLDER"
+	FolderItemTypeFile   FolderItemType = "FILE"
+	FolderItemTypeOther  FolderItemType = "OTHER"
+)
+
+var valuesOfFolderItemType = map[FolderItemType]int{
+	FolderItemTypeFolder: 0,
+	FolderItemTypeFile:   1,
+	FolderItemTypeOther:  2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FolderItemType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FolderItemType constants
+func (e FolderItemType) IsValid() bool {
+	_, ok := valuesOfFolderItemType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FolderItemType) Int() (int, bool) {
+	n, ok := valuesOfFolderItemType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FolderItemType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFolderItemType, "FolderItemType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type FrequencyDistributionData struct {
@@ -1443,6 +2653,26 @@ type FrequencyDistributionData struct {
 	Frequencies []int `json:"qFrequencies,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *FrequencyDistributionData) Validate() error {
+	return v.validate("")
+}
+
+func (v *FrequencyDistributionData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qNumberOfBins"), v.NumberOfBins, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Frequencies {
+		if err := validateRange(indexPath(joinPath(path, "qFrequencies"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type Function struct {
 	// Name of the script function.
 	Name string `json:"qName,omitempty"`
@@ -1513,12 +2743,126 @@ type Function struct {
 	// • DB or FUNC_GROUP_DB_NATIVE
 	//
 	// • WINDOW or FUNC_GROUP_WINDOW
-	Group string `json:"qGroup,omitempty"`
+	Group FunctionGroup `json:"qGroup,omitempty"`
 	// Signature of the script function.
 	// Gives general information about the function.
 	Signature string `json:"qSignature,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Function) Validate() error {
+	return v.validate("")
+}
+
+func (v *Function) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGroup"), v.Group); err != nil {
+		return err
+	}
+	return nil
+}
+
+type FunctionGroup string
+
+const (
+	FunctionGroupAll                        FunctionGroup = "ALL"
+	FunctionGroupUnknown                    FunctionGroup = "U"
+	FunctionGroupNone                       FunctionGroup = "NONE"
+	FunctionGroupAggr                       FunctionGroup = "AGGR"
+	FunctionGroupNumeric                    FunctionGroup = "NUM"
+	FunctionGroupRange                      FunctionGroup = "RNG"
+	FunctionGroupExponentialAndLogarithmic  FunctionGroup = "EXP"
+	FunctionGroupTrigonometricAndHyperbolic FunctionGroup = "TRIG"
+	FunctionGroupFinancial                  FunctionGroup = "FIN"
+	FunctionGroupMathConstantAndParamFree   FunctionGroup = "MATH"
+	FunctionGroupCounter                    FunctionGroup = "COUNT"
+	FunctionGroupString                     FunctionGroup = "STR"
+	FunctionGroupMapping                    FunctionGroup = "MAPP"
+	FunctionGroupInterRecord                FunctionGroup = "RCRD"
+	FunctionGroupConditional                FunctionGroup = "CND"
+	FunctionGroupLogical                    FunctionGroup = "LOG"
+	FunctionGroupNull                       FunctionGroup = "NULL"
+	FunctionGroupSystem                     FunctionGroup = "SYS"
+	FunctionGroupFile                       FunctionGroup = "FILE"
+	FunctionGroupTable                      FunctionGroup = "TBL"
+	FunctionGroupDateAndTime                FunctionGroup = "DATE"
+	FunctionGroupNumberInterpret            FunctionGroup = "NUMI"
+	FunctionGroupFormatting                 FunctionGroup = "FRMT"
+	FunctionGroupColor                      FunctionGroup = "CLR"
+	FunctionGroupRanking                    FunctionGroup = "RNK"
+	FunctionGroupGeo                        FunctionGroup = "GEO"
+	FunctionGroupExternal                   FunctionGroup = "EXT"
+	FunctionGroupProbability                FunctionGroup = "PROB"
+	FunctionGroupArray                      FunctionGroup = "ARRAY"
+	FunctionGroupLegacy                     FunctionGroup = "LEG"
+	FunctionGroupDbNative                   FunctionGroup = "DB"
+	FunctionGroupWindow                     FunctionGroup = "WINDOW"
+)
+
+var valuesOfFunctionGroup = map[FunctionGroup]int{
+	FunctionGroupAll:                        0,
+	FunctionGroupUnknown:                    1,
+	FunctionGroupNone:                       2,
+	FunctionGroupAggr:                       3,
+	FunctionGroupNumeric:                    4,
+	FunctionGroupRange:                      5,
+	FunctionGroupExponentialAndLogarithmic:  6,
+	FunctionGroupTrigonometricAndHyperbolic: 7,
+	FunctionGroupFinancial:                  8,
+	FunctionGroupMathConstantAndParamFree:   9,
+	FunctionGroupCounter:                    10,
+	FunctionGroupString:                     11,
+	FunctionGroupMapping:                    12,
+	FunctionGroupInterRecord:                13,
+	FunctionGroupConditional:                14,
+	FunctionGroupLogical:                    15,
+	FunctionGroupNull:                       16,
+	FunctionGroupSystem:                     17,
+	FunctionGroupFile:                       18,
+	FunctionGroupTable:                      19,
+	FunctionGroupDateAndTime:                20,
+	FunctionGroupNumberInterpret:            21,
+	FunctionGroupFormatting:                 22,
+	FunctionGroupColor:                      23,
+	FunctionGroupRanking:                    24,
+	FunctionGroupGeo:                        25,
+	FunctionGroupExternal:                   26,
+	FunctionGroupProbability:                27,
+	FunctionGroupArray:                      28,
+	FunctionGroupLegacy:                     29,
+	FunctionGroupDbNative:                   30,
+	FunctionGroupWindow:                     31,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e FunctionGroup) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the FunctionGroup constants
+func (e FunctionGroup) IsValid() bool {
+	_, ok := valuesOfFunctionGroup[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e FunctionGroup) Int() (int, bool) {
+	n, ok := valuesOfFunctionGroup[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *FunctionGroup) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfFunctionGroup, "FunctionGroup")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type GenericBookmarkEntry struct {
 	// Information about the properties of the bookmark.
 	Properties *GenericBookmarkProperties `json:"qProperties,omitempty"`
@@ -1530,6 +2874,24 @@ type GenericBookmarkEntry struct {
 	ClassicMetadata *MetaData `json:"qClassicMetadata,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericBookmarkEntry) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericBookmarkEntry) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Bookmark.validate(joinPath(path, "qBookmark")); err != nil {
+		return err
+	}
+	if err := v.ClassicBookmark.validate(joinPath(path, "qClassicBookmark")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Is the layout for GenericBookmarkProperties.
 type GenericBookmarkLayout struct {
 	// Information about the object.
@@ -1540,20 +2902,135 @@ type GenericBookmarkLayout struct {
 	Bookmark *NxBookmark `json:"qBookmark,omitempty"`
 	// Information about the field selections associated with the bookmark.
 	FieldInfos []*LayoutFieldInfo `json:"qFieldInfos,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
 }
 
-type GenericBookmarkProperties struct {
-	// Information about the bookmark.
-	// This parameter is mandatory.
-	Info *NxInfo `json:"qInfo,omitempty"`
-	// Definition of the dynamic properties.
-	MetaDef *NxMetaDef `json:"qMetaDef,omitempty"`
-	// If true all variables will be stored in the bookmark.
-	// Stability: experimental
-	IncludeVariables bool `json:"qIncludeVariables,omitempty"`
-	// If true all selected values will be stored distinct, i.e. searchstrings will not be kept.
+var propertiesOfGenericBookmarkLayout = map[string]bool{
+	"qInfo":       true,
+	"qMeta":       true,
+	"qBookmark":   true,
+	"qFieldInfos": true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericBookmarkLayout) MarshalJSON() ([]byte, error) {
+	type plain GenericBookmarkLayout
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericBookmarkLayout) UnmarshalJSON(data []byte) error {
+	type plain GenericBookmarkLayout
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericBookmarkLayout)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericBookmarkLayout) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericBookmarkLayout) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Bookmark.validate(joinPath(path, "qBookmark")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.FieldInfos {
+		if err := item0.validate(indexPath(joinPath(path, "qFieldInfos"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type GenericBookmarkProperties struct {
+	// Information about the bookmark.
+	// This parameter is mandatory.
+	Info *NxInfo `json:"qInfo,omitempty"`
+	// Definition of the dynamic properties.
+	MetaDef *NxMetaDef `json:"qMetaDef,omitempty"`
+	// If true all variables will be stored in the bookmark.
+	// Stability: experimental
+	IncludeVariables bool `json:"qIncludeVariables,omitempty"`
+	// If true all selected values will be stored distinct, i.e. searchstrings will not be kept.
 	// Stability: experimental
 	DistinctValues bool `json:"qDistinctValues,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericBookmarkProperties = map[string]bool{
+	"qInfo":             true,
+	"qMetaDef":          true,
+	"qIncludeVariables": true,
+	"qDistinctValues":   true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericBookmarkProperties) MarshalJSON() ([]byte, error) {
+	type plain GenericBookmarkProperties
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericBookmarkProperties) UnmarshalJSON(data []byte) error {
+	type plain GenericBookmarkProperties
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericBookmarkProperties)
+	return err
+}
+
+type GenericConnectMachine string
+
+const (
+	GenericConnectMachineDefault GenericConnectMachine = "CONNECT_DEFAULT"
+	GenericConnectMachine64      GenericConnectMachine = "CONNECT_64"
+	GenericConnectMachine32      GenericConnectMachine = "CONNECT_32"
+)
+
+var valuesOfGenericConnectMachine = map[GenericConnectMachine]int{
+	GenericConnectMachineDefault: 0,
+	GenericConnectMachine64:      1,
+	GenericConnectMachine32:      2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e GenericConnectMachine) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the GenericConnectMachine constants
+func (e GenericConnectMachine) IsValid() bool {
+	_, ok := valuesOfGenericConnectMachine[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e GenericConnectMachine) Int() (int, bool) {
+	n, ok := valuesOfGenericConnectMachine[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *GenericConnectMachine) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfGenericConnectMachine, "GenericConnectMachine")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type GenericDimensionInfo struct {
@@ -1571,6 +3048,24 @@ type GenericDimensionInfo struct {
 	AndMode bool `json:"qAndMode,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericDimensionInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericDimensionInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qApprMaxGlyphCount"), v.ApprMaxGlyphCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Is the layout for GenericDimensionProperties.
 type GenericDimensionLayout struct {
 	// Identifier and type of the dimension.
@@ -1582,6 +3077,53 @@ type GenericDimensionLayout struct {
 	// Cardinal and tags related to the dimension.
 	// Length of the longest value in the field.
 	DimInfos []*GenericDimensionInfo `json:"qDimInfos,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericDimensionLayout = map[string]bool{
+	"qInfo":     true,
+	"qMeta":     true,
+	"qDim":      true,
+	"qDimInfos": true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericDimensionLayout) MarshalJSON() ([]byte, error) {
+	type plain GenericDimensionLayout
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericDimensionLayout) UnmarshalJSON(data []byte) error {
+	type plain GenericDimensionLayout
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericDimensionLayout)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericDimensionLayout) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericDimensionLayout) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Dim.validate(joinPath(path, "qDim")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.DimInfos {
+		if err := item0.validate(indexPath(joinPath(path, "qDimInfos"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type GenericDimensionProperties struct {
@@ -1593,6 +3135,47 @@ type GenericDimensionProperties struct {
 	Dim *NxLibraryDimensionDef `json:"qDim,omitempty"`
 	// Definition of the dynamic properties.
 	MetaDef *NxMetaDef `json:"qMetaDef,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericDimensionProperties = map[string]bool{
+	"qInfo":    true,
+	"qDim":     true,
+	"qMetaDef": true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericDimensionProperties) MarshalJSON() ([]byte, error) {
+	type plain GenericDimensionProperties
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericDimensionProperties) UnmarshalJSON(data []byte) error {
+	type plain GenericDimensionProperties
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericDimensionProperties)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericDimensionProperties) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericDimensionProperties) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Dim.validate(joinPath(path, "qDim")); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Is the layout for GenericMeasureProperties.
@@ -1603,6 +3186,47 @@ type GenericMeasureLayout struct {
 	Measure *NxLibraryMeasure `json:"qMeasure,omitempty"`
 	// Information on publishing and permissions.
 	Meta *NxMeta `json:"qMeta,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericMeasureLayout = map[string]bool{
+	"qInfo":    true,
+	"qMeasure": true,
+	"qMeta":    true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericMeasureLayout) MarshalJSON() ([]byte, error) {
+	type plain GenericMeasureLayout
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericMeasureLayout) UnmarshalJSON(data []byte) error {
+	type plain GenericMeasureLayout
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericMeasureLayout)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericMeasureLayout) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericMeasureLayout) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Measure.validate(joinPath(path, "qMeasure")); err != nil {
+		return err
+	}
+	return nil
 }
 
 type GenericMeasureProperties struct {
@@ -1614,6 +3238,47 @@ type GenericMeasureProperties struct {
 	Measure *NxLibraryMeasureDef `json:"qMeasure,omitempty"`
 	// Definition of the dynamic properties.
 	MetaDef *NxMetaDef `json:"qMetaDef,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericMeasureProperties = map[string]bool{
+	"qInfo":    true,
+	"qMeasure": true,
+	"qMetaDef": true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericMeasureProperties) MarshalJSON() ([]byte, error) {
+	type plain GenericMeasureProperties
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericMeasureProperties) UnmarshalJSON(data []byte) error {
+	type plain GenericMeasureProperties
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericMeasureProperties)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericMeasureProperties) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericMeasureProperties) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Measure.validate(joinPath(path, "qMeasure")); err != nil {
+		return err
+	}
+	return nil
 }
 
 type GenericObjectEntry struct {
@@ -1625,6 +3290,29 @@ type GenericObjectEntry struct {
 	EmbeddedSnapshotRef *GenericBookmarkEntry `json:"qEmbeddedSnapshotRef,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericObjectEntry) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericObjectEntry) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Property.validate(joinPath(path, "qProperty")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Children {
+		if err := item0.validate(indexPath(joinPath(path, "qChildren"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.EmbeddedSnapshotRef.validate(joinPath(path, "qEmbeddedSnapshotRef")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Is the layout for GenericObjectProperties.
 type GenericObjectLayout struct {
 	// Identifier and type of the generic object.
@@ -1663,6 +3351,93 @@ type GenericObjectLayout struct {
 	TreeData           *TreeData           `json:"qTreeData,omitempty"`
 	UndoInfo           *UndoInfo           `json:"qUndoInfo,omitempty"`
 	VariableList       *VariableList       `json:"qVariableList,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericObjectLayout = map[string]bool{
+	"qInfo":               true,
+	"qMeta":               true,
+	"qExtendsId":          true,
+	"qHasSoftPatches":     true,
+	"qError":              true,
+	"qSelectionInfo":      true,
+	"qStateName":          true,
+	"qAppObjectList":      true,
+	"qBookmarkList":       true,
+	"qChildList":          true,
+	"qDimensionList":      true,
+	"qEmbeddedSnapshot":   true,
+	"qExtensionList":      true,
+	"qFieldList":          true,
+	"qHyperCube":          true,
+	"qListObject":         true,
+	"qMeasureList":        true,
+	"qMediaList":          true,
+	"qNxLibraryDimension": true,
+	"qNxLibraryMeasure":   true,
+	"qSelectionObject":    true,
+	"qStaticContentUrl":   true,
+	"qTreeData":           true,
+	"qUndoInfo":           true,
+	"qVariableList":       true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericObjectLayout) MarshalJSON() ([]byte, error) {
+	type plain GenericObjectLayout
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericObjectLayout) UnmarshalJSON(data []byte) error {
+	type plain GenericObjectLayout
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericObjectLayout)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericObjectLayout) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericObjectLayout) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	if err := v.FieldList.validate(joinPath(path, "qFieldList")); err != nil {
+		return err
+	}
+	if err := v.HyperCube.validate(joinPath(path, "qHyperCube")); err != nil {
+		return err
+	}
+	if err := v.ListObject.validate(joinPath(path, "qListObject")); err != nil {
+		return err
+	}
+	if err := v.NxLibraryDimension.validate(joinPath(path, "qNxLibraryDimension")); err != nil {
+		return err
+	}
+	if err := v.NxLibraryMeasure.validate(joinPath(path, "qNxLibraryMeasure")); err != nil {
+		return err
+	}
+	if err := v.SelectionObject.validate(joinPath(path, "qSelectionObject")); err != nil {
+		return err
+	}
+	if err := v.TreeData.validate(joinPath(path, "qTreeData")); err != nil {
+		return err
+	}
+	if err := v.UndoInfo.validate(joinPath(path, "qUndoInfo")); err != nil {
+		return err
+	}
+	return nil
 }
 
 type GenericObjectProperties struct {
@@ -1698,12 +3473,102 @@ type GenericObjectProperties struct {
 	UndoInfoDef           *UndoInfoDef           `json:"qUndoInfoDef,omitempty"`
 	ValueExpression       *ValueExpression       `json:"qValueExpression,omitempty"`
 	VariableListDef       *VariableListDef       `json:"qVariableListDef,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericObjectProperties = map[string]bool{
+	"qInfo":                  true,
+	"qExtendsId":             true,
+	"qMetaDef":               true,
+	"qStateName":             true,
+	"qAppObjectListDef":      true,
+	"qBookmarkListDef":       true,
+	"qChildListDef":          true,
+	"qDimensionListDef":      true,
+	"qEmbeddedSnapshotDef":   true,
+	"qExtensionListDef":      true,
+	"qFieldListDef":          true,
+	"qHyperCubeDef":          true,
+	"qLayoutExclude":         true,
+	"qListObjectDef":         true,
+	"qMeasureListDef":        true,
+	"qMediaListDef":          true,
+	"qNxLibraryDimensionDef": true,
+	"qNxLibraryMeasureDef":   true,
+	"qSelectionObjectDef":    true,
+	"qStaticContentUrlDef":   true,
+	"qStringExpression":      true,
+	"qTreeDataDef":           true,
+	"qUndoInfoDef":           true,
+	"qValueExpression":       true,
+	"qVariableListDef":       true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericObjectProperties) MarshalJSON() ([]byte, error) {
+	type plain GenericObjectProperties
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericObjectProperties) UnmarshalJSON(data []byte) error {
+	type plain GenericObjectProperties
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericObjectProperties)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericObjectProperties) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericObjectProperties) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.HyperCubeDef.validate(joinPath(path, "qHyperCubeDef")); err != nil {
+		return err
+	}
+	if err := v.ListObjectDef.validate(joinPath(path, "qListObjectDef")); err != nil {
+		return err
+	}
+	if err := v.NxLibraryDimensionDef.validate(joinPath(path, "qNxLibraryDimensionDef")); err != nil {
+		return err
+	}
+	if err := v.NxLibraryMeasureDef.validate(joinPath(path, "qNxLibraryMeasureDef")); err != nil {
+		return err
+	}
+	if err := v.TreeDataDef.validate(joinPath(path, "qTreeDataDef")); err != nil {
+		return err
+	}
+	return nil
 }
 
 type GenericVariableConstraints struct {
-	Type       string    `json:"qType,omitempty"`
-	ValuesText []string  `json:"qValuesText,omitempty"`
-	ValuesNum  []Float64 `json:"qValuesNum,omitempty"`
+	Type       GenericVariableType `json:"qType,omitempty"`
+	ValuesText []string            `json:"qValuesText,omitempty"`
+	ValuesNum  []Float64           `json:"qValuesNum,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericVariableConstraints) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericVariableConstraints) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Is the layout for GenericVariableProperties.
@@ -1720,6 +3585,34 @@ type GenericVariableLayout struct {
 	Num Float64 `json:"qNum,omitempty"`
 	// If set to true, it means that the variable was defined via script.
 	IsScriptCreated bool `json:"qIsScriptCreated,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericVariableLayout = map[string]bool{
+	"qInfo":            true,
+	"qMeta":            true,
+	"qText":            true,
+	"qNum":             true,
+	"qIsScriptCreated": true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericVariableLayout) MarshalJSON() ([]byte, error) {
+	type plain GenericVariableLayout
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericVariableLayout) UnmarshalJSON(data []byte) error {
+	type plain GenericVariableLayout
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericVariableLayout)
+	return err
 }
 
 type GenericVariableProperties struct {
@@ -1746,6 +3639,159 @@ type GenericVariableProperties struct {
 	// Definition of the variable.
 	Definition  string                      `json:"qDefinition,omitempty"`
 	Constraints *GenericVariableConstraints `json:"qConstraints,omitempty"`
+	// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.
+	// They are kept when unmarshalling and marshalled again together with the other fields.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+var propertiesOfGenericVariableProperties = map[string]bool{
+	"qInfo":               true,
+	"qMetaDef":            true,
+	"qName":               true,
+	"qComment":            true,
+	"qNumberPresentation": true,
+	"qIncludeInBookmark":  true,
+	"qDefinition":         true,
+	"qConstraints":        true,
+}
+
+// MarshalJSON marshals the fields and the Extra properties
+func (v GenericVariableProperties) MarshalJSON() ([]byte, error) {
+	type plain GenericVariableProperties
+	return marshalWithExtra((*plain)(&v), v.Extra)
+}
+
+// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra
+func (v *GenericVariableProperties) UnmarshalJSON(data []byte) error {
+	type plain GenericVariableProperties
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unmarshalExtra(data, propertiesOfGenericVariableProperties)
+	return err
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GenericVariableProperties) Validate() error {
+	return v.validate("")
+}
+
+func (v *GenericVariableProperties) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.NumberPresentation.validate(joinPath(path, "qNumberPresentation")); err != nil {
+		return err
+	}
+	if err := v.Constraints.validate(joinPath(path, "qConstraints")); err != nil {
+		return err
+	}
+	return nil
+}
+
+type GenericVariableType string
+
+const (
+	GenericVariableTypeAny    GenericVariableType = "any"
+	GenericVariableTypeNumber GenericVariableType = "number"
+	GenericVariableTypeText   GenericVariableType = "text"
+)
+
+var valuesOfGenericVariableType = map[GenericVariableType]int{
+	GenericVariableTypeAny:    0,
+	GenericVariableTypeNumber: 1,
+	GenericVariableTypeText:   2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e GenericVariableType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the GenericVariableType constants
+func (e GenericVariableType) IsValid() bool {
+	_, ok := valuesOfGenericVariableType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e GenericVariableType) Int() (int, bool) {
+	n, ok := valuesOfGenericVariableType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *GenericVariableType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfGenericVariableType, "GenericVariableType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type GraphMode string
+
+const (
+	GraphModeBar           GraphMode = "GRAPH_MODE_BAR"
+	GraphModePie           GraphMode = "GRAPH_MODE_PIE"
+	GraphModePivottable    GraphMode = "GRAPH_MODE_PIVOTTABLE"
+	GraphModeScatter       GraphMode = "GRAPH_MODE_SCATTER"
+	GraphModeLine          GraphMode = "GRAPH_MODE_LINE"
+	GraphModeStraighttable GraphMode = "GRAPH_MODE_STRAIGHTTABLE"
+	GraphModeCombo         GraphMode = "GRAPH_MODE_COMBO"
+	GraphModeRadar         GraphMode = "GRAPH_MODE_RADAR"
+	GraphModeGauge         GraphMode = "GRAPH_MODE_GAUGE"
+	GraphModeGrid          GraphMode = "GRAPH_MODE_GRID"
+	GraphModeBlock         GraphMode = "GRAPH_MODE_BLOCK"
+	GraphModeFunnel        GraphMode = "GRAPH_MODE_FUNNEL"
+	GraphModeMekko         GraphMode = "GRAPH_MODE_MEKKO"
+	GraphModeLast          GraphMode = "GRAPH_MODE_LAST"
+)
+
+var valuesOfGraphMode = map[GraphMode]int{
+	GraphModeBar:           0,
+	GraphModePie:           1,
+	GraphModePivottable:    2,
+	GraphModeScatter:       3,
+	GraphModeLine:          4,
+	GraphModeStraighttable: 5,
+	GraphModeCombo:         6,
+	GraphModeRadar:         7,
+	GraphModeGauge:         8,
+	GraphModeGrid:          9,
+	GraphModeBlock:         10,
+	GraphModeFunnel:        11,
+	GraphModeMekko:         12,
+	GraphModeLast:          13,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e GraphMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the GraphMode constants
+func (e GraphMode) IsValid() bool {
+	_, ok := valuesOfGraphMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e GraphMode) Int() (int, bool) {
+	n, ok := valuesOfGraphMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *GraphMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfGraphMode, "GraphMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type GroupBookmarkData struct {
@@ -1753,6 +3799,21 @@ type GroupBookmarkData struct {
 	CyclePos int    `json:"qCyclePos,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *GroupBookmarkData) Validate() error {
+	return v.validate("")
+}
+
+func (v *GroupBookmarkData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCyclePos"), v.CyclePos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Stability: experimental
 type GroupState struct {
 	// Target cyclic group.
@@ -1810,7 +3871,7 @@ type HyperCube struct {
 	// • T or DATA_MODE_TREE
 	//
 	// • D or DATA_MODE_DYNAMIC
-	Mode string `json:"qMode,omitempty"`
+	Mode NxHypercubeMode `json:"qMode,omitempty"`
 	// Number of left dimensions.
 	// Default value is -1.
 	// The index related to each left dimension depends on the position of the pseudo dimension (if any).
@@ -1872,6 +3933,78 @@ type HyperCube struct {
 	ColumnOrder []int `json:"qColumnOrder,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *HyperCube) Validate() error {
+	return v.validate("")
+}
+
+func (v *HyperCube) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Size.validate(joinPath(path, "qSize")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.DimensionInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qDimensionInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.MeasureInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qMeasureInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.EffectiveInterColumnSortOrder {
+		if err := validateRange(indexPath(joinPath(path, "qEffectiveInterColumnSortOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.GrandTotalRow {
+		if err := item0.validate(indexPath(joinPath(path, "qGrandTotalRow"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.DataPages {
+		if err := item0.validate(indexPath(joinPath(path, "qDataPages"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.PivotDataPages {
+		if err := item0.validate(indexPath(joinPath(path, "qPivotDataPages"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.StackedDataPages {
+		if err := item0.validate(indexPath(joinPath(path, "qStackedDataPages"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qMode"), v.Mode); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qNoOfLeftDims"), v.NoOfLeftDims, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.LastExpandedPos.validate(joinPath(path, "qLastExpandedPos")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.TreeNodesOnDim {
+		if err := validateRange(indexPath(joinPath(path, "qTreeNodesOnDim"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ColumnOrder {
+		if err := validateRange(indexPath(joinPath(path, "qColumnOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines the properties of a hypercube.
 // For more information about the definition of a hypercube, see Generic object.
 type HyperCubeDef struct {
@@ -1908,7 +4041,7 @@ type HyperCubeDef struct {
 	// • C or DATA_REDUCTION_CLUSTERED
 	//
 	// • ST or DATA_REDUCTION_STACKED
-	ReductionMode string `json:"qReductionMode,omitempty"`
+	ReductionMode NxDataReductionMode `json:"qReductionMode,omitempty"`
 	// Defines the way the data are handled internally by the engine.
 	// Default value is DATAMODE_STRAIGHT_ .
 	// A pivot table can contain several dimensions and measures whereas a stacked pivot table can contain several dimensions but only one measure.
@@ -1924,7 +4057,7 @@ type HyperCubeDef struct {
 	// • T or DATA_MODE_TREE
 	//
 	// • D or DATA_MODE_DYNAMIC
-	Mode string `json:"qMode,omitempty"`
+	Mode NxHypercubeMode `json:"qMode,omitempty"`
 	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
 	PseudoDimPos *int `json:"qPseudoDimPos,omitempty"`
 	// Number of left dimensions.
@@ -2018,6 +4151,72 @@ type HyperCubeDef struct {
 	SuppressMeasureTotals bool `json:"qSuppressMeasureTotals,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *HyperCubeDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *HyperCubeDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Dimensions {
+		if err := item0.validate(indexPath(joinPath(path, "qDimensions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Measures {
+		if err := item0.validate(indexPath(joinPath(path, "qMeasures"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.InterColumnSortOrder {
+		if err := validateRange(indexPath(joinPath(path, "qInterColumnSortOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.InitialDataFetch {
+		if err := item0.validate(indexPath(joinPath(path, "qInitialDataFetch"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qReductionMode"), v.ReductionMode); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qMode"), v.Mode); err != nil {
+		return err
+	}
+	if v.PseudoDimPos != nil {
+		if err := validateRange(joinPath(path, "qPseudoDimPos"), *v.PseudoDimPos, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if v.NoOfLeftDims != nil {
+		if err := validateRange(joinPath(path, "qNoOfLeftDims"), *v.NoOfLeftDims, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if v.MaxStackedCells != nil {
+		if err := validateRange(joinPath(path, "qMaxStackedCells"), *v.MaxStackedCells, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qSortbyYValue"), v.SortbyYValue, -128, 127); err != nil {
+		return err
+	}
+	for i0, item0 := range v.ColumnOrder {
+		if err := validateRange(indexPath(joinPath(path, "qColumnOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ExpansionState {
+		if err := item0.validate(indexPath(joinPath(path, "qExpansionState"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type InputFieldItem struct {
 	FieldName      string        `json:"qFieldName,omitempty"`
 	Values         []*FieldValue `json:"qValues,omitempty"`
@@ -2047,7 +4246,7 @@ type InteractDef struct {
 	// • IT_PASSWD
 	//
 	// • IT_USERNAME
-	Type string `json:"qType,omitempty"`
+	Type InteractType `json:"qType,omitempty"`
 	// Title used in the message box dialog.
 	// This property is relevant if qType is *IT_MSGBOX*.
 	Title string `json:"qTitle,omitempty"`
@@ -2084,15 +4283,149 @@ type InteractDef struct {
 	Input string `json:"qInput,omitempty"`
 }
 
-type LayoutBookmarkData struct {
-	Id        string          `json:"qId,omitempty"`
-	Active    bool            `json:"qActive,omitempty"`
-	ShowMode  byte            `json:"qShowMode,omitempty"`
-	ScrollPos *ScrollPosition `json:"qScrollPos,omitempty"`
-}
-
-// Contains JSON to be excluded from validation.
-type LayoutExclude struct {
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *InteractDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *InteractDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qButtons"), v.Buttons, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qOldLineNr"), v.OldLineNr, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qNewLineNr"), v.NewLineNr, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qResult"), v.Result, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type InteractType string
+
+const (
+	InteractTypeMsgbox     InteractType = "IT_MSGBOX"
+	InteractTypeScriptline InteractType = "IT_SCRIPTLINE"
+	InteractTypeBreak      InteractType = "IT_BREAK"
+	InteractTypeInput      InteractType = "IT_INPUT"
+	InteractTypeEnd        InteractType = "IT_END"
+	InteractTypePasswd     InteractType = "IT_PASSWD"
+	InteractTypeUsername   InteractType = "IT_USERNAME"
+)
+
+var valuesOfInteractType = map[InteractType]int{
+	InteractTypeMsgbox:     0,
+	InteractTypeScriptline: 1,
+	InteractTypeBreak:      2,
+	InteractTypeInput:      3,
+	InteractTypeEnd:        4,
+	InteractTypePasswd:     5,
+	InteractTypeUsername:   6,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e InteractType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the InteractType constants
+func (e InteractType) IsValid() bool {
+	_, ok := valuesOfInteractType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e InteractType) Int() (int, bool) {
+	n, ok := valuesOfInteractType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *InteractType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfInteractType, "InteractType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type KeyType string
+
+const (
+	KeyTypeNotKey     KeyType = "NOT_KEY"
+	KeyTypeAnyKey     KeyType = "ANY_KEY"
+	KeyTypePrimaryKey KeyType = "PRIMARY_KEY"
+	KeyTypePerfectKey KeyType = "PERFECT_KEY"
+)
+
+var valuesOfKeyType = map[KeyType]int{
+	KeyTypeNotKey:     0,
+	KeyTypeAnyKey:     1,
+	KeyTypePrimaryKey: 2,
+	KeyTypePerfectKey: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e KeyType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the KeyType constants
+func (e KeyType) IsValid() bool {
+	_, ok := valuesOfKeyType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e KeyType) Int() (int, bool) {
+	n, ok := valuesOfKeyType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *KeyType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfKeyType, "KeyType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type LayoutBookmarkData struct {
+	Id        string          `json:"qId,omitempty"`
+	Active    bool            `json:"qActive,omitempty"`
+	ShowMode  byte            `json:"qShowMode,omitempty"`
+	ScrollPos *ScrollPosition `json:"qScrollPos,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *LayoutBookmarkData) Validate() error {
+	return v.validate("")
+}
+
+func (v *LayoutBookmarkData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.ScrollPos.validate(joinPath(path, "qScrollPos")); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Contains JSON to be excluded from validation.
+type LayoutExclude struct {
 }
 
 // Meta data about the selection in a field.
@@ -2105,6 +4438,24 @@ type LayoutFieldInfo struct {
 	ExcludedValuesCount int `json:"qExcludedValuesCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *LayoutFieldInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *LayoutFieldInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qValuesCount"), v.ValuesCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qExcludedValuesCount"), v.ExcludedValuesCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type LineageInfo struct {
 	// A string indicating the origin of the data:
 	//
@@ -2148,6 +4499,37 @@ type ListObject struct {
 	DataPages []*NxDataPage `json:"qDataPages,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ListObject) Validate() error {
+	return v.validate("")
+}
+
+func (v *ListObject) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Size.validate(joinPath(path, "qSize")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	if err := v.DimensionInfo.validate(joinPath(path, "qDimensionInfo")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Expressions {
+		if err := item0.validate(indexPath(joinPath(path, "qExpressions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.DataPages {
+		if err := item0.validate(indexPath(joinPath(path, "qDataPages"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines the properties of a list object.
 // For more information about the definition of a list object, see Generic object.
 type ListObjectDef struct {
@@ -2173,7 +4555,7 @@ type ListObjectDef struct {
 	// • P or NX_FREQUENCY_PERCENT
 	//
 	// • R or NX_FREQUENCY_RELATIVE
-	FrequencyMode string `json:"qFrequencyMode,omitempty"`
+	FrequencyMode NxFrequencyMode `json:"qFrequencyMode,omitempty"`
 	// If set to true, alternative values are allowed in qData .
 	// If set to false, no alternative values are displayed in qData . Values are excluded instead.
 	// The default value is false.
@@ -2192,6 +4574,32 @@ type ListObjectDef struct {
 	DirectQuerySimplifiedView bool `json:"qDirectQuerySimplifiedView,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ListObjectDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *ListObjectDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Def.validate(joinPath(path, "qDef")); err != nil {
+		return err
+	}
+	if err := v.AutoSortByState.validate(joinPath(path, "qAutoSortByState")); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qFrequencyMode"), v.FrequencyMode); err != nil {
+		return err
+	}
+	for i0, item0 := range v.InitialDataFetch {
+		if err := item0.validate(indexPath(joinPath(path, "qInitialDataFetch"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type LocaleInfo struct {
 	// Decimal separator.
 	DecimalSep string `json:"qDecimalSep,omitempty"`
@@ -2276,6 +4684,69 @@ type LocaleInfo struct {
 	NumericalAbbreviation string `json:"qNumericalAbbreviation,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *LocaleInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *LocaleInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCurrentYear"), v.CurrentYear, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qFirstWeekDay"), v.FirstWeekDay, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qReferenceDay"), v.ReferenceDay, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qFirstMonthOfYear"), v.FirstMonthOfYear, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type LogOnType string
+
+const (
+	LogOnTypeServiceUser LogOnType = "LOG_ON_SERVICE_USER"
+	LogOnTypeCurrentUser LogOnType = "LOG_ON_CURRENT_USER"
+)
+
+var valuesOfLogOnType = map[LogOnType]int{
+	LogOnTypeServiceUser: 0,
+	LogOnTypeCurrentUser: 1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e LogOnType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the LogOnType constants
+func (e LogOnType) IsValid() bool {
+	_, ok := valuesOfLogOnType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e LogOnType) Int() (int, bool) {
+	n, ok := valuesOfLogOnType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *LogOnType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfLogOnType, "LogOnType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // Lists the measures. Is the layout for MeasureListDef.
 type MeasureList struct {
 	// Information about the list of measures.
@@ -2397,7 +4868,7 @@ type NxAppLayout struct {
 	// If set to true, the app is in Direct Query Mode.
 	IsDirectQueryMode bool `json:"qIsDirectQueryMode,omitempty"`
 	// Array of features not supported by the app.
-	UnsupportedFeatures []string `json:"qUnsupportedFeatures,omitempty"`
+	UnsupportedFeatures []NxFeature `json:"qUnsupportedFeatures,omitempty"`
 	// One of:
 	//
 	// • ANALYTICS
@@ -2407,7 +4878,30 @@ type NxAppLayout struct {
 	// • DATAFLOW_PREP
 	//
 	// • SINGLE_TABLE_PREP
-	Usage string `json:"qUsage,omitempty"`
+	Usage UsageEnum `json:"qUsage,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAppLayout) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAppLayout) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.LocaleInfo.validate(joinPath(path, "qLocaleInfo")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.UnsupportedFeatures {
+		if err := validateEnum(indexPath(joinPath(path, "qUnsupportedFeatures"), i0), item0); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qUsage"), v.Usage); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Qlik Sense Desktop:
@@ -2458,7 +4952,22 @@ type NxAppProperties struct {
 	// • DATAFLOW_PREP
 	//
 	// • SINGLE_TABLE_PREP
-	Usage string `json:"qUsage,omitempty"`
+	Usage UsageEnum `json:"qUsage,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAppProperties) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAppProperties) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qUsage"), v.Usage); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Layout for NxAttrDimDef.
@@ -2473,6 +4982,21 @@ type NxAttrDimDef struct {
 	Attribute bool `json:"qAttribute,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAttrDimDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAttrDimDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.SortBy.validate(joinPath(path, "qSortBy")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Layout for NxAttrDimDef.
 type NxAttrDimInfo struct {
 	// Cardinality of the attribute expression.
@@ -2489,6 +5013,27 @@ type NxAttrDimInfo struct {
 	IsCalculated bool `json:"qIsCalculated,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAttrDimInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAttrDimInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.Size.validate(joinPath(path, "qSize")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxAttrExprDef struct {
 	// Definition of the attribute expression.
 	// Example: "Max(OrderID)"
@@ -2507,6 +5052,21 @@ type NxAttrExprDef struct {
 	LabelExpression string `json:"qLabelExpression,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAttrExprDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAttrExprDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Layout for NxAttrExprDef.
 type NxAttrExprInfo struct {
 	// Minimum value.
@@ -2525,11 +5085,43 @@ type NxAttrExprInfo struct {
 	IsAutoFormat bool `json:"qIsAutoFormat,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAttrExprInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAttrExprInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxAttributeDimValues struct {
 	// List of values.
 	Values []*NxSimpleDimValue `json:"qValues,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAttributeDimValues) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAttributeDimValues) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Values {
+		if err := item0.validate(indexPath(joinPath(path, "qValues"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxAttributeExpressionValues struct {
 	// List of attribute expressions values.
 	Values []*NxSimpleValue `json:"qValues,omitempty"`
@@ -2542,6 +5134,21 @@ type NxAutoSortByStateDef struct {
 	DisplayNumberOfRows int `json:"qDisplayNumberOfRows,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxAutoSortByStateDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxAutoSortByStateDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qDisplayNumberOfRows"), v.DisplayNumberOfRows, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxAxisData struct {
 	// List of axis data.
 	Axis []*NxAxisTicks `json:"qAxis,omitempty"`
@@ -2571,6 +5178,33 @@ type NxBookmark struct {
 	GroupStates []*GroupState `json:"qGroupStates,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxBookmark) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxBookmark) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.StateData {
+		if err := item0.validate(indexPath(joinPath(path, "qStateData"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Patches {
+		if err := item0.validate(indexPath(joinPath(path, "qPatches"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.CyclicGroupStates {
+		if err := item0.validate(indexPath(joinPath(path, "qCyclicGroupStates"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxCalcCond struct {
 	// Condition for calculating an hypercube, dimension or measure.
 	Cond *ValueExpr `json:"qCond,omitempty"`
@@ -2589,6 +5223,29 @@ type NxCardinalities struct {
 	AllValuesCardinal *int `json:"qAllValuesCardinal,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxCardinalities) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxCardinalities) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHypercubeCardinal"), v.HypercubeCardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.AllValuesCardinal != nil {
+		if err := validateRange(joinPath(path, "qAllValuesCardinal"), *v.AllValuesCardinal, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxCell struct {
 	// Some text.
 	// This parameter is optional.
@@ -2630,7 +5287,7 @@ type NxCell struct {
 	// • XL or EXCL_LOCKED
 	//
 	// • NSTATES
-	State string `json:"qState,omitempty"`
+	State StateEnumType `json:"qState,omitempty"`
 	// Is set to true , if qText and qNum are empty.
 	// This parameter is optional. The default value is false .
 	IsEmpty bool `json:"qIsEmpty,omitempty"`
@@ -2660,6 +5317,33 @@ type NxCell struct {
 	InExtRow  bool             `json:"qInExtRow,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxCell) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxCell) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNumber"), v.ElemNumber, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qState"), v.State); err != nil {
+		return err
+	}
+	if err := v.HighlightRanges.validate(joinPath(path, "qHighlightRanges")); err != nil {
+		return err
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	if err := v.MiniChart.validate(joinPath(path, "qMiniChart")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxCellPosition struct {
 	// Position of the cell on the x-axis.
 	X int `json:"qx,omitempty"`
@@ -2667,8 +5351,40 @@ type NxCellPosition struct {
 	Y int `json:"qy,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxCellPosition) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxCellPosition) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qx"), v.X, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qy"), v.Y, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxCellRows []*NxCell
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v NxCellRows) Validate() error {
+	return v.validate("")
+}
+
+func (v NxCellRows) validate(path string) error {
+	for i0, item0 := range v {
+		if err := item0.validate(indexPath(path, i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxContainerEntry struct {
 	// Information about the object.
 	Info *NxInfo `json:"qInfo,omitempty"`
@@ -2692,6 +5408,70 @@ type NxContinuousDataOptions struct {
 	MaxNumberLines *int `json:"qMaxNumberLines,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxContinuousDataOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxContinuousDataOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qNbrPoints"), v.NbrPoints, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qMaxNbrTicks"), v.MaxNbrTicks, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.MaxNumberLines != nil {
+		if err := validateRange(joinPath(path, "qMaxNumberLines"), *v.MaxNumberLines, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NxContinuousMode string
+
+const (
+	NxContinuousModeNever      NxContinuousMode = "Never"
+	NxContinuousModeIfPossible NxContinuousMode = "Possible"
+	NxContinuousModeIfTime     NxContinuousMode = "Time"
+)
+
+var valuesOfNxContinuousMode = map[NxContinuousMode]int{
+	NxContinuousModeNever:      0,
+	NxContinuousModeIfPossible: 1,
+	NxContinuousModeIfTime:     2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxContinuousMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxContinuousMode constants
+func (e NxContinuousMode) IsValid() bool {
+	_, ok := valuesOfNxContinuousMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxContinuousMode) Int() (int, bool) {
+	n, ok := valuesOfNxContinuousMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxContinuousMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxContinuousMode, "NxContinuousMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxContinuousRangeSelectInfo struct {
 	// Range information.
 	Range *Range `json:"qRange,omitempty"`
@@ -2699,6 +5479,21 @@ type NxContinuousRangeSelectInfo struct {
 	DimIx int `json:"qDimIx,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxContinuousRangeSelectInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxContinuousRangeSelectInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qDimIx"), v.DimIx, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxCurrentSelectionItem struct {
 	// Number of values in the field.
 	Total int `json:"qTotal,omitempty"`
@@ -2747,6 +5542,43 @@ type NxCurrentSelectionItem struct {
 	DimensionReferences []*DimensionReference `json:"qDimensionReferences,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxCurrentSelectionItem) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxCurrentSelectionItem) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qTotal"), v.Total, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSelectedCount"), v.SelectedCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortIndex"), v.SortIndex, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.StateCounts.validate(joinPath(path, "qStateCounts")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SelectedFieldSelectionInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qSelectedFieldSelectionInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.NotSelectedFieldSelectionInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qNotSelectedFieldSelectionInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qSelectionThreshold"), v.SelectionThreshold, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxDataAreaPage struct {
 	// Position from the left.
 	// Corresponds to the lowest possible value of the first measure (the measure on the x-axis).
@@ -2776,28 +5608,115 @@ type NxDataPage struct {
 	IsReduced bool `json:"qIsReduced,omitempty"`
 }
 
-type NxDerivedField struct {
-	// Identifier of the derived field.
-	// The identifier is unique.
-	Id string `json:"qId,omitempty"`
-	// Combination of field name, definition and method.
-	// Example:
-	// _OrderDate.MyDefinition.Year_
-	Name string `json:"qName,omitempty"`
-	// Method name associated to the derived field.
-	Method string `json:"qMethod,omitempty"`
-	// Expression of the derived field.
-	// Example:
-	// If qName is OrderDate.MyDefinition.Year , the expression is as follows:
-	// _=${Mydefinition(OrderDate).Year}_
-	Expr string `json:"qExpr,omitempty"`
-	// List of tags.
-	Tags []string `json:"qTags,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDataPage) Validate() error {
+	return v.validate("")
 }
 
-type NxDerivedFieldDescriptionList struct {
-	// Information about the derived fields.
-	DerivedFieldLists []*NxDerivedFieldsData `json:"qDerivedFieldLists,omitempty"`
+func (v *NxDataPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Matrix {
+		if err := item0.validate(indexPath(joinPath(path, "qMatrix"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Tails {
+		if err := item0.validate(indexPath(joinPath(path, "qTails"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Area.validate(joinPath(path, "qArea")); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxDataReductionMode string
+
+const (
+	NxDataReductionModeNone      NxDataReductionMode = "N"
+	NxDataReductionModeOnedim    NxDataReductionMode = "D1"
+	NxDataReductionModeScattered NxDataReductionMode = "S"
+	NxDataReductionModeClustered NxDataReductionMode = "C"
+	NxDataReductionModeStacked   NxDataReductionMode = "ST"
+)
+
+var valuesOfNxDataReductionMode = map[NxDataReductionMode]int{
+	NxDataReductionModeNone:      0,
+	NxDataReductionModeOnedim:    1,
+	NxDataReductionModeScattered: 2,
+	NxDataReductionModeClustered: 3,
+	NxDataReductionModeStacked:   4,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxDataReductionMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxDataReductionMode constants
+func (e NxDataReductionMode) IsValid() bool {
+	_, ok := valuesOfNxDataReductionMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxDataReductionMode) Int() (int, bool) {
+	n, ok := valuesOfNxDataReductionMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxDataReductionMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxDataReductionMode, "NxDataReductionMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type NxDerivedField struct {
+	// Identifier of the derived field.
+	// The identifier is unique.
+	Id string `json:"qId,omitempty"`
+	// Combination of field name, definition and method.
+	// Example:
+	// _OrderDate.MyDefinition.Year_
+	Name string `json:"qName,omitempty"`
+	// Method name associated to the derived field.
+	Method string `json:"qMethod,omitempty"`
+	// Expression of the derived field.
+	// Example:
+	// If qName is OrderDate.MyDefinition.Year , the expression is as follows:
+	// _=${Mydefinition(OrderDate).Year}_
+	Expr string `json:"qExpr,omitempty"`
+	// List of tags.
+	Tags []string `json:"qTags,omitempty"`
+}
+
+type NxDerivedFieldDescriptionList struct {
+	// Information about the derived fields.
+	DerivedFieldLists []*NxDerivedFieldsData `json:"qDerivedFieldLists,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDerivedFieldDescriptionList) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDerivedFieldDescriptionList) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.DerivedFieldLists {
+		if err := item0.validate(indexPath(joinPath(path, "qDerivedFieldLists"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type NxDerivedFieldsData struct {
@@ -2811,6 +5730,23 @@ type NxDerivedFieldsData struct {
 	Tags []string `json:"qTags,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDerivedFieldsData) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDerivedFieldsData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.GroupDefs {
+		if err := item0.validate(indexPath(joinPath(path, "qGroupDefs"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxDerivedGroup struct {
 	// Identifier of the group.
 	Id string `json:"qId,omitempty"`
@@ -2827,11 +5763,81 @@ type NxDerivedGroup struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// List of the derived fields in the group.
 	FieldDefs []string `json:"qFieldDefs,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDerivedGroup) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDerivedGroup) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxDimCellType string
+
+const (
+	NxDimCellTypeValue     NxDimCellType = "V"
+	NxDimCellTypeEmpty     NxDimCellType = "E"
+	NxDimCellTypeNormal    NxDimCellType = "N"
+	NxDimCellTypeTotal     NxDimCellType = "T"
+	NxDimCellTypeOther     NxDimCellType = "O"
+	NxDimCellTypeAggr      NxDimCellType = "A"
+	NxDimCellTypePseudo    NxDimCellType = "P"
+	NxDimCellTypeRoot      NxDimCellType = "R"
+	NxDimCellTypeNull      NxDimCellType = "U"
+	NxDimCellTypeGenerated NxDimCellType = "G"
+)
+
+var valuesOfNxDimCellType = map[NxDimCellType]int{
+	NxDimCellTypeValue:     0,
+	NxDimCellTypeEmpty:     1,
+	NxDimCellTypeNormal:    2,
+	NxDimCellTypeTotal:     3,
+	NxDimCellTypeOther:     4,
+	NxDimCellTypeAggr:      5,
+	NxDimCellTypePseudo:    6,
+	NxDimCellTypeRoot:      7,
+	NxDimCellTypeNull:      8,
+	NxDimCellTypeGenerated: 9,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxDimCellType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxDimCellType constants
+func (e NxDimCellType) IsValid() bool {
+	_, ok := valuesOfNxDimCellType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxDimCellType) Int() (int, bool) {
+	n, ok := valuesOfNxDimCellType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxDimCellType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxDimCellType, "NxDimCellType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // The fields or expressions in the dimension are either defined in qDef or in the master dimension referred to by qLibraryId. If qLibraryId is set then the qFieldDefs, qFieldLabels, qGrouping, qLabelExpression and qAlias of the master dimension will be used.
 // If the dimension is set in the hypercube and not in the library, this dimension cannot be shared with other objects.
 // A dimension that is set in the library can be used by many objects.
@@ -2886,6 +5892,34 @@ type NxDimension struct {
 	CalcCondition *NxCalcCond `json:"qCalcCondition,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDimension) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDimension) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Def.validate(joinPath(path, "qDef")); err != nil {
+		return err
+	}
+	if err := v.OtherTotalSpec.validate(joinPath(path, "qOtherTotalSpec")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.AttributeExpressions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeExpressions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttributeDimensions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeDimensions"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxDimensionInfo struct {
 	// Corresponds to the label of the dimension that is selected.
 	// If the label is not defined then the field name is used.
@@ -2908,7 +5942,7 @@ type NxDimensionInfo struct {
 	// • A or NX_SORT_INDICATE_ASC
 	//
 	// • D or NX_SORT_INDICATE_DESC
-	SortIndicator string `json:"qSortIndicator,omitempty"`
+	SortIndicator NxSortIndicatorType `json:"qSortIndicator,omitempty"`
 	// Array of dimension labels.
 	// Contains the labels of all dimensions in a hierarchy group (for example the labels of all dimensions in a drill down group).
 	GroupFallbackTitles []string `json:"qGroupFallbackTitles,omitempty"`
@@ -2932,7 +5966,7 @@ type NxDimensionInfo struct {
 	// • N or NX_DIMENSION_TYPE_NUMERIC
 	//
 	// • T or NX_DIMENSION_TYPE_TIME
-	DimensionType string `json:"qDimensionType,omitempty"`
+	DimensionType NxDimensionType `json:"qDimensionType,omitempty"`
 	// If set to true, it inverts the sort criteria in the field.
 	ReverseSort bool `json:"qReverseSort,omitempty"`
 	// Defines the grouping.
@@ -2944,7 +5978,7 @@ type NxDimensionInfo struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// If set to true, it means that the field is a semantic.
 	IsSemantic bool `json:"qIsSemantic,omitempty"`
 	// Format of the field.
@@ -2983,6 +6017,99 @@ type NxDimensionInfo struct {
 	EffectiveDimensionName string `json:"qEffectiveDimensionName,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDimensionInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDimensionInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qApprMaxGlyphCount"), v.ApprMaxGlyphCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qSortIndicator"), v.SortIndicator); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qGroupPos"), v.GroupPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.StateCounts.validate(joinPath(path, "qStateCounts")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qDimensionType"), v.DimensionType); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.AttrExprInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrExprInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttrDimInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrDimInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Cardinalities.validate(joinPath(path, "qCardinalities")); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxDimensionType string
+
+const (
+	NxDimensionTypeDiscrete NxDimensionType = "D"
+	NxDimensionTypeNumeric  NxDimensionType = "N"
+	NxDimensionTypeTime     NxDimensionType = "T"
+)
+
+var valuesOfNxDimensionType = map[NxDimensionType]int{
+	NxDimensionTypeDiscrete: 0,
+	NxDimensionTypeNumeric:  1,
+	NxDimensionTypeTime:     2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxDimensionType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxDimensionType constants
+func (e NxDimensionType) IsValid() bool {
+	_, ok := valuesOfNxDimensionType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxDimensionType) Int() (int, bool) {
+	n, ok := valuesOfNxDimensionType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxDimensionType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxDimensionType, "NxDimensionType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxDownloadInfo struct {
 	// URL to download the reduced app on.
 	Url string `json:"qUrl,omitempty"`
@@ -2991,6 +6118,23 @@ type NxDownloadInfo struct {
 	FileSize *int `json:"qFileSize,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDownloadInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDownloadInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if v.FileSize != nil {
+		if err := validateRange(joinPath(path, "qFileSize"), *v.FileSize, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxDownloadOptions struct {
 	// Bookmark Id to apply before reducing the application.
 	BookmarkId string `json:"qBookmarkId,omitempty"`
@@ -3000,11 +6144,185 @@ type NxDownloadOptions struct {
 	ServeOnce bool `json:"qServeOnce,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxDownloadOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxDownloadOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if v.Expires != nil {
+		if err := validateRange(joinPath(path, "qExpires"), *v.Expires, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxEngineVersion struct {
 	// Version number of the Qlik engine component.
 	ComponentVersion string `json:"qComponentVersion,omitempty"`
 }
 
+type NxExportFileType string
+
+const (
+	NxExportFileTypeCsvC    NxExportFileType = "CSV_C"
+	NxExportFileTypeCsvT    NxExportFileType = "CSV_T"
+	NxExportFileTypeOoxml   NxExportFileType = "OOXML"
+	NxExportFileTypeParquet NxExportFileType = "PARQUET"
+)
+
+var valuesOfNxExportFileType = map[NxExportFileType]int{
+	NxExportFileTypeCsvC:    0,
+	NxExportFileTypeCsvT:    1,
+	NxExportFileTypeOoxml:   2,
+	NxExportFileTypeParquet: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxExportFileType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxExportFileType constants
+func (e NxExportFileType) IsValid() bool {
+	_, ok := valuesOfNxExportFileType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxExportFileType) Int() (int, bool) {
+	n, ok := valuesOfNxExportFileType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxExportFileType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxExportFileType, "NxExportFileType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type NxExportState string
+
+const (
+	NxExportStatePossible NxExportState = "P"
+	NxExportStateAll      NxExportState = "A"
+)
+
+var valuesOfNxExportState = map[NxExportState]int{
+	NxExportStatePossible: 0,
+	NxExportStateAll:      1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxExportState) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxExportState constants
+func (e NxExportState) IsValid() bool {
+	_, ok := valuesOfNxExportState[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxExportState) Int() (int, bool) {
+	n, ok := valuesOfNxExportState[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxExportState) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxExportState, "NxExportState")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type NxFeature string
+
+const (
+	NxFeatureBinningData             NxFeature = "binningData"
+	NxFeatureBookmarks               NxFeature = "bookmarks"
+	NxFeatureCalculatedFields        NxFeature = "calculatedFields"
+	NxFeatureContinuousData          NxFeature = "continuousData"
+	NxFeatureInvertedSelections      NxFeature = "invertedSelections"
+	NxFeatureRangeSelections         NxFeature = "rangeSelections"
+	NxFeatureReducingData            NxFeature = "reducingData"
+	NxFeatureSearch                  NxFeature = "search"
+	NxFeatureSelectionCount          NxFeature = "selectionCount"
+	NxFeatureSelectionInsights       NxFeature = "selectionInsights"
+	NxFeatureTableMiniChart          NxFeature = "tableMiniChart"
+	NxFeatureTrendlines              NxFeature = "trendlines"
+	NxFeatureCalculatedDimensions    NxFeature = "calculatedDimensions"
+	NxFeatureIncludeZeroValues       NxFeature = "includeZeroValues"
+	NxFeatureIncludeNullValues       NxFeature = "includeNullValues"
+	NxFeatureFilterPanePaging        NxFeature = "filterPanePaging"
+	NxFeatureFilterPaneCustomSorting NxFeature = "filterPaneCustomSorting"
+	NxFeatureShowFrequency           NxFeature = "showFrequency"
+	NxFeatureLimitation              NxFeature = "limitation"
+	NxFeatureTotals                  NxFeature = "totals"
+)
+
+var valuesOfNxFeature = map[NxFeature]int{
+	NxFeatureBinningData:             0,
+	NxFeatureBookmarks:               1,
+	NxFeatureCalculatedFields:        2,
+	NxFeatureContinuousData:          3,
+	NxFeatureInvertedSelections:      4,
+	NxFeatureRangeSelections:         5,
+	NxFeatureReducingData:            6,
+	NxFeatureSearch:                  7,
+	NxFeatureSelectionCount:          8,
+	NxFeatureSelectionInsights:       9,
+	NxFeatureTableMiniChart:          10,
+	NxFeatureTrendlines:              11,
+	NxFeatureCalculatedDimensions:    12,
+	NxFeatureIncludeZeroValues:       13,
+	NxFeatureIncludeNullValues:       14,
+	NxFeatureFilterPanePaging:        15,
+	NxFeatureFilterPaneCustomSorting: 16,
+	NxFeatureShowFrequency:           17,
+	NxFeatureLimitation:              18,
+	NxFeatureTotals:                  19,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxFeature) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxFeature constants
+func (e NxFeature) IsValid() bool {
+	_, ok := valuesOfNxFeature[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxFeature) Int() (int, bool) {
+	n, ok := valuesOfNxFeature[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxFeature) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxFeature, "NxFeature")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // NxDerivedFieldsdata:
 //
 //	+------------------------+--------------------------------+----------------+
@@ -3049,6 +6367,24 @@ type NxFieldDescription struct {
 	ReadableName string `json:"qReadableName,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxFieldDescription) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxFieldDescription) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.DerivedFieldData.validate(joinPath(path, "qDerivedFieldData")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxFieldProperties struct {
 	// This parameter is set to true, if the field has one and only one selection (not 0 and not more than 1).
 	// If this property is set to true, the field cannot be cleared anymore and no more selections can be performed in that field.
@@ -3084,7 +6420,63 @@ type NxFieldSelectionInfo struct {
 	// • AND or SELECTION_MODE_AND
 	//
 	// • NOT or SELECTION_MODE_NOT
-	FieldSelectionMode string `json:"qFieldSelectionMode,omitempty"`
+	FieldSelectionMode NxFieldSelectionMode `json:"qFieldSelectionMode,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxFieldSelectionInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxFieldSelectionInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qFieldSelectionMode"), v.FieldSelectionMode); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxFieldSelectionMode string
+
+const (
+	NxFieldSelectionModeNormal NxFieldSelectionMode = "NORMAL"
+	NxFieldSelectionModeAnd    NxFieldSelectionMode = "AND"
+	NxFieldSelectionModeNot    NxFieldSelectionMode = "NOT"
+)
+
+var valuesOfNxFieldSelectionMode = map[NxFieldSelectionMode]int{
+	NxFieldSelectionModeNormal: 0,
+	NxFieldSelectionModeAnd:    1,
+	NxFieldSelectionModeNot:    2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxFieldSelectionMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxFieldSelectionMode constants
+func (e NxFieldSelectionMode) IsValid() bool {
+	_, ok := valuesOfNxFieldSelectionMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxFieldSelectionMode) Int() (int, bool) {
+	n, ok := valuesOfNxFieldSelectionMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxFieldSelectionMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxFieldSelectionMode, "NxFieldSelectionMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type NxFieldTableResourceId struct {
@@ -3094,6 +6486,49 @@ type NxFieldTableResourceId struct {
 	ResourceId string `json:"qResourceId,omitempty"`
 }
 
+type NxFrequencyMode string
+
+const (
+	NxFrequencyModeNone     NxFrequencyMode = "N"
+	NxFrequencyModeValue    NxFrequencyMode = "V"
+	NxFrequencyModePercent  NxFrequencyMode = "P"
+	NxFrequencyModeRelative NxFrequencyMode = "R"
+)
+
+var valuesOfNxFrequencyMode = map[NxFrequencyMode]int{
+	NxFrequencyModeNone:     0,
+	NxFrequencyModeValue:    1,
+	NxFrequencyModePercent:  2,
+	NxFrequencyModeRelative: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxFrequencyMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxFrequencyMode constants
+func (e NxFrequencyMode) IsValid() bool {
+	_, ok := valuesOfNxFrequencyMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxFrequencyMode) Int() (int, bool) {
+	n, ok := valuesOfNxFrequencyMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxFrequencyMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxFrequencyMode, "NxFrequencyMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxGetBookmarkOptions struct {
 	// List of object types.
 	Types []string `json:"qTypes,omitempty"`
@@ -3113,36 +6548,43 @@ type NxGetObjectOptions struct {
 	Data json.RawMessage `json:"qData,omitempty"`
 }
 
-// Defines the properties of an object group.
-// Stability: experimental
-type NxGroupDef struct {
-	// Specifies the class of the group's object members.
-	//
-	// One of:
-	//
-	// • bookmark or MEMBER_BOOKMARK
-	//
-	// • object or MEMBER_OBJECT
-	Class string `json:"qClass,omitempty"`
-	// Specifies the type of the group's object members.
-	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
-	ObjectType *string `json:"qObjectType,omitempty"`
-	// Specifies the type of the group's subgroup members.
-	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
-	GroupType *string `json:"qGroupType,omitempty"`
-	// The group's label. Will be evaluated as an expression if it starts with '='.
-	Label string `json:"qLabel,omitempty"`
-	// The objects and sub-groups that are members of the group.
-	MemberIds []*NxGroupObjectId `json:"qMemberIds,omitempty"`
+type NxGroupMemberClass string
+
+const (
+	NxGroupMemberClassBookmark NxGroupMemberClass = "bookmark"
+	NxGroupMemberClassObject   NxGroupMemberClass = "object"
+)
+
+var valuesOfNxGroupMemberClass = map[NxGroupMemberClass]int{
+	NxGroupMemberClassBookmark: 0,
+	NxGroupMemberClassObject:   1,
 }
 
-// Holds the ID of a NxGroupDef's member.
-// _GroupId_ holds the ID of a sub-group while ObjectId holds the ID of an object.
-// Only one Id should be set. GroupId takes precedence if both are set.
-// Stability: experimental
-type NxGroupObjectId struct {
-	GroupId  string `json:"qGroupId,omitempty"`
-	ObjectId string `json:"qObjectId,omitempty"`
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxGroupMemberClass) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxGroupMemberClass constants
+func (e NxGroupMemberClass) IsValid() bool {
+	_, ok := valuesOfNxGroupMemberClass[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxGroupMemberClass) Int() (int, bool) {
+	n, ok := valuesOfNxGroupMemberClass[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxGroupMemberClass) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxGroupMemberClass, "NxGroupMemberClass")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type NxGroupTail struct {
@@ -3158,24 +6600,145 @@ type NxGroupTail struct {
 	Down int `json:"qDown,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxGroupTail) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxGroupTail) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qUp"), v.Up, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qDown"), v.Down, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxGrpType string
+
+const (
+	NxGrpTypeNone       NxGrpType = "N"
+	NxGrpTypeHiearchy   NxGrpType = "H"
+	NxGrpTypeCollection NxGrpType = "C"
+)
+
+var valuesOfNxGrpType = map[NxGrpType]int{
+	NxGrpTypeNone:       0,
+	NxGrpTypeHiearchy:   1,
+	NxGrpTypeCollection: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxGrpType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxGrpType constants
+func (e NxGrpType) IsValid() bool {
+	_, ok := valuesOfNxGrpType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxGrpType) Int() (int, bool) {
+	n, ok := valuesOfNxGrpType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxGrpType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxGrpType, "NxGrpType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxHighlightRanges struct {
 	// Ranges of highlighted values.
 	Ranges []*CharRange `json:"qRanges,omitempty"`
 }
 
-type NxInfo struct {
-	// Identifier of the object.
-	// If the chosen identifier is already in use, the engine automatically sets another one.
-	// If an identifier is not set, the engine automatically sets one.
-	// This parameter is optional.
-	Id string `json:"qId,omitempty"`
-	// Type of the object.
-	// This parameter is mandatory.
-	Type string `json:"qType,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxHighlightRanges) Validate() error {
+	return v.validate("")
 }
 
-type NxInlineDimensionDef struct {
-	// Used to define a cyclic group or drill-down group.
+func (v *NxHighlightRanges) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Ranges {
+		if err := item0.validate(indexPath(joinPath(path, "qRanges"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NxHypercubeMode string
+
+const (
+	NxHypercubeModeStraight   NxHypercubeMode = "S"
+	NxHypercubeModePivot      NxHypercubeMode = "P"
+	NxHypercubeModePivotStack NxHypercubeMode = "K"
+	NxHypercubeModeTree       NxHypercubeMode = "T"
+	NxHypercubeModeDynamic    NxHypercubeMode = "D"
+)
+
+var valuesOfNxHypercubeMode = map[NxHypercubeMode]int{
+	NxHypercubeModeStraight:   0,
+	NxHypercubeModePivot:      1,
+	NxHypercubeModePivotStack: 2,
+	NxHypercubeModeTree:       3,
+	NxHypercubeModeDynamic:    4,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxHypercubeMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxHypercubeMode constants
+func (e NxHypercubeMode) IsValid() bool {
+	_, ok := valuesOfNxHypercubeMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxHypercubeMode) Int() (int, bool) {
+	n, ok := valuesOfNxHypercubeMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxHypercubeMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxHypercubeMode, "NxHypercubeMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type NxInfo struct {
+	// Identifier of the object.
+	// If the chosen identifier is already in use, the engine automatically sets another one.
+	// If an identifier is not set, the engine automatically sets one.
+	// This parameter is optional.
+	Id string `json:"qId,omitempty"`
+	// Type of the object.
+	// This parameter is mandatory.
+	Type string `json:"qType,omitempty"`
+}
+
+type NxInlineDimensionDef struct {
+	// Used to define a cyclic group or drill-down group.
 	// Default value is no grouping.
 	// This parameter is optional.
 	//
@@ -3186,7 +6749,7 @@ type NxInlineDimensionDef struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// Array of field names.
 	// When creating a grouped dimension, more than one field name is defined.
 	// This parameter is optional.
@@ -3214,6 +6777,34 @@ type NxInlineDimensionDef struct {
 	Alias string `json:"qAlias,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxInlineDimensionDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxInlineDimensionDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SortCriterias {
+		if err := item0.validate(indexPath(joinPath(path, "qSortCriterias"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.NumberPresentations {
+		if err := item0.validate(indexPath(joinPath(path, "qNumberPresentations"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qActiveField"), v.ActiveField, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxInlineMeasureDef struct {
 	// Name of the measure.
 	// An empty string is returned as a default value.
@@ -3237,7 +6828,7 @@ type NxInlineMeasureDef struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// Definition of the expression in the measure.
 	// Example: Sum (OrderTotal)
 	// This parameter is mandatory.
@@ -3281,11 +6872,101 @@ type NxInlineMeasureDef struct {
 	LabelExpression string `json:"qLabelExpression,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxInlineMeasureDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxInlineMeasureDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qAccumulate"), v.Accumulate, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qActiveExpression"), v.ActiveExpression, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxLTrendlineType string
+
+const (
+	NxLTrendlineTypeAverage     NxLTrendlineType = "AVERAGE"
+	NxLTrendlineTypeLinear      NxLTrendlineType = "LINEAR"
+	NxLTrendlineTypePolynomial2 NxLTrendlineType = "POLYNOMIAL2"
+	NxLTrendlineTypePolynomial3 NxLTrendlineType = "POLYNOMIAL3"
+	NxLTrendlineTypePolynomial4 NxLTrendlineType = "POLYNOMIAL4"
+	NxLTrendlineTypeExponential NxLTrendlineType = "EXPONENTIAL"
+	NxLTrendlineTypePower       NxLTrendlineType = "POWER"
+	NxLTrendlineTypeLogarithmic NxLTrendlineType = "LOG"
+)
+
+var valuesOfNxLTrendlineType = map[NxLTrendlineType]int{
+	NxLTrendlineTypeAverage:     0,
+	NxLTrendlineTypeLinear:      1,
+	NxLTrendlineTypePolynomial2: 2,
+	NxLTrendlineTypePolynomial3: 3,
+	NxLTrendlineTypePolynomial4: 4,
+	NxLTrendlineTypeExponential: 5,
+	NxLTrendlineTypePower:       6,
+	NxLTrendlineTypeLogarithmic: 7,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxLTrendlineType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxLTrendlineType constants
+func (e NxLTrendlineType) IsValid() bool {
+	_, ok := valuesOfNxLTrendlineType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxLTrendlineType) Int() (int, bool) {
+	n, ok := valuesOfNxLTrendlineType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxLTrendlineType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxLTrendlineType, "NxLTrendlineType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxLayoutErrors struct {
 	// Error code.
 	ErrorCode int `json:"qErrorCode,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxLayoutErrors) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxLayoutErrors) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qErrorCode"), v.ErrorCode, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxLibraryDimension struct {
 	// Information about the grouping.
 	//
@@ -3296,7 +6977,7 @@ type NxLibraryDimension struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// Array of dimension names.
 	FieldDefs []string `json:"qFieldDefs,omitempty"`
 	// Array of dimension labels.
@@ -3307,6 +6988,21 @@ type NxLibraryDimension struct {
 	ScriptGenerated bool   `json:"qScriptGenerated,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxLibraryDimension) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxLibraryDimension) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxLibraryDimensionDef struct {
 	// Information about the grouping.
 	//
@@ -3317,7 +7013,7 @@ type NxLibraryDimensionDef struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// Array of dimension names.
 	FieldDefs []string `json:"qFieldDefs,omitempty"`
 	// Array of dimension labels.
@@ -3328,6 +7024,21 @@ type NxLibraryDimensionDef struct {
 	ScriptGenerated bool   `json:"qScriptGenerated,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxLibraryDimensionDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxLibraryDimensionDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Information about the library measure. Is the layout for NxLibraryMeasureDef.
 type NxLibraryMeasure struct {
 	Label string `json:"qLabel,omitempty"`
@@ -3339,16 +7050,37 @@ type NxLibraryMeasure struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping         string   `json:"qGrouping,omitempty"`
-	Expressions      []string `json:"qExpressions,omitempty"`
-	ActiveExpression int      `json:"qActiveExpression,omitempty"`
-	LabelExpression  string   `json:"qLabelExpression,omitempty"`
+	Grouping         NxGrpType `json:"qGrouping,omitempty"`
+	Expressions      []string  `json:"qExpressions,omitempty"`
+	ActiveExpression int       `json:"qActiveExpression,omitempty"`
+	LabelExpression  string    `json:"qLabelExpression,omitempty"`
 	// Format of the field.
 	// This parameter is optional.
 	NumFormat       *FieldAttributes `json:"qNumFormat,omitempty"`
 	ScriptGenerated bool             `json:"qScriptGenerated,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxLibraryMeasure) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxLibraryMeasure) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qActiveExpression"), v.ActiveExpression, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxLibraryMeasureDef struct {
 	// Label of the measure.
 	Label string `json:"qLabel,omitempty"`
@@ -3365,7 +7097,7 @@ type NxLibraryMeasureDef struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// Array of expressions.
 	Expressions []string `json:"qExpressions,omitempty"`
 	// Index to the active expression in a measure.
@@ -3378,6 +7110,27 @@ type NxLibraryMeasureDef struct {
 	ScriptGenerated bool             `json:"qScriptGenerated,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxLibraryMeasureDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxLibraryMeasureDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qActiveExpression"), v.ActiveExpression, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxLinkedObjectInfo struct {
 	// Identifier of the root object.
 	// If the linked object is a child, the root identifier is the identifier of the parent.
@@ -3395,6 +7148,21 @@ type NxListObjectExpression struct {
 	Error *NxLayoutErrors `json:"qError,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxListObjectExpression) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxListObjectExpression) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxListObjectExpressionDef struct {
 	// Value of the expression.
 	Expr string `json:"qExpr,omitempty"`
@@ -3402,6 +7170,705 @@ type NxListObjectExpressionDef struct {
 	LibraryId string `json:"qLibraryId,omitempty"`
 }
 
+type NxLocalizedErrorCode string
+
+const (
+	NxLocalizedErrorCodeInternalError                                NxLocalizedErrorCode = "LOCERR_INTERNAL_ERROR"
+	NxLocalizedErrorCodeGenericUnknown                               NxLocalizedErrorCode = "LOCERR_GENERIC_UNKNOWN"
+	NxLocalizedErrorCodeGenericOk                                    NxLocalizedErrorCode = "LOCERR_GENERIC_OK"
+	NxLocalizedErrorCodeGenericNotSet                                NxLocalizedErrorCode = "LOCERR_GENERIC_NOT_SET"
+	NxLocalizedErrorCodeGenericNotFound                              NxLocalizedErrorCode = "LOCERR_GENERIC_NOT_FOUND"
+	NxLocalizedErrorCodeGenericAlreadyExists                         NxLocalizedErrorCode = "LOCERR_GENERIC_ALREADY_EXISTS"
+	NxLocalizedErrorCodeGenericInvalidPath                           NxLocalizedErrorCode = "LOCERR_GENERIC_INVALID_PATH"
+	NxLocalizedErrorCodeGenericAccessDenied                          NxLocalizedErrorCode = "LOCERR_GENERIC_ACCESS_DENIED"
+	NxLocalizedErrorCodeGenericOutOfMemory                           NxLocalizedErrorCode = "LOCERR_GENERIC_OUT_OF_MEMORY"
+	NxLocalizedErrorCodeGenericNotInitialized                        NxLocalizedErrorCode = "LOCERR_GENERIC_NOT_INITIALIZED"
+	NxLocalizedErrorCodeGenericInvalidParameters                     NxLocalizedErrorCode = "LOCERR_GENERIC_INVALID_PARAMETERS"
+	NxLocalizedErrorCodeGenericEmptyParameters                       NxLocalizedErrorCode = "LOCERR_GENERIC_EMPTY_PARAMETERS"
+	NxLocalizedErrorCodeGenericInternalError                         NxLocalizedErrorCode = "LOCERR_GENERIC_INTERNAL_ERROR"
+	NxLocalizedErrorCodeGenericCorruptData                           NxLocalizedErrorCode = "LOCERR_GENERIC_CORRUPT_DATA"
+	NxLocalizedErrorCodeGenericMemoryInconsistency                   NxLocalizedErrorCode = "LOCERR_GENERIC_MEMORY_INCONSISTENCY"
+	NxLocalizedErrorCodeGenericInvisibleOwnerAbort                   NxLocalizedErrorCode = "LOCERR_GENERIC_INVISIBLE_OWNER_ABORT"
+	NxLocalizedErrorCodeGenericProhibitValidate                      NxLocalizedErrorCode = "LOCERR_GENERIC_PROHIBIT_VALIDATE"
+	NxLocalizedErrorCodeGenericAborted                               NxLocalizedErrorCode = "LOCERR_GENERIC_ABORTED"
+	NxLocalizedErrorCodeGenericConnectionLost                        NxLocalizedErrorCode = "LOCERR_GENERIC_CONNECTION_LOST"
+	NxLocalizedErrorCodeGenericUnsupportedInProductVersion           NxLocalizedErrorCode = "LOCERR_GENERIC_UNSUPPORTED_IN_PRODUCT_VERSION"
+	NxLocalizedErrorCodeGenericRestConnectionFailure                 NxLocalizedErrorCode = "LOCERR_GENERIC_REST_CONNECTION_FAILURE"
+	NxLocalizedErrorCodeGenericMemoryLimitReached                    NxLocalizedErrorCode = "LOCERR_GENERIC_MEMORY_LIMIT_REACHED"
+	NxLocalizedErrorCodeGenericNotImplemented                        NxLocalizedErrorCode = "LOCERR_GENERIC_NOT_IMPLEMENTED"
+	NxLocalizedErrorCodeGenericEngineTerminated                      NxLocalizedErrorCode = "LOCERR_GENERIC_ENGINE_TERMINATED"
+	NxLocalizedErrorCodeHttp400                                      NxLocalizedErrorCode = "LOCERR_HTTP_400"
+	NxLocalizedErrorCodeHttp401                                      NxLocalizedErrorCode = "LOCERR_HTTP_401"
+	NxLocalizedErrorCodeHttp402                                      NxLocalizedErrorCode = "LOCERR_HTTP_402"
+	NxLocalizedErrorCodeHttp403                                      NxLocalizedErrorCode = "LOCERR_HTTP_403"
+	NxLocalizedErrorCodeHttp404                                      NxLocalizedErrorCode = "LOCERR_HTTP_404"
+	NxLocalizedErrorCodeHttp405                                      NxLocalizedErrorCode = "LOCERR_HTTP_405"
+	NxLocalizedErrorCodeHttp406                                      NxLocalizedErrorCode = "LOCERR_HTTP_406"
+	NxLocalizedErrorCodeHttp407                                      NxLocalizedErrorCode = "LOCERR_HTTP_407"
+	NxLocalizedErrorCodeHttp408                                      NxLocalizedErrorCode = "LOCERR_HTTP_408"
+	NxLocalizedErrorCodeHttp409                                      NxLocalizedErrorCode = "LOCERR_HTTP_409"
+	NxLocalizedErrorCodeHttp410                                      NxLocalizedErrorCode = "LOCERR_HTTP_410"
+	NxLocalizedErrorCodeHttp411                                      NxLocalizedErrorCode = "LOCERR_HTTP_411"
+	NxLocalizedErrorCodeHttp412                                      NxLocalizedErrorCode = "LOCERR_HTTP_412"
+	NxLocalizedErrorCodeHttp413                                      NxLocalizedErrorCode = "LOCERR_HTTP_413"
+	NxLocalizedErrorCodeHttp414                                      NxLocalizedErrorCode = "LOCERR_HTTP_414"
+	NxLocalizedErrorCodeHttp415                                      NxLocalizedErrorCode = "LOCERR_HTTP_415"
+	NxLocalizedErrorCodeHttp416                                      NxLocalizedErrorCode = "LOCERR_HTTP_416"
+	NxLocalizedErrorCodeHttp417                                      NxLocalizedErrorCode = "LOCERR_HTTP_417"
+	NxLocalizedErrorCodeHttp422                                      NxLocalizedErrorCode = "LOCERR_HTTP_422"
+	NxLocalizedErrorCodeHttp423                                      NxLocalizedErrorCode = "LOCERR_HTTP_423"
+	NxLocalizedErrorCodeHttp429                                      NxLocalizedErrorCode = "LOCERR_HTTP_429"
+	NxLocalizedErrorCodeHttp500                                      NxLocalizedErrorCode = "LOCERR_HTTP_500"
+	NxLocalizedErrorCodeHttp501                                      NxLocalizedErrorCode = "LOCERR_HTTP_501"
+	NxLocalizedErrorCodeHttp502                                      NxLocalizedErrorCode = "LOCERR_HTTP_502"
+	NxLocalizedErrorCodeHttp503                                      NxLocalizedErrorCode = "LOCERR_HTTP_503"
+	NxLocalizedErrorCodeHttp504                                      NxLocalizedErrorCode = "LOCERR_HTTP_504"
+	NxLocalizedErrorCodeHttp505                                      NxLocalizedErrorCode = "LOCERR_HTTP_505"
+	NxLocalizedErrorCodeHttp509                                      NxLocalizedErrorCode = "LOCERR_HTTP_509"
+	NxLocalizedErrorCodeHttpCouldNotResolveHost                      NxLocalizedErrorCode = "LOCERR_HTTP_COULD_NOT_RESOLVE_HOST"
+	NxLocalizedErrorCodeAppAlreadyExists                             NxLocalizedErrorCode = "LOCERR_APP_ALREADY_EXISTS"
+	NxLocalizedErrorCodeAppInvalidName                               NxLocalizedErrorCode = "LOCERR_APP_INVALID_NAME"
+	NxLocalizedErrorCodeAppAlreadyOpen                               NxLocalizedErrorCode = "LOCERR_APP_ALREADY_OPEN"
+	NxLocalizedErrorCodeAppNotFound                                  NxLocalizedErrorCode = "LOCERR_APP_NOT_FOUND"
+	NxLocalizedErrorCodeAppImportFailed                              NxLocalizedErrorCode = "LOCERR_APP_IMPORT_FAILED"
+	NxLocalizedErrorCodeAppSaveFailed                                NxLocalizedErrorCode = "LOCERR_APP_SAVE_FAILED"
+	NxLocalizedErrorCodeAppCreateFailed                              NxLocalizedErrorCode = "LOCERR_APP_CREATE_FAILED"
+	NxLocalizedErrorCodeAppInvalid                                   NxLocalizedErrorCode = "LOCERR_APP_INVALID"
+	NxLocalizedErrorCodeAppConnectFailed                             NxLocalizedErrorCode = "LOCERR_APP_CONNECT_FAILED"
+	NxLocalizedErrorCodeAppAlreadyOpenInDifferentMode                NxLocalizedErrorCode = "LOCERR_APP_ALREADY_OPEN_IN_DIFFERENT_MODE"
+	NxLocalizedErrorCodeAppMigrationCouldNotContactMigrationService  NxLocalizedErrorCode = "LOCERR_APP_MIGRATION_COULD_NOT_CONTACT_MIGRATION_SERVICE"
+	NxLocalizedErrorCodeAppMigrationCouldNotStartMigration           NxLocalizedErrorCode = "LOCERR_APP_MIGRATION_COULD_NOT_START_MIGRATION"
+	NxLocalizedErrorCodeAppMigrationFailure                          NxLocalizedErrorCode = "LOCERR_APP_MIGRATION_FAILURE"
+	NxLocalizedErrorCodeAppScriptMissing                             NxLocalizedErrorCode = "LOCERR_APP_SCRIPT_MISSING"
+	NxLocalizedErrorCodeAppExportFailed                              NxLocalizedErrorCode = "LOCERR_APP_EXPORT_FAILED"
+	NxLocalizedErrorCodeAppSizeExceeded                              NxLocalizedErrorCode = "LOCERR_APP_SIZE_EXCEEDED"
+	NxLocalizedErrorCodeAppDirectQueryWorkloadNotSupported           NxLocalizedErrorCode = "LOCERR_APP_DIRECT_QUERY_WORKLOAD_NOT_SUPPORTED"
+	NxLocalizedErrorCodeAppNotOpen                                   NxLocalizedErrorCode = "LOCERR_APP_NOT_OPEN"
+	NxLocalizedErrorCodeAppEventSourceTimeout                        NxLocalizedErrorCode = "LOCERR_APP_EVENT_SOURCE_TIMEOUT"
+	NxLocalizedErrorCodeConnectionAlreadyExists                      NxLocalizedErrorCode = "LOCERR_CONNECTION_ALREADY_EXISTS"
+	NxLocalizedErrorCodeConnectionNotFound                           NxLocalizedErrorCode = "LOCERR_CONNECTION_NOT_FOUND"
+	NxLocalizedErrorCodeConnectionFailedToLoad                       NxLocalizedErrorCode = "LOCERR_CONNECTION_FAILED_TO_LOAD"
+	NxLocalizedErrorCodeConnectionFailedToImport                     NxLocalizedErrorCode = "LOCERR_CONNECTION_FAILED_TO_IMPORT"
+	NxLocalizedErrorCodeConnectionNameIsInvalid                      NxLocalizedErrorCode = "LOCERR_CONNECTION_NAME_IS_INVALID"
+	NxLocalizedErrorCodeConnectionMissingCredentials                 NxLocalizedErrorCode = "LOCERR_CONNECTION_MISSING_CREDENTIALS"
+	NxLocalizedErrorCodeConnectorNoFileStreamingSupport              NxLocalizedErrorCode = "LOCERR_CONNECTOR_NO_FILE_STREAMING_SUPPORT"
+	NxLocalizedErrorCodeConnectorFilesizeExceededBufferSize          NxLocalizedErrorCode = "LOCERR_CONNECTOR_FILESIZE_EXCEEDED_BUFFER_SIZE"
+	NxLocalizedErrorCodeFileAccessDenied                             NxLocalizedErrorCode = "LOCERR_FILE_ACCESS_DENIED"
+	NxLocalizedErrorCodeFileNameInvalid                              NxLocalizedErrorCode = "LOCERR_FILE_NAME_INVALID"
+	NxLocalizedErrorCodeFileCorrupt                                  NxLocalizedErrorCode = "LOCERR_FILE_CORRUPT"
+	NxLocalizedErrorCodeFileNotFound                                 NxLocalizedErrorCode = "LOCERR_FILE_NOT_FOUND"
+	NxLocalizedErrorCodeFileFormatUnsupported                        NxLocalizedErrorCode = "LOCERR_FILE_FORMAT_UNSUPPORTED"
+	NxLocalizedErrorCodeFileOpenedInUnsupportedMode                  NxLocalizedErrorCode = "LOCERR_FILE_OPENED_IN_UNSUPPORTED_MODE"
+	NxLocalizedErrorCodeFileTableNotFound                            NxLocalizedErrorCode = "LOCERR_FILE_TABLE_NOT_FOUND"
+	NxLocalizedErrorCodeUserAccessDenied                             NxLocalizedErrorCode = "LOCERR_USER_ACCESS_DENIED"
+	NxLocalizedErrorCodeUserImpersonationFailed                      NxLocalizedErrorCode = "LOCERR_USER_IMPERSONATION_FAILED"
+	NxLocalizedErrorCodeServerOutOfSessionAndUserCals                NxLocalizedErrorCode = "LOCERR_SERVER_OUT_OF_SESSION_AND_USER_CALS"
+	NxLocalizedErrorCodeServerOutOfSessionCals                       NxLocalizedErrorCode = "LOCERR_SERVER_OUT_OF_SESSION_CALS"
+	NxLocalizedErrorCodeServerOutOfUsageCals                         NxLocalizedErrorCode = "LOCERR_SERVER_OUT_OF_USAGE_CALS"
+	NxLocalizedErrorCodeServerOutOfCals                              NxLocalizedErrorCode = "LOCERR_SERVER_OUT_OF_CALS"
+	NxLocalizedErrorCodeServerOutOfNamedCals                         NxLocalizedErrorCode = "LOCERR_SERVER_OUT_OF_NAMED_CALS"
+	NxLocalizedErrorCodeServerOffDuty                                NxLocalizedErrorCode = "LOCERR_SERVER_OFF_DUTY"
+	NxLocalizedErrorCodeServerBusy                                   NxLocalizedErrorCode = "LOCERR_SERVER_BUSY"
+	NxLocalizedErrorCodeServerLicenseExpired                         NxLocalizedErrorCode = "LOCERR_SERVER_LICENSE_EXPIRED"
+	NxLocalizedErrorCodeServerAjaxDisabled                           NxLocalizedErrorCode = "LOCERR_SERVER_AJAX_DISABLED"
+	NxLocalizedErrorCodeServerNoToken                                NxLocalizedErrorCode = "LOCERR_SERVER_NO_TOKEN"
+	NxLocalizedErrorCodeHcInvalidObject                              NxLocalizedErrorCode = "LOCERR_HC_INVALID_OBJECT"
+	NxLocalizedErrorCodeHcResultTooLarge                             NxLocalizedErrorCode = "LOCERR_HC_RESULT_TOO_LARGE"
+	NxLocalizedErrorCodeHcInvalidObjectState                         NxLocalizedErrorCode = "LOCERR_HC_INVALID_OBJECT_STATE"
+	NxLocalizedErrorCodeHcModalObjectError                           NxLocalizedErrorCode = "LOCERR_HC_MODAL_OBJECT_ERROR"
+	NxLocalizedErrorCodeCalcInvalidDef                               NxLocalizedErrorCode = "LOCERR_CALC_INVALID_DEF"
+	NxLocalizedErrorCodeCalcNotInLib                                 NxLocalizedErrorCode = "LOCERR_CALC_NOT_IN_LIB"
+	NxLocalizedErrorCodeCalcHeapError                                NxLocalizedErrorCode = "LOCERR_CALC_HEAP_ERROR"
+	NxLocalizedErrorCodeCalcTooLarge                                 NxLocalizedErrorCode = "LOCERR_CALC_TOO_LARGE"
+	NxLocalizedErrorCodeCalcTimeout                                  NxLocalizedErrorCode = "LOCERR_CALC_TIMEOUT"
+	NxLocalizedErrorCodeCalcEvalConditionFailed                      NxLocalizedErrorCode = "LOCERR_CALC_EVAL_CONDITION_FAILED"
+	NxLocalizedErrorCodeCalcMixedLinkedAggregation                   NxLocalizedErrorCode = "LOCERR_CALC_MIXED_LINKED_AGGREGATION"
+	NxLocalizedErrorCodeCalcMissingLinked                            NxLocalizedErrorCode = "LOCERR_CALC_MISSING_LINKED"
+	NxLocalizedErrorCodeCalcInvalidColSort                           NxLocalizedErrorCode = "LOCERR_CALC_INVALID_COL_SORT"
+	NxLocalizedErrorCodeCalcPagesTooLarge                            NxLocalizedErrorCode = "LOCERR_CALC_PAGES_TOO_LARGE"
+	NxLocalizedErrorCodeCalcSemanticFieldNotAllowed                  NxLocalizedErrorCode = "LOCERR_CALC_SEMANTIC_FIELD_NOT_ALLOWED"
+	NxLocalizedErrorCodeCalcValidationStateInvalid                   NxLocalizedErrorCode = "LOCERR_CALC_VALIDATION_STATE_INVALID"
+	NxLocalizedErrorCodeCalcPivotDimensionsAlreadyExists             NxLocalizedErrorCode = "LOCERR_CALC_PIVOT_DIMENSIONS_ALREADY_EXISTS"
+	NxLocalizedErrorCodeCalcMissingLinkedField                       NxLocalizedErrorCode = "LOCERR_CALC_MISSING_LINKED_FIELD"
+	NxLocalizedErrorCodeCalcNotCalculated                            NxLocalizedErrorCode = "LOCERR_CALC_NOT_CALCULATED"
+	NxLocalizedErrorCodeLayoutExtendsInvalidId                       NxLocalizedErrorCode = "LOCERR_LAYOUT_EXTENDS_INVALID_ID"
+	NxLocalizedErrorCodeLayoutLinkedObjectNotFound                   NxLocalizedErrorCode = "LOCERR_LAYOUT_LINKED_OBJECT_NOT_FOUND"
+	NxLocalizedErrorCodeLayoutLinkedObjectInvalid                    NxLocalizedErrorCode = "LOCERR_LAYOUT_LINKED_OBJECT_INVALID"
+	NxLocalizedErrorCodePersistenceWriteFailed                       NxLocalizedErrorCode = "LOCERR_PERSISTENCE_WRITE_FAILED"
+	NxLocalizedErrorCodePersistenceReadFailed                        NxLocalizedErrorCode = "LOCERR_PERSISTENCE_READ_FAILED"
+	NxLocalizedErrorCodePersistenceDeleteFailed                      NxLocalizedErrorCode = "LOCERR_PERSISTENCE_DELETE_FAILED"
+	NxLocalizedErrorCodePersistenceNotFound                          NxLocalizedErrorCode = "LOCERR_PERSISTENCE_NOT_FOUND"
+	NxLocalizedErrorCodePersistenceUnsupportedVersion                NxLocalizedErrorCode = "LOCERR_PERSISTENCE_UNSUPPORTED_VERSION"
+	NxLocalizedErrorCodePersistenceMigrationFailedReadOnly           NxLocalizedErrorCode = "LOCERR_PERSISTENCE_MIGRATION_FAILED_READ_ONLY"
+	NxLocalizedErrorCodePersistenceMigrationCancelled                NxLocalizedErrorCode = "LOCERR_PERSISTENCE_MIGRATION_CANCELLED"
+	NxLocalizedErrorCodePersistenceMigrationBackupFailed             NxLocalizedErrorCode = "LOCERR_PERSISTENCE_MIGRATION_BACKUP_FAILED"
+	NxLocalizedErrorCodePersistenceDiskFull                          NxLocalizedErrorCode = "LOCERR_PERSISTENCE_DISK_FULL"
+	NxLocalizedErrorCodePersistenceNotSupportedForSessionApp         NxLocalizedErrorCode = "LOCERR_PERSISTENCE_NOT_SUPPORTED_FOR_SESSION_APP"
+	NxLocalizedErrorCodePersistenceMoveFailed                        NxLocalizedErrorCode = "LOCERR_PERSISTENCE_MOVE_FAILED"
+	NxLocalizedErrorCodePersistenceObjectLocked                      NxLocalizedErrorCode = "LOCERR_PERSISTENCE_OBJECT_LOCKED"
+	NxLocalizedErrorCodePersistenceEncryptionKeyMigrationOngoing     NxLocalizedErrorCode = "LOCERR_PERSISTENCE_ENCRYPTION_KEY_MIGRATION_ONGOING"
+	NxLocalizedErrorCodePersistenceSyncSetChunkInvalidParameters     NxLocalizedErrorCode = "LOCERR_PERSISTENCE_SYNC_SET_CHUNK_INVALID_PARAMETERS"
+	NxLocalizedErrorCodePersistenceSyncGetChunkInvalidParameters     NxLocalizedErrorCode = "LOCERR_PERSISTENCE_SYNC_GET_CHUNK_INVALID_PARAMETERS"
+	NxLocalizedErrorCodeScriptDatasourceAccessDenied                 NxLocalizedErrorCode = "LOCERR_SCRIPT_DATASOURCE_ACCESS_DENIED"
+	NxLocalizedErrorCodeReloadInProgress                             NxLocalizedErrorCode = "LOCERR_RELOAD_IN_PROGRESS"
+	NxLocalizedErrorCodeReloadTableXNotFound                         NxLocalizedErrorCode = "LOCERR_RELOAD_TABLE_X_NOT_FOUND"
+	NxLocalizedErrorCodeReloadUnknownStatement                       NxLocalizedErrorCode = "LOCERR_RELOAD_UNKNOWN_STATEMENT"
+	NxLocalizedErrorCodeReloadExpectedSomethingFoundUnknown          NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_SOMETHING_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedNothingFoundUnknown            NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_NOTHING_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf1TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_1_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf2TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_2_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf3TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_3_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf4TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_4_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf5TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_5_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf6TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_6_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf7TokensFoundUnknown       NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_7_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadExpectedOneOf8OrMoreTokensFoundUnknown NxLocalizedErrorCode = "LOCERR_RELOAD_EXPECTED_ONE_OF_8_OR_MORE_TOKENS_FOUND_UNKNOWN"
+	NxLocalizedErrorCodeReloadFieldXNotFound                         NxLocalizedErrorCode = "LOCERR_RELOAD_FIELD_X_NOT_FOUND"
+	NxLocalizedErrorCodeReloadMappingTableXNotFound                  NxLocalizedErrorCode = "LOCERR_RELOAD_MAPPING_TABLE_X_NOT_FOUND"
+	NxLocalizedErrorCodeReloadLibConnectionXNotFound                 NxLocalizedErrorCode = "LOCERR_RELOAD_LIB_CONNECTION_X_NOT_FOUND"
+	NxLocalizedErrorCodeReloadNameAlreadyTaken                       NxLocalizedErrorCode = "LOCERR_RELOAD_NAME_ALREADY_TAKEN"
+	NxLocalizedErrorCodeReloadWrongFileFormatDif                     NxLocalizedErrorCode = "LOCERR_RELOAD_WRONG_FILE_FORMAT_DIF"
+	NxLocalizedErrorCodeReloadWrongFileFormatBiff                    NxLocalizedErrorCode = "LOCERR_RELOAD_WRONG_FILE_FORMAT_BIFF"
+	NxLocalizedErrorCodeReloadWrongFileFormatEncrypted               NxLocalizedErrorCode = "LOCERR_RELOAD_WRONG_FILE_FORMAT_ENCRYPTED"
+	NxLocalizedErrorCodeReloadOpenFileError                          NxLocalizedErrorCode = "LOCERR_RELOAD_OPEN_FILE_ERROR"
+	NxLocalizedErrorCodeReloadAutoGenerateCount                      NxLocalizedErrorCode = "LOCERR_RELOAD_AUTO_GENERATE_COUNT"
+	NxLocalizedErrorCodeReloadPeIllegalPrefixComb                    NxLocalizedErrorCode = "LOCERR_RELOAD_PE_ILLEGAL_PREFIX_COMB"
+	NxLocalizedErrorCodeReloadMatchingControlStatementError          NxLocalizedErrorCode = "LOCERR_RELOAD_MATCHING_CONTROL_STATEMENT_ERROR"
+	NxLocalizedErrorCodeReloadMatchingLibpathXNotFound               NxLocalizedErrorCode = "LOCERR_RELOAD_MATCHING_LIBPATH_X_NOT_FOUND"
+	NxLocalizedErrorCodeReloadMatchingLibpathXInvalid                NxLocalizedErrorCode = "LOCERR_RELOAD_MATCHING_LIBPATH_X_INVALID"
+	NxLocalizedErrorCodeReloadMatchingLibpathXOutside                NxLocalizedErrorCode = "LOCERR_RELOAD_MATCHING_LIBPATH_X_OUTSIDE"
+	NxLocalizedErrorCodeReloadNoQualifiedPathForFile                 NxLocalizedErrorCode = "LOCERR_RELOAD_NO_QUALIFIED_PATH_FOR_FILE"
+	NxLocalizedErrorCodeReloadModeStatementOnlyForLibPaths           NxLocalizedErrorCode = "LOCERR_RELOAD_MODE_STATEMENT_ONLY_FOR_LIB_PATHS"
+	NxLocalizedErrorCodeReloadInconsistentUseOfSemanticFields        NxLocalizedErrorCode = "LOCERR_RELOAD_INCONSISTENT_USE_OF_SEMANTIC_FIELDS"
+	NxLocalizedErrorCodeReloadNoOpenDatabase                         NxLocalizedErrorCode = "LOCERR_RELOAD_NO_OPEN_DATABASE"
+	NxLocalizedErrorCodeReloadAggregationRequiredByGroupBy           NxLocalizedErrorCode = "LOCERR_RELOAD_AGGREGATION_REQUIRED_BY_GROUP_BY"
+	NxLocalizedErrorCodeReloadConnectMustUseLibPrefixInThisMode      NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECT_MUST_USE_LIB_PREFIX_IN_THIS_MODE"
+	NxLocalizedErrorCodeReloadOdbcConnectFailed                      NxLocalizedErrorCode = "LOCERR_RELOAD_ODBC_CONNECT_FAILED"
+	NxLocalizedErrorCodeReloadOledbConnectFailed                     NxLocalizedErrorCode = "LOCERR_RELOAD_OLEDB_CONNECT_FAILED"
+	NxLocalizedErrorCodeReloadCustomConnectFailed                    NxLocalizedErrorCode = "LOCERR_RELOAD_CUSTOM_CONNECT_FAILED"
+	NxLocalizedErrorCodeReloadOdbcReadFailed                         NxLocalizedErrorCode = "LOCERR_RELOAD_ODBC_READ_FAILED"
+	NxLocalizedErrorCodeReloadOledbReadFailed                        NxLocalizedErrorCode = "LOCERR_RELOAD_OLEDB_READ_FAILED"
+	NxLocalizedErrorCodeReloadCustomReadFailed                       NxLocalizedErrorCode = "LOCERR_RELOAD_CUSTOM_READ_FAILED"
+	NxLocalizedErrorCodeReloadBinaryLoadProhibited                   NxLocalizedErrorCode = "LOCERR_RELOAD_BINARY_LOAD_PROHIBITED"
+	NxLocalizedErrorCodeReloadConnectorStartFailed                   NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECTOR_START_FAILED"
+	NxLocalizedErrorCodeReloadConnectorNotResponding                 NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECTOR_NOT_RESPONDING"
+	NxLocalizedErrorCodeReloadConnectorReplyError                    NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECTOR_REPLY_ERROR"
+	NxLocalizedErrorCodeReloadConnectorConnectError                  NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECTOR_CONNECT_ERROR"
+	NxLocalizedErrorCodeReloadConnectorNotFoundError                 NxLocalizedErrorCode = "LOCERR_RELOAD_CONNECTOR_NOT_FOUND_ERROR"
+	NxLocalizedErrorCodeReloadInputFieldWithDuplicateKeys            NxLocalizedErrorCode = "LOCERR_RELOAD_INPUT_FIELD_WITH_DUPLICATE_KEYS"
+	NxLocalizedErrorCodeReloadConcatenateLoadNoPreviousTable         NxLocalizedErrorCode = "LOCERR_RELOAD_CONCATENATE_LOAD_NO_PREVIOUS_TABLE"
+	NxLocalizedErrorCodeReloadWrongFileFormatQvd                     NxLocalizedErrorCode = "LOCERR_RELOAD_WRONG_FILE_FORMAT_QVD"
+	NxLocalizedErrorCodeReloadActionBlockedEntitlement               NxLocalizedErrorCode = "LOCERR_RELOAD_ACTION_BLOCKED_ENTITLEMENT"
+	NxLocalizedErrorCodePersonalNewVersionAvailable                  NxLocalizedErrorCode = "LOCERR_PERSONAL_NEW_VERSION_AVAILABLE"
+	NxLocalizedErrorCodePersonalVersionExpired                       NxLocalizedErrorCode = "LOCERR_PERSONAL_VERSION_EXPIRED"
+	NxLocalizedErrorCodePersonalSectionAccessDetected                NxLocalizedErrorCode = "LOCERR_PERSONAL_SECTION_ACCESS_DETECTED"
+	NxLocalizedErrorCodePersonalAppDeletionFailed                    NxLocalizedErrorCode = "LOCERR_PERSONAL_APP_DELETION_FAILED"
+	NxLocalizedErrorCodeUserAuthenticationFailure                    NxLocalizedErrorCode = "LOCERR_USER_AUTHENTICATION_FAILURE"
+	NxLocalizedErrorCodeExportOutOfMemory                            NxLocalizedErrorCode = "LOCERR_EXPORT_OUT_OF_MEMORY"
+	NxLocalizedErrorCodeExportNoData                                 NxLocalizedErrorCode = "LOCERR_EXPORT_NO_DATA"
+	NxLocalizedErrorCodeSyncInvalidOffset                            NxLocalizedErrorCode = "LOCERR_SYNC_INVALID_OFFSET"
+	NxLocalizedErrorCodeSearchTimeout                                NxLocalizedErrorCode = "LOCERR_SEARCH_TIMEOUT"
+	NxLocalizedErrorCodeDirectDiscoveryLinkedExpressionFail          NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_LINKED_EXPRESSION_FAIL"
+	NxLocalizedErrorCodeDirectDiscoveryRowcountOverflow              NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_ROWCOUNT_OVERFLOW"
+	NxLocalizedErrorCodeDirectDiscoveryEmptyResult                   NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_EMPTY_RESULT"
+	NxLocalizedErrorCodeDirectDiscoveryDbConnectionFailed            NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_DB_CONNECTION_FAILED"
+	NxLocalizedErrorCodeDirectDiscoveryMeasureNotAllowed             NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_MEASURE_NOT_ALLOWED"
+	NxLocalizedErrorCodeDirectDiscoveryDetailNotAllowed              NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_DETAIL_NOT_ALLOWED"
+	NxLocalizedErrorCodeDirectDiscoveryNotSynthCircularAllowed       NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_NOT_SYNTH_CIRCULAR_ALLOWED"
+	NxLocalizedErrorCodeDirectDiscoveryOnlyOneDdTableAllowed         NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_ONLY_ONE_DD_TABLE_ALLOWED"
+	NxLocalizedErrorCodeDirectDiscoveryDbAuthorizationFailed         NxLocalizedErrorCode = "LOCERR_DIRECT_DISCOVERY_DB_AUTHORIZATION_FAILED"
+	NxLocalizedErrorCodeSmartLoadTableNotFound                       NxLocalizedErrorCode = "LOCERR_SMART_LOAD_TABLE_NOT_FOUND"
+	NxLocalizedErrorCodeSmartLoadTableDuplicated                     NxLocalizedErrorCode = "LOCERR_SMART_LOAD_TABLE_DUPLICATED"
+	NxLocalizedErrorCodeVariableNoName                               NxLocalizedErrorCode = "LOCERR_VARIABLE_NO_NAME"
+	NxLocalizedErrorCodeVariableDuplicateName                        NxLocalizedErrorCode = "LOCERR_VARIABLE_DUPLICATE_NAME"
+	NxLocalizedErrorCodeVariableInconsistency                        NxLocalizedErrorCode = "LOCERR_VARIABLE_INCONSISTENCY"
+	NxLocalizedErrorCodeVariableConstraintInconsistency              NxLocalizedErrorCode = "LOCERR_VARIABLE_CONSTRAINT_INCONSISTENCY"
+	NxLocalizedErrorCodeVariableConstraintFailed                     NxLocalizedErrorCode = "LOCERR_VARIABLE_CONSTRAINT_FAILED"
+	NxLocalizedErrorCodeMediaLibraryListFailed                       NxLocalizedErrorCode = "LOCERR_MEDIA_LIBRARY_LIST_FAILED"
+	NxLocalizedErrorCodeMediaLibraryContentFailed                    NxLocalizedErrorCode = "LOCERR_MEDIA_LIBRARY_CONTENT_FAILED"
+	NxLocalizedErrorCodeMediaBundlingFailed                          NxLocalizedErrorCode = "LOCERR_MEDIA_BUNDLING_FAILED"
+	NxLocalizedErrorCodeMediaUnbundlingFailed                        NxLocalizedErrorCode = "LOCERR_MEDIA_UNBUNDLING_FAILED"
+	NxLocalizedErrorCodeMediaLibraryNotFound                         NxLocalizedErrorCode = "LOCERR_MEDIA_LIBRARY_NOT_FOUND"
+	NxLocalizedErrorCodeFeatureDisabled                              NxLocalizedErrorCode = "LOCERR_FEATURE_DISABLED"
+	NxLocalizedErrorCodeLoadTooManyFields                            NxLocalizedErrorCode = "LOCERR_LOAD_TOO_MANY_FIELDS"
+	NxLocalizedErrorCodeLoadTooManyTables                            NxLocalizedErrorCode = "LOCERR_LOAD_TOO_MANY_TABLES"
+	NxLocalizedErrorCodeJsonRpcInvalidRequest                        NxLocalizedErrorCode = "LOCERR_JSON_RPC_INVALID_REQUEST"
+	NxLocalizedErrorCodeJsonRpcMethodNotFound                        NxLocalizedErrorCode = "LOCERR_JSON_RPC_METHOD_NOT_FOUND"
+	NxLocalizedErrorCodeJsonRpcInvalidParameters                     NxLocalizedErrorCode = "LOCERR_JSON_RPC_INVALID_PARAMETERS"
+	NxLocalizedErrorCodeJsonRpcInternalError                         NxLocalizedErrorCode = "LOCERR_JSON_RPC_INTERNAL_ERROR"
+	NxLocalizedErrorCodeJsonRpcResponseTooLarge                      NxLocalizedErrorCode = "LOCERR_JSON_RPC_RESPONSE_TOO_LARGE"
+	NxLocalizedErrorCodeJsonRpcParseError                            NxLocalizedErrorCode = "LOCERR_JSON_RPC_PARSE_ERROR"
+	NxLocalizedErrorCodeMqSocketConnectFailure                       NxLocalizedErrorCode = "LOCERR_MQ_SOCKET_CONNECT_FAILURE"
+	NxLocalizedErrorCodeMqSocketOpenFailure                          NxLocalizedErrorCode = "LOCERR_MQ_SOCKET_OPEN_FAILURE"
+	NxLocalizedErrorCodeMqProtocolNoRespone                          NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_NO_RESPONE"
+	NxLocalizedErrorCodeMqProtocolLibraryException                   NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_LIBRARY_EXCEPTION"
+	NxLocalizedErrorCodeMqProtocolConnectionClosed                   NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_CONNECTION_CLOSED"
+	NxLocalizedErrorCodeMqProtocolChannelClosed                      NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_CHANNEL_CLOSED"
+	NxLocalizedErrorCodeMqProtocolUnknownError                       NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_UNKNOWN_ERROR"
+	NxLocalizedErrorCodeMqProtocolInvalidStatus                      NxLocalizedErrorCode = "LOCERR_MQ_PROTOCOL_INVALID_STATUS"
+	NxLocalizedErrorCodeExtengineGrpcStatusOk                        NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_OK"
+	NxLocalizedErrorCodeExtengineGrpcStatusCancelled                 NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_CANCELLED"
+	NxLocalizedErrorCodeExtengineGrpcStatusUnknown                   NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_UNKNOWN"
+	NxLocalizedErrorCodeExtengineGrpcStatusInvalidArgument           NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_INVALID_ARGUMENT"
+	NxLocalizedErrorCodeExtengineGrpcStatusDeadlineExceeded          NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_DEADLINE_EXCEEDED"
+	NxLocalizedErrorCodeExtengineGrpcStatusNotFound                  NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_NOT_FOUND"
+	NxLocalizedErrorCodeExtengineGrpcStatusAlreadyExists             NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_ALREADY_EXISTS"
+	NxLocalizedErrorCodeExtengineGrpcStatusPermissionDenied          NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_PERMISSION_DENIED"
+	NxLocalizedErrorCodeExtengineGrpcStatusResourceExhausted         NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_RESOURCE_EXHAUSTED"
+	NxLocalizedErrorCodeExtengineGrpcStatusFailedPrecondition        NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_FAILED_PRECONDITION"
+	NxLocalizedErrorCodeExtengineGrpcStatusAborted                   NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_ABORTED"
+	NxLocalizedErrorCodeExtengineGrpcStatusOutOfRange                NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_OUT_OF_RANGE"
+	NxLocalizedErrorCodeExtengineGrpcStatusUnimplemented             NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_UNIMPLEMENTED"
+	NxLocalizedErrorCodeExtengineGrpcStatusInternal                  NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_INTERNAL"
+	NxLocalizedErrorCodeExtengineGrpcStatusUnavailable               NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_UNAVAILABLE"
+	NxLocalizedErrorCodeExtengineGrpcStatusDataLoss                  NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_DATA_LOSS"
+	NxLocalizedErrorCodeExtengineGrpcStatusUnauthenticated           NxLocalizedErrorCode = "LOCERR_EXTENGINE_GRPC_STATUS_UNAUTHENTICATED"
+	NxLocalizedErrorCodeLxwInvalidObj                                NxLocalizedErrorCode = "LOCERR_LXW_INVALID_OBJ"
+	NxLocalizedErrorCodeLxwInvalidFile                               NxLocalizedErrorCode = "LOCERR_LXW_INVALID_FILE"
+	NxLocalizedErrorCodeLxwInvalidSheet                              NxLocalizedErrorCode = "LOCERR_LXW_INVALID_SHEET"
+	NxLocalizedErrorCodeLxwInvalidExportRange                        NxLocalizedErrorCode = "LOCERR_LXW_INVALID_EXPORT_RANGE"
+	NxLocalizedErrorCodeLxwError                                     NxLocalizedErrorCode = "LOCERR_LXW_ERROR"
+	NxLocalizedErrorCodeLxwErrorMemoryMallocFailed                   NxLocalizedErrorCode = "LOCERR_LXW_ERROR_MEMORY_MALLOC_FAILED"
+	NxLocalizedErrorCodeLxwErrorCreatingXlsxFile                     NxLocalizedErrorCode = "LOCERR_LXW_ERROR_CREATING_XLSX_FILE"
+	NxLocalizedErrorCodeLxwErrorCreatingTmpfile                      NxLocalizedErrorCode = "LOCERR_LXW_ERROR_CREATING_TMPFILE"
+	NxLocalizedErrorCodeLxwErrorZipFileOperation                     NxLocalizedErrorCode = "LOCERR_LXW_ERROR_ZIP_FILE_OPERATION"
+	NxLocalizedErrorCodeLxwErrorZipFileAdd                           NxLocalizedErrorCode = "LOCERR_LXW_ERROR_ZIP_FILE_ADD"
+	NxLocalizedErrorCodeLxwErrorZipClose                             NxLocalizedErrorCode = "LOCERR_LXW_ERROR_ZIP_CLOSE"
+	NxLocalizedErrorCodeLxwErrorNullParameterIgnored                 NxLocalizedErrorCode = "LOCERR_LXW_ERROR_NULL_PARAMETER_IGNORED"
+	NxLocalizedErrorCodeLxwErrorMaxStringLengthExceeded              NxLocalizedErrorCode = "LOCERR_LXW_ERROR_MAX_STRING_LENGTH_EXCEEDED"
+	NxLocalizedErrorCodeLxwError255StringLengthExceeded              NxLocalizedErrorCode = "LOCERR_LXW_ERROR_255_STRING_LENGTH_EXCEEDED"
+	NxLocalizedErrorCodeLxwErrorSharedStringIndexNotFound            NxLocalizedErrorCode = "LOCERR_LXW_ERROR_SHARED_STRING_INDEX_NOT_FOUND"
+	NxLocalizedErrorCodeLxwErrorWorksheetIndexOutOfRange             NxLocalizedErrorCode = "LOCERR_LXW_ERROR_WORKSHEET_INDEX_OUT_OF_RANGE"
+	NxLocalizedErrorCodeLxwErrorWorksheetMaxNumberUrlsExceeded       NxLocalizedErrorCode = "LOCERR_LXW_ERROR_WORKSHEET_MAX_NUMBER_URLS_EXCEEDED"
+	NxLocalizedErrorCodeBdiStatusOk                                  NxLocalizedErrorCode = "LOCERR_BDI_STATUS_OK"
+	NxLocalizedErrorCodeBdiGenericErrorNotTranslated                 NxLocalizedErrorCode = "LOCERR_BDI_GENERIC_ERROR_NOT_TRANSLATED"
+	NxLocalizedErrorCodeTrendlineInvalidDef                          NxLocalizedErrorCode = "LOCERR_TRENDLINE_INVALID_DEF"
+	NxLocalizedErrorCodeTrendlineInvalidMathError                    NxLocalizedErrorCode = "LOCERR_TRENDLINE_INVALID_MATH_ERROR"
+	NxLocalizedErrorCodeCurlUnsupportedProtocol                      NxLocalizedErrorCode = "LOCERR_CURL_UNSUPPORTED_PROTOCOL"
+	NxLocalizedErrorCodeCurlCouldntResolveProxy                      NxLocalizedErrorCode = "LOCERR_CURL_COULDNT_RESOLVE_PROXY"
+	NxLocalizedErrorCodeCurlCouldntConnect                           NxLocalizedErrorCode = "LOCERR_CURL_COULDNT_CONNECT"
+	NxLocalizedErrorCodeCurlRemoteAccessDenied                       NxLocalizedErrorCode = "LOCERR_CURL_REMOTE_ACCESS_DENIED"
+	NxLocalizedErrorCodeCurlFtpAcceptFailed                          NxLocalizedErrorCode = "LOCERR_CURL_FTP_ACCEPT_FAILED"
+	NxLocalizedErrorCodeCurlFtpAcceptTimeout                         NxLocalizedErrorCode = "LOCERR_CURL_FTP_ACCEPT_TIMEOUT"
+	NxLocalizedErrorCodeCurlFtpCantGetHost                           NxLocalizedErrorCode = "LOCERR_CURL_FTP_CANT_GET_HOST"
+	NxLocalizedErrorCodeCurlPartialFile                              NxLocalizedErrorCode = "LOCERR_CURL_PARTIAL_FILE"
+	NxLocalizedErrorCodeCurlQuoteError                               NxLocalizedErrorCode = "LOCERR_CURL_QUOTE_ERROR"
+	NxLocalizedErrorCodeCurlWriteError                               NxLocalizedErrorCode = "LOCERR_CURL_WRITE_ERROR"
+	NxLocalizedErrorCodeCurlUploadFailed                             NxLocalizedErrorCode = "LOCERR_CURL_UPLOAD_FAILED"
+	NxLocalizedErrorCodeCurlOutOfMemory                              NxLocalizedErrorCode = "LOCERR_CURL_OUT_OF_MEMORY"
+	NxLocalizedErrorCodeCurlOperationTimedout                        NxLocalizedErrorCode = "LOCERR_CURL_OPERATION_TIMEDOUT"
+	NxLocalizedErrorCodeCurlFtpCouldntUseRest                        NxLocalizedErrorCode = "LOCERR_CURL_FTP_COULDNT_USE_REST"
+	NxLocalizedErrorCodeCurlHttpPostError                            NxLocalizedErrorCode = "LOCERR_CURL_HTTP_POST_ERROR"
+	NxLocalizedErrorCodeCurlSslConnectError                          NxLocalizedErrorCode = "LOCERR_CURL_SSL_CONNECT_ERROR"
+	NxLocalizedErrorCodeCurlFileCouldntReadFile                      NxLocalizedErrorCode = "LOCERR_CURL_FILE_COULDNT_READ_FILE"
+	NxLocalizedErrorCodeCurlLdapCannotBind                           NxLocalizedErrorCode = "LOCERR_CURL_LDAP_CANNOT_BIND"
+	NxLocalizedErrorCodeCurlLdapSearchFailed                         NxLocalizedErrorCode = "LOCERR_CURL_LDAP_SEARCH_FAILED"
+	NxLocalizedErrorCodeCurlTooManyRedirects                         NxLocalizedErrorCode = "LOCERR_CURL_TOO_MANY_REDIRECTS"
+	NxLocalizedErrorCodeCurlPeerFailedVerification                   NxLocalizedErrorCode = "LOCERR_CURL_PEER_FAILED_VERIFICATION"
+	NxLocalizedErrorCodeCurlGotNothing                               NxLocalizedErrorCode = "LOCERR_CURL_GOT_NOTHING"
+	NxLocalizedErrorCodeCurlSslEngineNotfound                        NxLocalizedErrorCode = "LOCERR_CURL_SSL_ENGINE_NOTFOUND"
+	NxLocalizedErrorCodeCurlSslEngineSetfailed                       NxLocalizedErrorCode = "LOCERR_CURL_SSL_ENGINE_SETFAILED"
+	NxLocalizedErrorCodeCurlSslCertproblem                           NxLocalizedErrorCode = "LOCERR_CURL_SSL_CERTPROBLEM"
+	NxLocalizedErrorCodeCurlSslCipher                                NxLocalizedErrorCode = "LOCERR_CURL_SSL_CIPHER"
+	NxLocalizedErrorCodeCurlSslCacert                                NxLocalizedErrorCode = "LOCERR_CURL_SSL_CACERT"
+	NxLocalizedErrorCodeCurlBadContentEncoding                       NxLocalizedErrorCode = "LOCERR_CURL_BAD_CONTENT_ENCODING"
+	NxLocalizedErrorCodeCurlLdapInvalidUrl                           NxLocalizedErrorCode = "LOCERR_CURL_LDAP_INVALID_URL"
+	NxLocalizedErrorCodeCurlUseSslFailed                             NxLocalizedErrorCode = "LOCERR_CURL_USE_SSL_FAILED"
+	NxLocalizedErrorCodeCurlSslEngineInitfailed                      NxLocalizedErrorCode = "LOCERR_CURL_SSL_ENGINE_INITFAILED"
+	NxLocalizedErrorCodeCurlLoginDenied                              NxLocalizedErrorCode = "LOCERR_CURL_LOGIN_DENIED"
+	NxLocalizedErrorCodeCurlTftpNotfound                             NxLocalizedErrorCode = "LOCERR_CURL_TFTP_NOTFOUND"
+	NxLocalizedErrorCodeCurlTftpIllegal                              NxLocalizedErrorCode = "LOCERR_CURL_TFTP_ILLEGAL"
+	NxLocalizedErrorCodeCurlSsh                                      NxLocalizedErrorCode = "LOCERR_CURL_SSH"
+	NxLocalizedErrorCodeSetexpressionTooLarge                        NxLocalizedErrorCode = "LOCERR_SETEXPRESSION_TOO_LARGE"
+	NxLocalizedErrorCodeReloadMergeLoadError                         NxLocalizedErrorCode = "LOCERR_RELOAD_MERGE_LOAD_ERROR"
+	NxLocalizedErrorCodeWinFtpDropped                                NxLocalizedErrorCode = "LOCERR_WIN_FTP_DROPPED"
+	NxLocalizedErrorCodeWinFtpNoPassiveMode                          NxLocalizedErrorCode = "LOCERR_WIN_FTP_NO_PASSIVE_MODE"
+	NxLocalizedErrorCodeWinHttpDownlevelServer                       NxLocalizedErrorCode = "LOCERR_WIN_HTTP_DOWNLEVEL_SERVER"
+	NxLocalizedErrorCodeWinHttpInvalidServerResponse                 NxLocalizedErrorCode = "LOCERR_WIN_HTTP_INVALID_SERVER_RESPONSE"
+	NxLocalizedErrorCodeWinHttpRedirectNeedsConfirmation             NxLocalizedErrorCode = "LOCERR_WIN_HTTP_REDIRECT_NEEDS_CONFIRMATION"
+	NxLocalizedErrorCodeWinInternetForceRetry                        NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_FORCE_RETRY"
+	NxLocalizedErrorCodeWinInternetCannotConnect                     NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_CANNOT_CONNECT"
+	NxLocalizedErrorCodeWinInternetConnectionAborted                 NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_CONNECTION_ABORTED"
+	NxLocalizedErrorCodeWinInternetConnectionReset                   NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_CONNECTION_RESET"
+	NxLocalizedErrorCodeWinInternetDisconnected                      NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_DISCONNECTED"
+	NxLocalizedErrorCodeWinInternetIncorrectFormat                   NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_INCORRECT_FORMAT"
+	NxLocalizedErrorCodeWinInternetInvalidCa                         NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_INVALID_CA"
+	NxLocalizedErrorCodeWinInternetInvalidOperation                  NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_INVALID_OPERATION"
+	NxLocalizedErrorCodeWinInternetInvalidUrl                        NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_INVALID_URL"
+	NxLocalizedErrorCodeWinInternetItemNotFound                      NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_ITEM_NOT_FOUND"
+	NxLocalizedErrorCodeWinInternetLoginFailure                      NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_LOGIN_FAILURE"
+	NxLocalizedErrorCodeWinInternetNameNotResolved                   NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_NAME_NOT_RESOLVED"
+	NxLocalizedErrorCodeWinInternetNeedUi                            NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_NEED_UI"
+	NxLocalizedErrorCodeWinInternetSecCertCnInvalid                  NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_SEC_CERT_CN_INVALID"
+	NxLocalizedErrorCodeWinInternetSecCertDateInvalid                NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_SEC_CERT_DATE_INVALID"
+	NxLocalizedErrorCodeWinInternetSecCertErrors                     NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_SEC_CERT_ERRORS"
+	NxLocalizedErrorCodeWinInternetSecInvalidCert                    NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_SEC_INVALID_CERT"
+	NxLocalizedErrorCodeWinInternetServerUnreachable                 NxLocalizedErrorCode = "LOCERR_WIN_INTERNET_SERVER_UNREACHABLE"
+	NxLocalizedErrorCodeBmResultTooLarge                             NxLocalizedErrorCode = "LOCERR_BM_RESULT_TOO_LARGE"
+)
+
+var valuesOfNxLocalizedErrorCode = map[NxLocalizedErrorCode]int{
+	NxLocalizedErrorCodeInternalError:                                -128,
+	NxLocalizedErrorCodeGenericUnknown:                               -1,
+	NxLocalizedErrorCodeGenericOk:                                    0,
+	NxLocalizedErrorCodeGenericNotSet:                                1,
+	NxLocalizedErrorCodeGenericNotFound:                              2,
+	NxLocalizedErrorCodeGenericAlreadyExists:                         3,
+	NxLocalizedErrorCodeGenericInvalidPath:                           4,
+	NxLocalizedErrorCodeGenericAccessDenied:                          5,
+	NxLocalizedErrorCodeGenericOutOfMemory:                           6,
+	NxLocalizedErrorCodeGenericNotInitialized:                        7,
+	NxLocalizedErrorCodeGenericInvalidParameters:                     8,
+	NxLocalizedErrorCodeGenericEmptyParameters:                       9,
+	NxLocalizedErrorCodeGenericInternalError:                         10,
+	NxLocalizedErrorCodeGenericCorruptData:                           11,
+	NxLocalizedErrorCodeGenericMemoryInconsistency:                   12,
+	NxLocalizedErrorCodeGenericInvisibleOwnerAbort:                   13,
+	NxLocalizedErrorCodeGenericProhibitValidate:                      14,
+	NxLocalizedErrorCodeGenericAborted:                               15,
+	NxLocalizedErrorCodeGenericConnectionLost:                        16,
+	NxLocalizedErrorCodeGenericUnsupportedInProductVersion:           17,
+	NxLocalizedErrorCodeGenericRestConnectionFailure:                 18,
+	NxLocalizedErrorCodeGenericMemoryLimitReached:                    19,
+	NxLocalizedErrorCodeGenericNotImplemented:                        20,
+	NxLocalizedErrorCodeGenericEngineTerminated:                      21,
+	NxLocalizedErrorCodeHttp400:                                      400,
+	NxLocalizedErrorCodeHttp401:                                      401,
+	NxLocalizedErrorCodeHttp402:                                      402,
+	NxLocalizedErrorCodeHttp403:                                      403,
+	NxLocalizedErrorCodeHttp404:                                      404,
+	NxLocalizedErrorCodeHttp405:                                      405,
+	NxLocalizedErrorCodeHttp406:                                      406,
+	NxLocalizedErrorCodeHttp407:                                      407,
+	NxLocalizedErrorCodeHttp408:                                      408,
+	NxLocalizedErrorCodeHttp409:                                      409,
+	NxLocalizedErrorCodeHttp410:                                      410,
+	NxLocalizedErrorCodeHttp411:                                      411,
+	NxLocalizedErrorCodeHttp412:                                      412,
+	NxLocalizedErrorCodeHttp413:                                      413,
+	NxLocalizedErrorCodeHttp414:                                      414,
+	NxLocalizedErrorCodeHttp415:                                      415,
+	NxLocalizedErrorCodeHttp416:                                      416,
+	NxLocalizedErrorCodeHttp417:                                      417,
+	NxLocalizedErrorCodeHttp422:                                      422,
+	NxLocalizedErrorCodeHttp423:                                      423,
+	NxLocalizedErrorCodeHttp429:                                      429,
+	NxLocalizedErrorCodeHttp500:                                      500,
+	NxLocalizedErrorCodeHttp501:                                      501,
+	NxLocalizedErrorCodeHttp502:                                      502,
+	NxLocalizedErrorCodeHttp503:                                      503,
+	NxLocalizedErrorCodeHttp504:                                      504,
+	NxLocalizedErrorCodeHttp505:                                      505,
+	NxLocalizedErrorCodeHttp509:                                      509,
+	NxLocalizedErrorCodeHttpCouldNotResolveHost:                      700,
+	NxLocalizedErrorCodeAppAlreadyExists:                             1000,
+	NxLocalizedErrorCodeAppInvalidName:                               1001,
+	NxLocalizedErrorCodeAppAlreadyOpen:                               1002,
+	NxLocalizedErrorCodeAppNotFound:                                  1003,
+	NxLocalizedErrorCodeAppImportFailed:                              1004,
+	NxLocalizedErrorCodeAppSaveFailed:                                1005,
+	NxLocalizedErrorCodeAppCreateFailed:                              1006,
+	NxLocalizedErrorCodeAppInvalid:                                   1007,
+	NxLocalizedErrorCodeAppConnectFailed:                             1008,
+	NxLocalizedErrorCodeAppAlreadyOpenInDifferentMode:                1009,
+	NxLocalizedErrorCodeAppMigrationCouldNotContactMigrationService:  1010,
+	NxLocalizedErrorCodeAppMigrationCouldNotStartMigration:           1011,
+	NxLocalizedErrorCodeAppMigrationFailure:                          1012,
+	NxLocalizedErrorCodeAppScriptMissing:                             1013,
+	NxLocalizedErrorCodeAppExportFailed:                              1014,
+	NxLocalizedErrorCodeAppSizeExceeded:                              1015,
+	NxLocalizedErrorCodeAppDirectQueryWorkloadNotSupported:           1016,
+	NxLocalizedErrorCodeAppNotOpen:                                   1017,
+	NxLocalizedErrorCodeAppEventSourceTimeout:                        1018,
+	NxLocalizedErrorCodeConnectionAlreadyExists:                      2000,
+	NxLocalizedErrorCodeConnectionNotFound:                           2001,
+	NxLocalizedErrorCodeConnectionFailedToLoad:                       2002,
+	NxLocalizedErrorCodeConnectionFailedToImport:                     2003,
+	NxLocalizedErrorCodeConnectionNameIsInvalid:                      2004,
+	NxLocalizedErrorCodeConnectionMissingCredentials:                 2005,
+	NxLocalizedErrorCodeConnectorNoFileStreamingSupport:              2300,
+	NxLocalizedErrorCodeConnectorFilesizeExceededBufferSize:          2301,
+	NxLocalizedErrorCodeFileAccessDenied:                             3000,
+	NxLocalizedErrorCodeFileNameInvalid:                              3001,
+	NxLocalizedErrorCodeFileCorrupt:                                  3002,
+	NxLocalizedErrorCodeFileNotFound:                                 3003,
+	NxLocalizedErrorCodeFileFormatUnsupported:                        3004,
+	NxLocalizedErrorCodeFileOpenedInUnsupportedMode:                  3005,
+	NxLocalizedErrorCodeFileTableNotFound:                            3006,
+	NxLocalizedErrorCodeUserAccessDenied:                             4000,
+	NxLocalizedErrorCodeUserImpersonationFailed:                      4001,
+	NxLocalizedErrorCodeServerOutOfSessionAndUserCals:                5000,
+	NxLocalizedErrorCodeServerOutOfSessionCals:                       5001,
+	NxLocalizedErrorCodeServerOutOfUsageCals:                         5002,
+	NxLocalizedErrorCodeServerOutOfCals:                              5003,
+	NxLocalizedErrorCodeServerOutOfNamedCals:                         5004,
+	NxLocalizedErrorCodeServerOffDuty:                                5005,
+	NxLocalizedErrorCodeServerBusy:                                   5006,
+	NxLocalizedErrorCodeServerLicenseExpired:                         5007,
+	NxLocalizedErrorCodeServerAjaxDisabled:                           5008,
+	NxLocalizedErrorCodeServerNoToken:                                5009,
+	NxLocalizedErrorCodeHcInvalidObject:                              6000,
+	NxLocalizedErrorCodeHcResultTooLarge:                             6001,
+	NxLocalizedErrorCodeHcInvalidObjectState:                         6002,
+	NxLocalizedErrorCodeHcModalObjectError:                           6003,
+	NxLocalizedErrorCodeCalcInvalidDef:                               7000,
+	NxLocalizedErrorCodeCalcNotInLib:                                 7001,
+	NxLocalizedErrorCodeCalcHeapError:                                7002,
+	NxLocalizedErrorCodeCalcTooLarge:                                 7003,
+	NxLocalizedErrorCodeCalcTimeout:                                  7004,
+	NxLocalizedErrorCodeCalcEvalConditionFailed:                      7005,
+	NxLocalizedErrorCodeCalcMixedLinkedAggregation:                   7006,
+	NxLocalizedErrorCodeCalcMissingLinked:                            7007,
+	NxLocalizedErrorCodeCalcInvalidColSort:                           7008,
+	NxLocalizedErrorCodeCalcPagesTooLarge:                            7009,
+	NxLocalizedErrorCodeCalcSemanticFieldNotAllowed:                  7010,
+	NxLocalizedErrorCodeCalcValidationStateInvalid:                   7011,
+	NxLocalizedErrorCodeCalcPivotDimensionsAlreadyExists:             7012,
+	NxLocalizedErrorCodeCalcMissingLinkedField:                       7013,
+	NxLocalizedErrorCodeCalcNotCalculated:                            7014,
+	NxLocalizedErrorCodeLayoutExtendsInvalidId:                       8000,
+	NxLocalizedErrorCodeLayoutLinkedObjectNotFound:                   8001,
+	NxLocalizedErrorCodeLayoutLinkedObjectInvalid:                    8002,
+	NxLocalizedErrorCodePersistenceWriteFailed:                       9000,
+	NxLocalizedErrorCodePersistenceReadFailed:                        9001,
+	NxLocalizedErrorCodePersistenceDeleteFailed:                      9002,
+	NxLocalizedErrorCodePersistenceNotFound:                          9003,
+	NxLocalizedErrorCodePersistenceUnsupportedVersion:                9004,
+	NxLocalizedErrorCodePersistenceMigrationFailedReadOnly:           9005,
+	NxLocalizedErrorCodePersistenceMigrationCancelled:                9006,
+	NxLocalizedErrorCodePersistenceMigrationBackupFailed:             9007,
+	NxLocalizedErrorCodePersistenceDiskFull:                          9008,
+	NxLocalizedErrorCodePersistenceNotSupportedForSessionApp:         9009,
+	NxLocalizedErrorCodePersistenceMoveFailed:                        9010,
+	NxLocalizedErrorCodePersistenceObjectLocked:                      9011,
+	NxLocalizedErrorCodePersistenceEncryptionKeyMigrationOngoing:     9012,
+	NxLocalizedErrorCodePersistenceSyncSetChunkInvalidParameters:     9510,
+	NxLocalizedErrorCodePersistenceSyncGetChunkInvalidParameters:     9511,
+	NxLocalizedErrorCodeScriptDatasourceAccessDenied:                 10000,
+	NxLocalizedErrorCodeReloadInProgress:                             11000,
+	NxLocalizedErrorCodeReloadTableXNotFound:                         11001,
+	NxLocalizedErrorCodeReloadUnknownStatement:                       11002,
+	NxLocalizedErrorCodeReloadExpectedSomethingFoundUnknown:          11003,
+	NxLocalizedErrorCodeReloadExpectedNothingFoundUnknown:            11004,
+	NxLocalizedErrorCodeReloadExpectedOneOf1TokensFoundUnknown:       11005,
+	NxLocalizedErrorCodeReloadExpectedOneOf2TokensFoundUnknown:       11006,
+	NxLocalizedErrorCodeReloadExpectedOneOf3TokensFoundUnknown:       11007,
+	NxLocalizedErrorCodeReloadExpectedOneOf4TokensFoundUnknown:       11008,
+	NxLocalizedErrorCodeReloadExpectedOneOf5TokensFoundUnknown:       11009,
+	NxLocalizedErrorCodeReloadExpectedOneOf6TokensFoundUnknown:       11010,
+	NxLocalizedErrorCodeReloadExpectedOneOf7TokensFoundUnknown:       11011,
+	NxLocalizedErrorCodeReloadExpectedOneOf8OrMoreTokensFoundUnknown: 11012,
+	NxLocalizedErrorCodeReloadFieldXNotFound:                         11013,
+	NxLocalizedErrorCodeReloadMappingTableXNotFound:                  11014,
+	NxLocalizedErrorCodeReloadLibConnectionXNotFound:                 11015,
+	NxLocalizedErrorCodeReloadNameAlreadyTaken:                       11016,
+	NxLocalizedErrorCodeReloadWrongFileFormatDif:                     11017,
+	NxLocalizedErrorCodeReloadWrongFileFormatBiff:                    11018,
+	NxLocalizedErrorCodeReloadWrongFileFormatEncrypted:               11019,
+	NxLocalizedErrorCodeReloadOpenFileError:                          11020,
+	NxLocalizedErrorCodeReloadAutoGenerateCount:                      11021,
+	NxLocalizedErrorCodeReloadPeIllegalPrefixComb:                    11022,
+	NxLocalizedErrorCodeReloadMatchingControlStatementError:          11023,
+	NxLocalizedErrorCodeReloadMatchingLibpathXNotFound:               11024,
+	NxLocalizedErrorCodeReloadMatchingLibpathXInvalid:                11025,
+	NxLocalizedErrorCodeReloadMatchingLibpathXOutside:                11026,
+	NxLocalizedErrorCodeReloadNoQualifiedPathForFile:                 11027,
+	NxLocalizedErrorCodeReloadModeStatementOnlyForLibPaths:           11028,
+	NxLocalizedErrorCodeReloadInconsistentUseOfSemanticFields:        11029,
+	NxLocalizedErrorCodeReloadNoOpenDatabase:                         11030,
+	NxLocalizedErrorCodeReloadAggregationRequiredByGroupBy:           11031,
+	NxLocalizedErrorCodeReloadConnectMustUseLibPrefixInThisMode:      11032,
+	NxLocalizedErrorCodeReloadOdbcConnectFailed:                      11033,
+	NxLocalizedErrorCodeReloadOledbConnectFailed:                     11034,
+	NxLocalizedErrorCodeReloadCustomConnectFailed:                    11035,
+	NxLocalizedErrorCodeReloadOdbcReadFailed:                         11036,
+	NxLocalizedErrorCodeReloadOledbReadFailed:                        11037,
+	NxLocalizedErrorCodeReloadCustomReadFailed:                       11038,
+	NxLocalizedErrorCodeReloadBinaryLoadProhibited:                   11039,
+	NxLocalizedErrorCodeReloadConnectorStartFailed:                   11040,
+	NxLocalizedErrorCodeReloadConnectorNotResponding:                 11041,
+	NxLocalizedErrorCodeReloadConnectorReplyError:                    11042,
+	NxLocalizedErrorCodeReloadConnectorConnectError:                  11043,
+	NxLocalizedErrorCodeReloadConnectorNotFoundError:                 11044,
+	NxLocalizedErrorCodeReloadInputFieldWithDuplicateKeys:            11045,
+	NxLocalizedErrorCodeReloadConcatenateLoadNoPreviousTable:         11046,
+	NxLocalizedErrorCodeReloadWrongFileFormatQvd:                     11047,
+	NxLocalizedErrorCodeReloadActionBlockedEntitlement:               11048,
+	NxLocalizedErrorCodePersonalNewVersionAvailable:                  12000,
+	NxLocalizedErrorCodePersonalVersionExpired:                       12001,
+	NxLocalizedErrorCodePersonalSectionAccessDetected:                12002,
+	NxLocalizedErrorCodePersonalAppDeletionFailed:                    12003,
+	NxLocalizedErrorCodeUserAuthenticationFailure:                    12004,
+	NxLocalizedErrorCodeExportOutOfMemory:                            13000,
+	NxLocalizedErrorCodeExportNoData:                                 13001,
+	NxLocalizedErrorCodeSyncInvalidOffset:                            14000,
+	NxLocalizedErrorCodeSearchTimeout:                                15000,
+	NxLocalizedErrorCodeDirectDiscoveryLinkedExpressionFail:          16000,
+	NxLocalizedErrorCodeDirectDiscoveryRowcountOverflow:              16001,
+	NxLocalizedErrorCodeDirectDiscoveryEmptyResult:                   16002,
+	NxLocalizedErrorCodeDirectDiscoveryDbConnectionFailed:            16003,
+	NxLocalizedErrorCodeDirectDiscoveryMeasureNotAllowed:             16004,
+	NxLocalizedErrorCodeDirectDiscoveryDetailNotAllowed:              16005,
+	NxLocalizedErrorCodeDirectDiscoveryNotSynthCircularAllowed:       16006,
+	NxLocalizedErrorCodeDirectDiscoveryOnlyOneDdTableAllowed:         16007,
+	NxLocalizedErrorCodeDirectDiscoveryDbAuthorizationFailed:         16008,
+	NxLocalizedErrorCodeSmartLoadTableNotFound:                       17000,
+	NxLocalizedErrorCodeSmartLoadTableDuplicated:                     17001,
+	NxLocalizedErrorCodeVariableNoName:                               18000,
+	NxLocalizedErrorCodeVariableDuplicateName:                        18001,
+	NxLocalizedErrorCodeVariableInconsistency:                        18002,
+	NxLocalizedErrorCodeVariableConstraintInconsistency:              18003,
+	NxLocalizedErrorCodeVariableConstraintFailed:                     18004,
+	NxLocalizedErrorCodeMediaLibraryListFailed:                       19000,
+	NxLocalizedErrorCodeMediaLibraryContentFailed:                    19001,
+	NxLocalizedErrorCodeMediaBundlingFailed:                          19002,
+	NxLocalizedErrorCodeMediaUnbundlingFailed:                        19003,
+	NxLocalizedErrorCodeMediaLibraryNotFound:                         19004,
+	NxLocalizedErrorCodeFeatureDisabled:                              20000,
+	NxLocalizedErrorCodeLoadTooManyFields:                            21000,
+	NxLocalizedErrorCodeLoadTooManyTables:                            21001,
+	NxLocalizedErrorCodeJsonRpcInvalidRequest:                        -32600,
+	NxLocalizedErrorCodeJsonRpcMethodNotFound:                        -32601,
+	NxLocalizedErrorCodeJsonRpcInvalidParameters:                     -32602,
+	NxLocalizedErrorCodeJsonRpcInternalError:                         -32603,
+	NxLocalizedErrorCodeJsonRpcResponseTooLarge:                      -32604,
+	NxLocalizedErrorCodeJsonRpcParseError:                            -32700,
+	NxLocalizedErrorCodeMqSocketConnectFailure:                       33000,
+	NxLocalizedErrorCodeMqSocketOpenFailure:                          33001,
+	NxLocalizedErrorCodeMqProtocolNoRespone:                          33002,
+	NxLocalizedErrorCodeMqProtocolLibraryException:                   33003,
+	NxLocalizedErrorCodeMqProtocolConnectionClosed:                   33004,
+	NxLocalizedErrorCodeMqProtocolChannelClosed:                      33005,
+	NxLocalizedErrorCodeMqProtocolUnknownError:                       33006,
+	NxLocalizedErrorCodeMqProtocolInvalidStatus:                      33007,
+	NxLocalizedErrorCodeExtengineGrpcStatusOk:                        22000,
+	NxLocalizedErrorCodeExtengineGrpcStatusCancelled:                 22001,
+	NxLocalizedErrorCodeExtengineGrpcStatusUnknown:                   22002,
+	NxLocalizedErrorCodeExtengineGrpcStatusInvalidArgument:           22003,
+	NxLocalizedErrorCodeExtengineGrpcStatusDeadlineExceeded:          22004,
+	NxLocalizedErrorCodeExtengineGrpcStatusNotFound:                  22005,
+	NxLocalizedErrorCodeExtengineGrpcStatusAlreadyExists:             22006,
+	NxLocalizedErrorCodeExtengineGrpcStatusPermissionDenied:          22007,
+	NxLocalizedErrorCodeExtengineGrpcStatusResourceExhausted:         22008,
+	NxLocalizedErrorCodeExtengineGrpcStatusFailedPrecondition:        22009,
+	NxLocalizedErrorCodeExtengineGrpcStatusAborted:                   22010,
+	NxLocalizedErrorCodeExtengineGrpcStatusOutOfRange:                22011,
+	NxLocalizedErrorCodeExtengineGrpcStatusUnimplemented:             22012,
+	NxLocalizedErrorCodeExtengineGrpcStatusInternal:                  22013,
+	NxLocalizedErrorCodeExtengineGrpcStatusUnavailable:               22014,
+	NxLocalizedErrorCodeExtengineGrpcStatusDataLoss:                  22015,
+	NxLocalizedErrorCodeExtengineGrpcStatusUnauthenticated:           22016,
+	NxLocalizedErrorCodeLxwInvalidObj:                                23001,
+	NxLocalizedErrorCodeLxwInvalidFile:                               23002,
+	NxLocalizedErrorCodeLxwInvalidSheet:                              23003,
+	NxLocalizedErrorCodeLxwInvalidExportRange:                        23004,
+	NxLocalizedErrorCodeLxwError:                                     23005,
+	NxLocalizedErrorCodeLxwErrorMemoryMallocFailed:                   23006,
+	NxLocalizedErrorCodeLxwErrorCreatingXlsxFile:                     23007,
+	NxLocalizedErrorCodeLxwErrorCreatingTmpfile:                      23008,
+	NxLocalizedErrorCodeLxwErrorZipFileOperation:                     23009,
+	NxLocalizedErrorCodeLxwErrorZipFileAdd:                           23010,
+	NxLocalizedErrorCodeLxwErrorZipClose:                             23011,
+	NxLocalizedErrorCodeLxwErrorNullParameterIgnored:                 23012,
+	NxLocalizedErrorCodeLxwErrorMaxStringLengthExceeded:              23013,
+	NxLocalizedErrorCodeLxwError255StringLengthExceeded:              23014,
+	NxLocalizedErrorCodeLxwErrorSharedStringIndexNotFound:            23015,
+	NxLocalizedErrorCodeLxwErrorWorksheetIndexOutOfRange:             23016,
+	NxLocalizedErrorCodeLxwErrorWorksheetMaxNumberUrlsExceeded:       23017,
+	NxLocalizedErrorCodeBdiStatusOk:                                  24000,
+	NxLocalizedErrorCodeBdiGenericErrorNotTranslated:                 24001,
+	NxLocalizedErrorCodeTrendlineInvalidDef:                          25000,
+	NxLocalizedErrorCodeTrendlineInvalidMathError:                    25001,
+	NxLocalizedErrorCodeCurlUnsupportedProtocol:                      30000,
+	NxLocalizedErrorCodeCurlCouldntResolveProxy:                      30001,
+	NxLocalizedErrorCodeCurlCouldntConnect:                           30002,
+	NxLocalizedErrorCodeCurlRemoteAccessDenied:                       30003,
+	NxLocalizedErrorCodeCurlFtpAcceptFailed:                          30004,
+	NxLocalizedErrorCodeCurlFtpAcceptTimeout:                         30005,
+	NxLocalizedErrorCodeCurlFtpCantGetHost:                           30006,
+	NxLocalizedErrorCodeCurlPartialFile:                              30007,
+	NxLocalizedErrorCodeCurlQuoteError:                               30008,
+	NxLocalizedErrorCodeCurlWriteError:                               30009,
+	NxLocalizedErrorCodeCurlUploadFailed:                             30010,
+	NxLocalizedErrorCodeCurlOutOfMemory:                              30011,
+	NxLocalizedErrorCodeCurlOperationTimedout:                        30012,
+	NxLocalizedErrorCodeCurlFtpCouldntUseRest:                        30013,
+	NxLocalizedErrorCodeCurlHttpPostError:                            30014,
+	NxLocalizedErrorCodeCurlSslConnectError:                          30015,
+	NxLocalizedErrorCodeCurlFileCouldntReadFile:                      30016,
+	NxLocalizedErrorCodeCurlLdapCannotBind:                           30017,
+	NxLocalizedErrorCodeCurlLdapSearchFailed:                         30018,
+	NxLocalizedErrorCodeCurlTooManyRedirects:                         30019,
+	NxLocalizedErrorCodeCurlPeerFailedVerification:                   30020,
+	NxLocalizedErrorCodeCurlGotNothing:                               30021,
+	NxLocalizedErrorCodeCurlSslEngineNotfound:                        30022,
+	NxLocalizedErrorCodeCurlSslEngineSetfailed:                       30023,
+	NxLocalizedErrorCodeCurlSslCertproblem:                           30024,
+	NxLocalizedErrorCodeCurlSslCipher:                                30025,
+	NxLocalizedErrorCodeCurlSslCacert:                                30026,
+	NxLocalizedErrorCodeCurlBadContentEncoding:                       30027,
+	NxLocalizedErrorCodeCurlLdapInvalidUrl:                           30028,
+	NxLocalizedErrorCodeCurlUseSslFailed:                             30029,
+	NxLocalizedErrorCodeCurlSslEngineInitfailed:                      30030,
+	NxLocalizedErrorCodeCurlLoginDenied:                              30031,
+	NxLocalizedErrorCodeCurlTftpNotfound:                             30032,
+	NxLocalizedErrorCodeCurlTftpIllegal:                              30033,
+	NxLocalizedErrorCodeCurlSsh:                                      30034,
+	NxLocalizedErrorCodeSetexpressionTooLarge:                        30100,
+	NxLocalizedErrorCodeReloadMergeLoadError:                         30101,
+	NxLocalizedErrorCodeWinFtpDropped:                                30200,
+	NxLocalizedErrorCodeWinFtpNoPassiveMode:                          30201,
+	NxLocalizedErrorCodeWinHttpDownlevelServer:                       30210,
+	NxLocalizedErrorCodeWinHttpInvalidServerResponse:                 30211,
+	NxLocalizedErrorCodeWinHttpRedirectNeedsConfirmation:             30212,
+	NxLocalizedErrorCodeWinInternetForceRetry:                        30220,
+	NxLocalizedErrorCodeWinInternetCannotConnect:                     30221,
+	NxLocalizedErrorCodeWinInternetConnectionAborted:                 30222,
+	NxLocalizedErrorCodeWinInternetConnectionReset:                   30223,
+	NxLocalizedErrorCodeWinInternetDisconnected:                      30224,
+	NxLocalizedErrorCodeWinInternetIncorrectFormat:                   30225,
+	NxLocalizedErrorCodeWinInternetInvalidCa:                         30226,
+	NxLocalizedErrorCodeWinInternetInvalidOperation:                  30227,
+	NxLocalizedErrorCodeWinInternetInvalidUrl:                        30228,
+	NxLocalizedErrorCodeWinInternetItemNotFound:                      30229,
+	NxLocalizedErrorCodeWinInternetLoginFailure:                      30230,
+	NxLocalizedErrorCodeWinInternetNameNotResolved:                   30231,
+	NxLocalizedErrorCodeWinInternetNeedUi:                            30232,
+	NxLocalizedErrorCodeWinInternetSecCertCnInvalid:                  30233,
+	NxLocalizedErrorCodeWinInternetSecCertDateInvalid:                30234,
+	NxLocalizedErrorCodeWinInternetSecCertErrors:                     30235,
+	NxLocalizedErrorCodeWinInternetSecInvalidCert:                    30236,
+	NxLocalizedErrorCodeWinInternetServerUnreachable:                 30237,
+	NxLocalizedErrorCodeBmResultTooLarge:                             31000,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxLocalizedErrorCode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxLocalizedErrorCode constants
+func (e NxLocalizedErrorCode) IsValid() bool {
+	_, ok := valuesOfNxLocalizedErrorCode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxLocalizedErrorCode) Int() (int, bool) {
+	n, ok := valuesOfNxLocalizedErrorCode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxLocalizedErrorCode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxLocalizedErrorCode, "NxLocalizedErrorCode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 func ErrorCodeLookup(c int) string {
 	switch c {
 	case -128:
@@ -4072,6 +8539,51 @@ func ErrorCodeLookup(c int) string {
 	return ""
 }
 
+type NxLocalizedWarningCode string
+
+const (
+	NxLocalizedWarningCodePersonalReloadRequired           NxLocalizedWarningCode = "LOCWARN_PERSONAL_RELOAD_REQUIRED"
+	NxLocalizedWarningCodePersonalVersionExpiresSoon       NxLocalizedWarningCode = "LOCWARN_PERSONAL_VERSION_EXPIRES_SOON"
+	NxLocalizedWarningCodeExportDataTruncated              NxLocalizedWarningCode = "LOCWARN_EXPORT_DATA_TRUNCATED"
+	NxLocalizedWarningCodeCouldNotOpenAllObjects           NxLocalizedWarningCode = "LOCWARN_COULD_NOT_OPEN_ALL_OBJECTS"
+	NxLocalizedWarningCodeSearchInvalidSearchfieldDetected NxLocalizedWarningCode = "LOCWARN_SEARCH_INVALID_SEARCHFIELD_DETECTED"
+)
+
+var valuesOfNxLocalizedWarningCode = map[NxLocalizedWarningCode]int{
+	NxLocalizedWarningCodePersonalReloadRequired:           0,
+	NxLocalizedWarningCodePersonalVersionExpiresSoon:       1,
+	NxLocalizedWarningCodeExportDataTruncated:              1000,
+	NxLocalizedWarningCodeCouldNotOpenAllObjects:           2000,
+	NxLocalizedWarningCodeSearchInvalidSearchfieldDetected: 3000,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxLocalizedWarningCode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxLocalizedWarningCode constants
+func (e NxLocalizedWarningCode) IsValid() bool {
+	_, ok := valuesOfNxLocalizedWarningCode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxLocalizedWarningCode) Int() (int, bool) {
+	n, ok := valuesOfNxLocalizedWarningCode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxLocalizedWarningCode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxLocalizedWarningCode, "NxLocalizedWarningCode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxMatchingFieldInfo struct {
 	// Name of the field.
 	Name string `json:"qName,omitempty"`
@@ -4079,6 +8591,45 @@ type NxMatchingFieldInfo struct {
 	Tags []string `json:"qTags,omitempty"`
 }
 
+type NxMatchingFieldMode string
+
+const (
+	NxMatchingFieldModeAll NxMatchingFieldMode = "MATCHINGFIELDMODE_MATCH_ALL"
+	NxMatchingFieldModeOne NxMatchingFieldMode = "MATCHINGFIELDMODE_MATCH_ONE"
+)
+
+var valuesOfNxMatchingFieldMode = map[NxMatchingFieldMode]int{
+	NxMatchingFieldModeAll: 0,
+	NxMatchingFieldModeOne: 1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxMatchingFieldMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxMatchingFieldMode constants
+func (e NxMatchingFieldMode) IsValid() bool {
+	_, ok := valuesOfNxMatchingFieldMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxMatchingFieldMode) Int() (int, bool) {
+	n, ok := valuesOfNxMatchingFieldMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxMatchingFieldMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxMatchingFieldMode, "NxMatchingFieldMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 // Either qDef or qLibraryId must be set, but not both. If both are set, the library measure's qDef and qLabel will be used.
 // If the measure is set in the hypercube and not in the library, this measure cannot be shared with other objects.
 // A measure that is set in the library can be used by many objects.
@@ -4113,6 +8664,42 @@ type NxMeasure struct {
 	MiniChartDef *NxMiniChartDef   `json:"qMiniChartDef,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMeasure) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMeasure) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Def.validate(joinPath(path, "qDef")); err != nil {
+		return err
+	}
+	if err := v.SortBy.validate(joinPath(path, "qSortBy")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.AttributeExpressions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeExpressions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttributeDimensions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeDimensions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.TrendLines {
+		if err := item0.validate(indexPath(joinPath(path, "qTrendLines"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.MiniChartDef.validate(joinPath(path, "qMiniChartDef")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Layout for NxInlineMeasureDef.
 type NxMeasureInfo struct {
 	// Corresponds to the label of the measure.
@@ -4133,7 +8720,7 @@ type NxMeasureInfo struct {
 	// • A or NX_SORT_INDICATE_ASC
 	//
 	// • D or NX_SORT_INDICATE_DESC
-	SortIndicator string `json:"qSortIndicator,omitempty"`
+	SortIndicator NxSortIndicatorType `json:"qSortIndicator,omitempty"`
 	// Format of the field.
 	// This parameter is optional.
 	NumFormat *FieldAttributes `json:"qNumFormat,omitempty"`
@@ -4161,6 +8748,51 @@ type NxMeasureInfo struct {
 	MiniChart  *NxMiniChart   `json:"qMiniChart,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMeasureInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMeasureInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qApprMaxGlyphCount"), v.ApprMaxGlyphCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qSortIndicator"), v.SortIndicator); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.AttrExprInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrExprInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttrDimInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrDimInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.TrendLines {
+		if err := item0.validate(indexPath(joinPath(path, "qTrendLines"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.MiniChart.validate(joinPath(path, "qMiniChart")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Layout for NxMetaDef.
 type NxMeta struct {
 	// Name.
@@ -4187,6 +8819,26 @@ type NxMiniChart struct {
 	Error *NxValidationError `json:"qError,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMiniChart) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMiniChart) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.AttrExprInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrExprInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxMiniChartCell struct {
 	// Some text.
 	Text string `json:"qText,omitempty"`
@@ -4209,6 +8861,21 @@ type NxMiniChartCell struct {
 	AttrExps *NxAttributeExpressionValues `json:"qAttrExps,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMiniChartCell) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMiniChartCell) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNumber"), v.ElemNumber, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxMiniChartData struct {
 	// Array of data.
 	Matrix []NxMiniChartRows `json:"qMatrix,omitempty"`
@@ -4218,6 +8885,26 @@ type NxMiniChartData struct {
 	Error *NxValidationError `json:"qError,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMiniChartData) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMiniChartData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Matrix {
+		if err := item0.validate(indexPath(joinPath(path, "qMatrix"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxMiniChartDef struct {
 	// Expression or field name.
 	Def string `json:"qDef,omitempty"`
@@ -4234,13 +8921,77 @@ type NxMiniChartDef struct {
 	NullSuppression bool `json:"qNullSuppression,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMiniChartDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMiniChartDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.SortBy.validate(joinPath(path, "qSortBy")); err != nil {
+		return err
+	}
+	if err := v.OtherTotalSpec.validate(joinPath(path, "qOtherTotalSpec")); err != nil {
+		return err
+	}
+	if v.MaxNumberPoints != nil {
+		if err := validateRange(joinPath(path, "qMaxNumberPoints"), *v.MaxNumberPoints, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttributeExpressions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeExpressions"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxMiniChartRows []*NxMiniChartCell
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v NxMiniChartRows) Validate() error {
+	return v.validate("")
+}
+
+func (v NxMiniChartRows) validate(path string) error {
+	for i0, item0 := range v {
+		if err := item0.validate(indexPath(path, i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxMultiRangeSelectInfo struct {
 	Ranges          []*NxRangeSelectInfo `json:"qRanges,omitempty"`
 	ColumnsToSelect []int                `json:"qColumnsToSelect,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxMultiRangeSelectInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxMultiRangeSelectInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Ranges {
+		if err := item0.validate(indexPath(joinPath(path, "qRanges"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ColumnsToSelect {
+		if err := validateRange(indexPath(joinPath(path, "qColumnsToSelect"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type NxPage struct {
 	// Position from the left.
 	// Corresponds to the first column.
@@ -4254,6 +9005,30 @@ type NxPage struct {
 	Height int `json:"qHeight,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPage) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qLeft"), v.Left, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTop"), v.Top, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qWidth"), v.Width, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHeight"), v.Height, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Stability: stable
 type NxPageTreeLevel struct {
 	// The first dimension that is to be part of the tree, counted from the left. For example, if qLeft is equal to 1, omit nodes from the first dimension in the current sort order.
@@ -4263,6 +9038,26 @@ type NxPageTreeLevel struct {
 	Depth *int `json:"qDepth,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPageTreeLevel) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPageTreeLevel) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qLeft"), v.Left, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.Depth != nil {
+		if err := validateRange(joinPath(path, "qDepth"), *v.Depth, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines an area of the tree to be fetched.
 // Stability: stable
 type NxPageTreeNode struct {
@@ -4272,6 +9067,21 @@ type NxPageTreeNode struct {
 	AllValues bool `json:"qAllValues,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPageTreeNode) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPageTreeNode) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Area.validate(joinPath(path, "qArea")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxPatch struct {
 	// Operation to perform.
 	//
@@ -4282,7 +9092,7 @@ type NxPatch struct {
 	// • remove or Remove
 	//
 	// • replace or Replace
-	Op string `json:"qOp,omitempty"`
+	Op NxPatchOperationType `json:"qOp,omitempty"`
 	// Path to the property to add, remove or replace.
 	Path string `json:"qPath,omitempty"`
 	// This parameter is not used in a remove operation.
@@ -4292,6 +9102,62 @@ type NxPatch struct {
 	Value string `json:"qValue,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPatch) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPatch) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qOp"), v.Op); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxPatchOperationType string
+
+const (
+	NxPatchOperationTypeAdd     NxPatchOperationType = "add"
+	NxPatchOperationTypeRemove  NxPatchOperationType = "remove"
+	NxPatchOperationTypeReplace NxPatchOperationType = "replace"
+)
+
+var valuesOfNxPatchOperationType = map[NxPatchOperationType]int{
+	NxPatchOperationTypeAdd:     0,
+	NxPatchOperationTypeRemove:  1,
+	NxPatchOperationTypeReplace: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxPatchOperationType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxPatchOperationType constants
+func (e NxPatchOperationType) IsValid() bool {
+	_, ok := valuesOfNxPatchOperationType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxPatchOperationType) Int() (int, bool) {
+	n, ok := valuesOfNxPatchOperationType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxPatchOperationType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxPatchOperationType, "NxPatchOperationType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxPatches struct {
 	// Identifier and type of the object.
 	Info *NxInfo `json:"qInfo,omitempty"`
@@ -4301,12 +9167,34 @@ type NxPatches struct {
 	Children []*NxPatches `json:"qChildren,omitempty"`
 }
 
-type NxPivotDimensionCell struct {
-	// Some text.
-	Text string `json:"qText,omitempty"`
-	// Rank number of the value.
-	// If set to -1, it means that the value is not an element number.
-	ElemNo int `json:"qElemNo,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPatches) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPatches) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Patches {
+		if err := item0.validate(indexPath(joinPath(path, "qPatches"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Children {
+		if err := item0.validate(indexPath(joinPath(path, "qChildren"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NxPivotDimensionCell struct {
+	// Some text.
+	Text string `json:"qText,omitempty"`
+	// Rank number of the value.
+	// If set to -1, it means that the value is not an element number.
+	ElemNo int `json:"qElemNo,omitempty"`
 	// Value of the cell.
 	// Is set to NaN , if the value is not a number.
 	Value Float64 `json:"qValue,omitempty"`
@@ -4339,7 +9227,7 @@ type NxPivotDimensionCell struct {
 	// • U or NX_DIM_CELL_NULL
 	//
 	// • G or NX_DIM_CELL_GENERATED
-	Type string `json:"qType,omitempty"`
+	Type NxDimCellType `json:"qType,omitempty"`
 	// Number of elements that are part of the previous tail.
 	// This number depends on the paging, more particularly it depends on the values defined in qTop and qHeight .
 	Up int `json:"qUp,omitempty"`
@@ -4356,6 +9244,38 @@ type NxPivotDimensionCell struct {
 	AttrDims *NxAttributeDimValues `json:"qAttrDims,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPivotDimensionCell) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPivotDimensionCell) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNo"), v.ElemNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qUp"), v.Up, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qDown"), v.Down, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SubNodes {
+		if err := item0.validate(indexPath(joinPath(path, "qSubNodes"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxPivotPage struct {
 	// Information about the left dimension values of a pivot table.
 	Left []*NxPivotDimensionCell `json:"qLeft,omitempty"`
@@ -4367,6 +9287,36 @@ type NxPivotPage struct {
 	Area *Rect `json:"qArea,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPivotPage) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPivotPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Left {
+		if err := item0.validate(indexPath(joinPath(path, "qLeft"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Top {
+		if err := item0.validate(indexPath(joinPath(path, "qTop"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Data {
+		if err := item0.validate(indexPath(joinPath(path, "qData"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Area.validate(joinPath(path, "qArea")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxPivotValuePoint struct {
 	// Label of the cell.
 	// This parameter is optional.
@@ -4398,12 +9348,30 @@ type NxPivotValuePoint struct {
 	// • U or NX_DIM_CELL_NULL
 	//
 	// • G or NX_DIM_CELL_GENERATED
-	Type string `json:"qType,omitempty"`
+	Type NxDimCellType `json:"qType,omitempty"`
 	// Attribute expressions values.
 	AttrExps *NxAttributeExpressionValues `json:"qAttrExps,omitempty"`
 	AttrDims *NxAttributeDimValues        `json:"qAttrDims,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxPivotValuePoint) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxPivotValuePoint) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxRange struct {
 	// Position in the expression of the first character of the field name.
 	From int `json:"qFrom,omitempty"`
@@ -4411,6 +9379,24 @@ type NxRange struct {
 	Count int `json:"qCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxRange) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxRange) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qFrom"), v.From, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCount"), v.Count, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxRangeSelectInfo struct {
 	// Range of values.
 	Range *Range `json:"qRange,omitempty"`
@@ -4419,6 +9405,21 @@ type NxRangeSelectInfo struct {
 	MeasureIx int `json:"qMeasureIx,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxRangeSelectInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxRangeSelectInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qMeasureIx"), v.MeasureIx, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxSelectionCell struct {
 	// Type of cells to select.
 	//
@@ -4429,7 +9430,7 @@ type NxSelectionCell struct {
 	// • T or NX_CELL_TOP
 	//
 	// • L or NX_CELL_LEFT
-	Type string `json:"qType,omitempty"`
+	Type NxSelectionCellType `json:"qType,omitempty"`
 	// Column index to select.
 	// Indexing starts from 0.
 	// If the cell's type is:
@@ -4452,6 +9453,68 @@ type NxSelectionCell struct {
 	Row int `json:"qRow,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxSelectionCell) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxSelectionCell) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCol"), v.Col, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qRow"), v.Row, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxSelectionCellType string
+
+const (
+	NxSelectionCellTypeData NxSelectionCellType = "D"
+	NxSelectionCellTypeTop  NxSelectionCellType = "T"
+	NxSelectionCellTypeLeft NxSelectionCellType = "L"
+)
+
+var valuesOfNxSelectionCellType = map[NxSelectionCellType]int{
+	NxSelectionCellTypeData: 0,
+	NxSelectionCellTypeTop:  1,
+	NxSelectionCellTypeLeft: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxSelectionCellType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxSelectionCellType constants
+func (e NxSelectionCellType) IsValid() bool {
+	_, ok := valuesOfNxSelectionCellType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxSelectionCellType) Int() (int, bool) {
+	n, ok := valuesOfNxSelectionCellType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxSelectionCellType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxSelectionCellType, "NxSelectionCellType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxSelectionInfo struct {
 	// Is set to true if the visualization is in selection mode.
 	// For more information about the selection mode, see BeginSelections Method.
@@ -4469,6 +9532,21 @@ type NxSimpleDimValue struct {
 	ElemNo int `json:"qElemNo,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxSimpleDimValue) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxSimpleDimValue) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNo"), v.ElemNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxSimpleValue struct {
 	// Text related to the attribute expression value.
 	Text string `json:"qText,omitempty"`
@@ -4477,6 +9555,47 @@ type NxSimpleValue struct {
 	Num Float64 `json:"qNum,omitempty"`
 }
 
+type NxSortIndicatorType string
+
+const (
+	NxSortIndicatorTypeNone NxSortIndicatorType = "N"
+	NxSortIndicatorTypeAsc  NxSortIndicatorType = "A"
+	NxSortIndicatorTypeDesc NxSortIndicatorType = "D"
+)
+
+var valuesOfNxSortIndicatorType = map[NxSortIndicatorType]int{
+	NxSortIndicatorTypeNone: 0,
+	NxSortIndicatorTypeAsc:  1,
+	NxSortIndicatorTypeDesc: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxSortIndicatorType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxSortIndicatorType constants
+func (e NxSortIndicatorType) IsValid() bool {
+	_, ok := valuesOfNxSortIndicatorType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxSortIndicatorType) Int() (int, bool) {
+	n, ok := valuesOfNxSortIndicatorType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxSortIndicatorType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxSortIndicatorType, "NxSortIndicatorType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type NxStackPage struct {
 	// Array of data.
 	Data []*NxStackedPivotCell `json:"qData,omitempty"`
@@ -4484,6 +9603,26 @@ type NxStackPage struct {
 	Area *Rect `json:"qArea,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxStackPage) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxStackPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Data {
+		if err := item0.validate(indexPath(joinPath(path, "qData"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Area.validate(joinPath(path, "qArea")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxStackedPivotCell struct {
 	// Some text.
 	Text string `json:"qText,omitempty"`
@@ -4522,7 +9661,7 @@ type NxStackedPivotCell struct {
 	// • U or NX_DIM_CELL_NULL
 	//
 	// • G or NX_DIM_CELL_GENERATED
-	Type string `json:"qType,omitempty"`
+	Type NxDimCellType `json:"qType,omitempty"`
 	// Total of the positive values in the current group of cells.
 	MaxPos Float64 `json:"qMaxPos,omitempty"`
 	// Total of the negative values in the current group of cells.
@@ -4543,6 +9682,41 @@ type NxStackedPivotCell struct {
 	AttrDims *NxAttributeDimValues `json:"qAttrDims,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxStackedPivotCell) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxStackedPivotCell) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNo"), v.ElemNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qUp"), v.Up, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qDown"), v.Down, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qRow"), v.Row, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SubNodes {
+		if err := item0.validate(indexPath(joinPath(path, "qSubNodes"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxStateCounts struct {
 	// Number of values in locked state.
 	Locked int `json:"qLocked,omitempty"`
@@ -4562,13 +9736,40 @@ type NxStateCounts struct {
 	LockedExcluded int `json:"qLockedExcluded,omitempty"`
 }
 
-// This struct is deprecated (not recommended to use).
-// Deprecated: This will be removed in a future version
-type NxStreamListEntry struct {
-	// Name of the stream.
-	Name string `json:"qName,omitempty"`
-	// Identifier of the stream.
-	Id string `json:"qId,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxStateCounts) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxStateCounts) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qLocked"), v.Locked, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSelected"), v.Selected, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qOption"), v.Option, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qDeselected"), v.Deselected, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qAlternative"), v.Alternative, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qExcluded"), v.Excluded, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSelectedExcluded"), v.SelectedExcluded, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qLockedExcluded"), v.LockedExcluded, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
 }
 
 type NxTempBookmarkOptions struct {
@@ -4598,6 +9799,29 @@ type NxTreeDataOption struct {
 	TreeLevels *NxPageTreeLevel `json:"qTreeLevels,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTreeDataOption) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTreeDataOption) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qMaxNbrOfNodes"), v.MaxNbrOfNodes, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.TreeNodes {
+		if err := item0.validate(indexPath(joinPath(path, "qTreeNodes"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.TreeLevels.validate(joinPath(path, "qTreeLevels")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Stability: stable
 type NxTreeDimensionDef struct {
 	// Refers to a dimension stored in the library.
@@ -4645,6 +9869,39 @@ type NxTreeDimensionDef struct {
 	AttributeDimensions []*NxAttrDimDef `json:"qAttributeDimensions,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTreeDimensionDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTreeDimensionDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Def.validate(joinPath(path, "qDef")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.ValueExprs {
+		if err := item0.validate(indexPath(joinPath(path, "qValueExprs"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.OtherTotalSpec.validate(joinPath(path, "qOtherTotalSpec")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.AttributeExpressions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeExpressions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttributeDimensions {
+		if err := item0.validate(indexPath(joinPath(path, "qAttributeDimensions"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Stability: stable
 type NxTreeDimensionInfo struct {
 	// Corresponds to the label of the dimension that is selected.
@@ -4668,7 +9925,7 @@ type NxTreeDimensionInfo struct {
 	// • A or NX_SORT_INDICATE_ASC
 	//
 	// • D or NX_SORT_INDICATE_DESC
-	SortIndicator string `json:"qSortIndicator,omitempty"`
+	SortIndicator NxSortIndicatorType `json:"qSortIndicator,omitempty"`
 	// Array of dimension labels.
 	// Contains the labels of all dimensions in a hierarchy group (for example the labels of all dimensions in a drill down group).
 	GroupFallbackTitles []string `json:"qGroupFallbackTitles,omitempty"`
@@ -4692,7 +9949,7 @@ type NxTreeDimensionInfo struct {
 	// • N or NX_DIMENSION_TYPE_NUMERIC
 	//
 	// • T or NX_DIMENSION_TYPE_TIME
-	DimensionType string `json:"qDimensionType,omitempty"`
+	DimensionType NxDimensionType `json:"qDimensionType,omitempty"`
 	// If set to true, it inverts the sort criteria in the field.
 	ReverseSort bool `json:"qReverseSort,omitempty"`
 	// Defines the grouping.
@@ -4704,7 +9961,7 @@ type NxTreeDimensionInfo struct {
 	// • H or GRP_NX_HIEARCHY
 	//
 	// • C or GRP_NX_COLLECTION
-	Grouping string `json:"qGrouping,omitempty"`
+	Grouping NxGrpType `json:"qGrouping,omitempty"`
 	// If set to true, it means that the field is a semantic.
 	IsSemantic bool `json:"qIsSemantic,omitempty"`
 	// Format of the field.
@@ -4745,10 +10002,61 @@ type NxTreeDimensionInfo struct {
 	EffectiveDimensionName string `json:"qEffectiveDimensionName,omitempty"`
 }
 
-// Stability: stable
-type NxTreeMultiRangeSelectInfo struct {
-	// An array of Ranges.
-	Ranges []*NxTreeRangeSelectInfo `json:"qRanges,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTreeDimensionInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTreeDimensionInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qApprMaxGlyphCount"), v.ApprMaxGlyphCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCardinal"), v.Cardinal, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qSortIndicator"), v.SortIndicator); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qGroupPos"), v.GroupPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := v.StateCounts.validate(joinPath(path, "qStateCounts")); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qDimensionType"), v.DimensionType); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qGrouping"), v.Grouping); err != nil {
+		return err
+	}
+	if err := v.NumFormat.validate(joinPath(path, "qNumFormat")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.MeasureInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qMeasureInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttrExprInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrExprInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.AttrDimInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qAttrDimInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Cardinalities.validate(joinPath(path, "qCardinalities")); err != nil {
+		return err
+	}
+	return nil
 }
 
 // Represents a dimension in the tree.
@@ -4791,7 +10099,7 @@ type NxTreeNode struct {
 	// • U or NX_DIM_CELL_NULL
 	//
 	// • G or NX_DIM_CELL_GENERATED
-	Type string `json:"qType,omitempty"`
+	Type NxDimCellType `json:"qType,omitempty"`
 	// The measures for this node.
 	Values []*NxTreeValue `json:"qValues,omitempty"`
 	// The children of this node in the fetched tree structure.
@@ -4832,22 +10140,58 @@ type NxTreeNode struct {
 	// • XL or EXCL_LOCKED
 	//
 	// • NSTATES
-	State string `json:"qState,omitempty"`
+	State StateEnumType `json:"qState,omitempty"`
 	// The GroupPos of all prior nodes connected to this one, one position for each level of the tree.
 	// If this node is attached directly to the root, this array is empty.
 	TreePath []int `json:"qTreePath,omitempty"`
 }
 
-// Stability: stable
-type NxTreeRangeSelectInfo struct {
-	// Range of values.
-	Range *Range `json:"qRange,omitempty"`
-	// Number of the measure to select.
-	// Numbering starts from 0.
-	MeasureIx int `json:"qMeasureIx,omitempty"`
-	// Number of the dimension to select
-	// measure from. Numbering starts from 0.
-	DimensionIx int `json:"qDimensionIx,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTreeNode) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTreeNode) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qElemNo"), v.ElemNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qGroupPos"), v.GroupPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qGroupSize"), v.GroupSize, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qRow"), v.Row, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Values {
+		if err := item0.validate(indexPath(joinPath(path, "qValues"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.Nodes {
+		if err := item0.validate(indexPath(joinPath(path, "qNodes"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qState"), v.State); err != nil {
+		return err
+	}
+	for i0, item0 := range v.TreePath {
+		if err := validateRange(indexPath(joinPath(path, "qTreePath"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Represents a measure.
@@ -4864,6 +10208,21 @@ type NxTreeValue struct {
 	AttrDims *NxAttributeDimValues `json:"qAttrDims,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTreeValue) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTreeValue) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.AttrDims.validate(joinPath(path, "qAttrDims")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Information about the calculated trendline.
 // Stability: experimental
 type NxTrendline struct {
@@ -4886,7 +10245,7 @@ type NxTrendline struct {
 	// • POWER or Power
 	//
 	// • LOG or Logarithmic
-	Type string `json:"qType,omitempty"`
+	Type NxLTrendlineType `json:"qType,omitempty"`
 	// This parameter is optional and is displayed in case of error.
 	Error *NxValidationError `json:"qError,omitempty"`
 	// Coefficent c0..cN depending on the trendline type.
@@ -4899,6 +10258,27 @@ type NxTrendline struct {
 	ElemNo int `json:"qElemNo,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTrendline) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTrendline) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qElemNo"), v.ElemNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Trendline input definition
 // Stability: experimental
 type NxTrendlineDef struct {
@@ -4921,7 +10301,7 @@ type NxTrendlineDef struct {
 	// • POWER or Power
 	//
 	// • LOG or Logarithmic
-	Type string `json:"qType,omitempty"`
+	Type NxLTrendlineType `json:"qType,omitempty"`
 	// The column in the hypercube to be used as x axis. Can point to either a dimension (numeric or text) or a measure
 	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
 	XColIx *int `json:"qXColIx,omitempty"`
@@ -4936,7 +10316,7 @@ type NxTrendlineDef struct {
 	// • Possible or CONTINUOUS_IF_POSSIBLE
 	//
 	// • Time or CONTINUOUS_IF_TIME
-	ContinuousXAxis string `json:"qContinuousXAxis,omitempty"`
+	ContinuousXAxis NxContinuousMode `json:"qContinuousXAxis,omitempty"`
 	// If you have a hypercube with two dimensions and qXColIx refers to a dimension
 	// This determines if you get one trendline of each value in the other dimension or
 	// Or trendline based on the sum of the value in the other dimension
@@ -4947,7 +10327,72 @@ type NxTrendlineDef struct {
 	// • Multi or TRENDLINE_MULTILINE
 	//
 	// • Sum or TRENDLINE_SUM
-	MultiDimMode string `json:"qMultiDimMode,omitempty"`
+	MultiDimMode NxTrendlineMode `json:"qMultiDimMode,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxTrendlineDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxTrendlineDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qType"), v.Type); err != nil {
+		return err
+	}
+	if v.XColIx != nil {
+		if err := validateRange(joinPath(path, "qXColIx"), *v.XColIx, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qContinuousXAxis"), v.ContinuousXAxis); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qMultiDimMode"), v.MultiDimMode); err != nil {
+		return err
+	}
+	return nil
+}
+
+type NxTrendlineMode string
+
+const (
+	NxTrendlineModeMultiline NxTrendlineMode = "Multi"
+	NxTrendlineModeSum       NxTrendlineMode = "Sum"
+)
+
+var valuesOfNxTrendlineMode = map[NxTrendlineMode]int{
+	NxTrendlineModeMultiline: 0,
+	NxTrendlineModeSum:       1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e NxTrendlineMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the NxTrendlineMode constants
+func (e NxTrendlineMode) IsValid() bool {
+	_, ok := valuesOfNxTrendlineMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e NxTrendlineMode) Int() (int, bool) {
+	n, ok := valuesOfNxTrendlineMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *NxTrendlineMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfNxTrendlineMode, "NxTrendlineMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type NxValidationError struct {
@@ -4963,6 +10408,21 @@ type NxValidationError struct {
 	ExtendedMessage string `json:"qExtendedMessage,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxValidationError) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxValidationError) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qErrorCode"), v.ErrorCode, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type NxVariableListItem struct {
 	// Name of the variable.
 	Name string `json:"qName,omitempty"`
@@ -4996,24 +10456,6 @@ type NxVariableListItem struct {
 	IsScriptCreated bool `json:"qIsScriptCreated,omitempty"`
 }
 
-// Deprecated: This will be removed in a future version
-type NxVariableProperties struct {
-	// Name of the variable.
-	Name string `json:"qName,omitempty"`
-	// Defines the format of the value of a variable.
-	NumberPresentation *FieldAttributes `json:"qNumberPresentation,omitempty"`
-	// Set this property to true to update the variable when applying a bookmark.
-	// The value of a variable can affect the state of the selections.
-	// The default value is false.
-	IncludeInBookmark bool `json:"qIncludeInBookmark,omitempty"`
-	// The value of a variable can be an enumeration.
-	// Set this property to true to reflect the predefined values in an enumeration.
-	UsePredefListedValues bool `json:"qUsePredefListedValues,omitempty"`
-	// List of enumerations.
-	// This property is used if qUsePredefListedValues is set to true.
-	PreDefinedList []string `json:"qPreDefinedList,omitempty"`
-}
-
 type NxViewPort struct {
 	// Width of the canvas in pixels.
 	Width int `json:"qWidth,omitempty"`
@@ -5023,6 +10465,27 @@ type NxViewPort struct {
 	ZoomLevel int `json:"qZoomLevel,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *NxViewPort) Validate() error {
+	return v.validate("")
+}
+
+func (v *NxViewPort) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qWidth"), v.Width, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHeight"), v.Height, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qZoomLevel"), v.ZoomLevel, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ObjectInterface struct {
 	// The native type of the object.
 	Type string `json:"qType,omitempty"`
@@ -5034,6 +10497,21 @@ type ObjectInterface struct {
 	GenericId string `json:"qGenericId,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ObjectInterface) Validate() error {
+	return v.validate("")
+}
+
+func (v *ObjectInterface) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qHandle"), v.Handle, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type OdbcDsn struct {
 	// Name of the ODBC connection.
 	Name string `json:"qName,omitempty"`
@@ -5059,6 +10537,137 @@ type OleDbProvider struct {
 	Bit32 bool `json:"qBit32,omitempty"`
 }
 
+type OtherLimitMode string
+
+const (
+	OtherLimitModeGeLimit OtherLimitMode = "OTHER_GE_LIMIT"
+	OtherLimitModeLeLimit OtherLimitMode = "OTHER_LE_LIMIT"
+	OtherLimitModeGtLimit OtherLimitMode = "OTHER_GT_LIMIT"
+	OtherLimitModeLtLimit OtherLimitMode = "OTHER_LT_LIMIT"
+)
+
+var valuesOfOtherLimitMode = map[OtherLimitMode]int{
+	OtherLimitModeGeLimit: 0,
+	OtherLimitModeLeLimit: 1,
+	OtherLimitModeGtLimit: 2,
+	OtherLimitModeLtLimit: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e OtherLimitMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the OtherLimitMode constants
+func (e OtherLimitMode) IsValid() bool {
+	_, ok := valuesOfOtherLimitMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e OtherLimitMode) Int() (int, bool) {
+	n, ok := valuesOfOtherLimitMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *OtherLimitMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfOtherLimitMode, "OtherLimitMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type OtherMode string
+
+const (
+	OtherModeOff          OtherMode = "OTHER_OFF"
+	OtherModeCounted      OtherMode = "OTHER_COUNTED"
+	OtherModeAbsLimited   OtherMode = "OTHER_ABS_LIMITED"
+	OtherModeAbsAccTarget OtherMode = "OTHER_ABS_ACC_TARGET"
+	OtherModeRelLimited   OtherMode = "OTHER_REL_LIMITED"
+	OtherModeRelAccTarget OtherMode = "OTHER_REL_ACC_TARGET"
+)
+
+var valuesOfOtherMode = map[OtherMode]int{
+	OtherModeOff:          0,
+	OtherModeCounted:      1,
+	OtherModeAbsLimited:   2,
+	OtherModeAbsAccTarget: 3,
+	OtherModeRelLimited:   4,
+	OtherModeRelAccTarget: 5,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e OtherMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the OtherMode constants
+func (e OtherMode) IsValid() bool {
+	_, ok := valuesOfOtherMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e OtherMode) Int() (int, bool) {
+	n, ok := valuesOfOtherMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *OtherMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfOtherMode, "OtherMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type OtherSortMode string
+
+const (
+	OtherSortModeDefault    OtherSortMode = "OTHER_SORT_DEFAULT"
+	OtherSortModeDescending OtherSortMode = "OTHER_SORT_DESCENDING"
+	OtherSortModeAscending  OtherSortMode = "OTHER_SORT_ASCENDING"
+)
+
+var valuesOfOtherSortMode = map[OtherSortMode]int{
+	OtherSortModeDefault:    0,
+	OtherSortModeDescending: 1,
+	OtherSortModeAscending:  2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e OtherSortMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the OtherSortMode constants
+func (e OtherSortMode) IsValid() bool {
+	_, ok := valuesOfOtherSortMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e OtherSortMode) Int() (int, bool) {
+	n, ok := valuesOfOtherSortMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *OtherSortMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfOtherSortMode, "OtherSortMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type OtherTotalSpecProp struct {
 	// Determines how many dimension values are displayed.
 	// The default value is OTHEROFF_ .
@@ -5076,7 +10685,7 @@ type OtherTotalSpecProp struct {
 	// • OTHER_REL_LIMITED
 	//
 	// • OTHER_REL_ACC_TARGET
-	OtherMode string `json:"qOtherMode,omitempty"`
+	OtherMode OtherMode `json:"qOtherMode,omitempty"`
 	// Number of values to display. The number of values can be entered as a calculated formula.
 	// This parameter is used when qOtherMode is set to OTHERCOUNTED_ .
 	OtherCounted *ValueExpr `json:"qOtherCounted,omitempty"`
@@ -5109,7 +10718,7 @@ type OtherTotalSpecProp struct {
 	// • OTHER_GT_LIMIT
 	//
 	// • OTHER_LT_LIMIT
-	OtherLimitMode string `json:"qOtherLimitMode,omitempty"`
+	OtherLimitMode OtherLimitMode `json:"qOtherLimitMode,omitempty"`
 	// If set to true, the group Others is not displayed as a dimension value.
 	// The default value is false.
 	SuppressOther bool `json:"qSuppressOther,omitempty"`
@@ -5149,7 +10758,7 @@ type OtherTotalSpecProp struct {
 	// • OTHER_SORT_DESCENDING
 	//
 	// • OTHER_SORT_ASCENDING
-	OtherSortMode string `json:"qOtherSortMode,omitempty"`
+	OtherSortMode OtherSortMode `json:"qOtherSortMode,omitempty"`
 	// If set to TOTALEXPR_ , the total of the dimension values is returned.
 	// The default value is TOTALOFF_ .
 	//
@@ -5158,12 +10767,36 @@ type OtherTotalSpecProp struct {
 	// • TOTAL_OFF
 	//
 	// • TOTAL_EXPR
-	TotalMode string `json:"qTotalMode,omitempty"`
+	TotalMode TotalMode `json:"qTotalMode,omitempty"`
 	// This parameter applies when there are several measures.
 	// Name of the measure to use for the calculation of Others for a specific dimension.
 	ReferencedExpression *StringExpr `json:"qReferencedExpression,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *OtherTotalSpecProp) Validate() error {
+	return v.validate("")
+}
+
+func (v *OtherTotalSpecProp) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qOtherMode"), v.OtherMode); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qOtherLimitMode"), v.OtherLimitMode); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qOtherSortMode"), v.OtherSortMode); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qTotalMode"), v.TotalMode); err != nil {
+		return err
+	}
+	return nil
+}
+
 type Point struct {
 	// x-coordinate in pixels.
 	// The origin is the top left of the screen.
@@ -5173,12 +10806,47 @@ type Point struct {
 	Y int `json:"qy,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Point) Validate() error {
+	return v.validate("")
+}
+
+func (v *Point) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qx"), v.X, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qy"), v.Y, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type PositionMark struct {
 	DimName    string   `json:"qDimName,omitempty"`
 	ElemNo     []int    `json:"qElemNo,omitempty"`
 	ElemValues []string `json:"qElemValues,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *PositionMark) Validate() error {
+	return v.validate("")
+}
+
+func (v *PositionMark) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.ElemNo {
+		if err := validateRange(indexPath(joinPath(path, "qElemNo"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type ProgressData struct {
 	// True if the request is started.
 	Started bool `json:"qStarted,omitempty"`
@@ -5206,6 +10874,37 @@ type ProgressData struct {
 	TransientProgressMessage *ProgressMessage `json:"qTransientProgressMessage,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ProgressData) Validate() error {
+	return v.validate("")
+}
+
+func (v *ProgressData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qKB"), v.KB, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qMillisecs"), v.Millisecs, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.ErrorData {
+		if err := item0.validate(indexPath(joinPath(path, "qErrorData"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.PersistentProgressMessages {
+		if err := item0.validate(indexPath(joinPath(path, "qPersistentProgressMessages"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.TransientProgressMessage.validate(joinPath(path, "qTransientProgressMessage")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ProgressMessage struct {
 	// Code number to the corresponding localized message string.
 	MessageCode int `json:"qMessageCode,omitempty"`
@@ -5213,6 +10912,21 @@ type ProgressMessage struct {
 	MessageParameters []string `json:"qMessageParameters,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ProgressMessage) Validate() error {
+	return v.validate("")
+}
+
+func (v *ProgressMessage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qMessageCode"), v.MessageCode, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type Range struct {
 	// Lowest value in the range
 	Min Float64 `json:"qMin,omitempty"`
@@ -5252,6 +10966,30 @@ type Rect struct {
 	Height int `json:"qHeight,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Rect) Validate() error {
+	return v.validate("")
+}
+
+func (v *Rect) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qLeft"), v.Left, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTop"), v.Top, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qWidth"), v.Width, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qHeight"), v.Height, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ReloadError struct {
 	// Type of error.
 	Error string `json:"qError,omitempty"`
@@ -5930,7 +11668,28 @@ type ReloadError struct {
 	// • LOCERR_WIN_INTERNET_SERVER_UNREACHABLE
 	//
 	// • LOCERR_BM_RESULT_TOO_LARGE
-	Title string `json:"qTitle,omitempty"`
+	Title NxLocalizedErrorCode `json:"qTitle,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ReloadError) Validate() error {
+	return v.validate("")
+}
+
+func (v *ReloadError) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qLineNumber"), v.LineNumber, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCode"), v.Code, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qTitle"), v.Title); err != nil {
+		return err
+	}
+	return nil
 }
 
 type SampleResult struct {
@@ -5955,25 +11714,51 @@ type ScriptSyntaxError struct {
 	SecondaryFailure bool `json:"qSecondaryFailure,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ScriptSyntaxError) Validate() error {
+	return v.validate("")
+}
+
+func (v *ScriptSyntaxError) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qErrLen"), v.ErrLen, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTabIx"), v.TabIx, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qLineInTab"), v.LineInTab, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qColInLine"), v.ColInLine, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTextPos"), v.TextPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type ScrollPosition struct {
 	UsePosition bool   `json:"qUsePosition,omitempty"`
 	Pos         *Point `json:"qPos,omitempty"`
 }
 
-// Deprecated: This will be removed in a future version
-type SearchAssociationResult struct {
-	// List of the fields that contains search associations.
-	FieldNames []string `json:"qFieldNames,omitempty"`
-	// List of the search terms.
-	SearchTerms []string `json:"qSearchTerms,omitempty"`
-	// Information about the fields containing search hits.
-	FieldDictionaries []*SearchFieldDictionary `json:"qFieldDictionaries,omitempty"`
-	// List of search results.
-	// The maximum number of search results in this list is set by qPage/qCount .
-	SearchTermsMatched []SearchMatchCombinations `json:"qSearchTermsMatched,omitempty"`
-	// Total number of search results.
-	// This number is not limited by qPage/qCount .
-	TotalSearchResults int `json:"qTotalSearchResults,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *ScrollPosition) Validate() error {
+	return v.validate("")
+}
+
+func (v *ScrollPosition) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	return nil
 }
 
 type SearchAttribute struct {
@@ -5992,6 +11777,27 @@ type SearchCharRange struct {
 	Term int `json:"qTerm,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchCharRange) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchCharRange) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qCharPos"), v.CharPos, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCharCount"), v.CharCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTerm"), v.Term, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type SearchCombinationOptions struct {
 	// List of the search fields.
 	// If empty, the search is performed in all fields of the app.
@@ -6006,7 +11812,7 @@ type SearchCombinationOptions struct {
 	// • LockedFieldsOnly or CONTEXT_LOCKED_FIELDS_ONLY
 	//
 	// • CurrentSelections or CONTEXT_CURRENT_SELECTIONS
-	Context string `json:"qContext,omitempty"`
+	Context SearchContextType `json:"qContext,omitempty"`
 	// Encoding used to compute qRanges of type SearchCharRange.
 	// Only affects the computation of the ranges. It does not impact the encoding of the text.
 	//
@@ -6015,7 +11821,7 @@ type SearchCombinationOptions struct {
 	// • Utf8 or CHAR_ENCODING_UTF8
 	//
 	// • Utf16 or CHAR_ENCODING_UTF16
-	CharEncoding string `json:"qCharEncoding,omitempty"`
+	CharEncoding CharEncodingType `json:"qCharEncoding,omitempty"`
 	// Optional.
 	//
 	// • For SearchSuggest method, this array is empty.
@@ -6026,44 +11832,145 @@ type SearchCombinationOptions struct {
 	Attributes []string `json:"qAttributes,omitempty"`
 }
 
-// Deprecated: This will be removed in a future version
-type SearchFieldDictionary struct {
-	// Position of the field in the list of fields, starting from 0.
-	// The list of fields is defined in qResults/qFieldNames and contains the search associations.
-	Field int `json:"qField,omitempty"`
-	// List of the matching values.
-	// The maximum number of values in this list is set by qMaxNbrFieldMatches .
-	Result []*SearchTermResult `json:"qResult,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchCombinationOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchCombinationOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qContext"), v.Context); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qCharEncoding"), v.CharEncoding); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Deprecated: This will be removed in a future version
-type SearchFieldMatch struct {
-	// Position of the field in the list of fields, starting from 0.
-	// The list of fields is defined in qResults/qFieldNames and contains the search associations.
-	Field int `json:"qField,omitempty"`
-	// Positions of the matching values in the search results.
-	// The maximum number of values in this list is defined by qMaxNbrFieldMatches .
-	Values []int `json:"qValues,omitempty"`
-	// Positions of the search terms, starting from 0.
-	Terms []int `json:"qTerms,omitempty"`
-	// Number of search hits in the field.
-	// The number of values in qValues and the value of qNoOfMatches are equal if qMaxNbrFieldMatches is -1.
-	NoOfMatches int `json:"qNoOfMatches,omitempty"`
+type SearchContextType string
+
+const (
+	SearchContextTypeCleared           SearchContextType = "Cleared"
+	SearchContextTypeLockedFieldsOnly  SearchContextType = "LockedFieldsOnly"
+	SearchContextTypeCurrentSelections SearchContextType = "CurrentSelections"
+)
+
+var valuesOfSearchContextType = map[SearchContextType]int{
+	SearchContextTypeCleared:           0,
+	SearchContextTypeLockedFieldsOnly:  1,
+	SearchContextTypeCurrentSelections: 2,
 }
 
-// Stability: experimental
-type SearchFieldMatchesItem struct {
-	Text               string `json:"qText,omitempty"`
-	ElemNo             int    `json:"qElemNo,omitempty"`
-	SearchTermsMatched []int  `json:"qSearchTermsMatched,omitempty"`
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e SearchContextType) String() string {
+	return string(e)
 }
 
-// Stability: experimental
-type SearchFieldValueItem struct {
-	// Field name of matches.
-	FieldName string `json:"qFieldName,omitempty"`
-	// List of search matches.
-	Values []*SearchFieldMatchesItem `json:"qValues,omitempty"`
+// IsValid tells if the value is one of the SearchContextType constants
+func (e SearchContextType) IsValid() bool {
+	_, ok := valuesOfSearchContextType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e SearchContextType) Int() (int, bool) {
+	n, ok := valuesOfSearchContextType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *SearchContextType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfSearchContextType, "SearchContextType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type SearchFieldMatchType string
+
+const (
+	SearchFieldMatchTypeNone      SearchFieldMatchType = "FieldMatchNone"
+	SearchFieldMatchTypeSubstring SearchFieldMatchType = "FieldMatchSubString"
+	SearchFieldMatchTypeWord      SearchFieldMatchType = "FieldMatchWord"
+	SearchFieldMatchTypeExact     SearchFieldMatchType = "FieldMatchExact"
+	SearchFieldMatchTypeLast      SearchFieldMatchType = "FieldMatchLast"
+)
+
+var valuesOfSearchFieldMatchType = map[SearchFieldMatchType]int{
+	SearchFieldMatchTypeNone:      0,
+	SearchFieldMatchTypeSubstring: 1,
+	SearchFieldMatchTypeWord:      2,
+	SearchFieldMatchTypeExact:     3,
+	SearchFieldMatchTypeLast:      4,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e SearchFieldMatchType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the SearchFieldMatchType constants
+func (e SearchFieldMatchType) IsValid() bool {
+	_, ok := valuesOfSearchFieldMatchType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e SearchFieldMatchType) Int() (int, bool) {
+	n, ok := valuesOfSearchFieldMatchType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *SearchFieldMatchType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfSearchFieldMatchType, "SearchFieldMatchType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
+type SearchFieldSelectionMode string
+
+const (
+	SearchFieldSelectionModeOneAndOnlyOne SearchFieldSelectionMode = "OneAndOnlyOne"
+)
+
+var valuesOfSearchFieldSelectionMode = map[SearchFieldSelectionMode]int{
+	SearchFieldSelectionModeOneAndOnlyOne: 0,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e SearchFieldSelectionMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the SearchFieldSelectionMode constants
+func (e SearchFieldSelectionMode) IsValid() bool {
+	_, ok := valuesOfSearchFieldSelectionMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e SearchFieldSelectionMode) Int() (int, bool) {
+	n, ok := valuesOfSearchFieldSelectionMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *SearchFieldSelectionMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfSearchFieldSelectionMode, "SearchFieldSelectionMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
 }
 
 type SearchGroup struct {
@@ -6076,7 +11983,7 @@ type SearchGroup struct {
 	// • DatasetType or DATASET_GROUP
 	//
 	// • GenericObjectsType or GENERIC_OBJECTS_GROUP
-	GroupType string `json:"qGroupType,omitempty"`
+	GroupType SearchGroupType `json:"qGroupType,omitempty"`
 	// Indexes of the search terms that are included in the group. These search terms are related to the list of terms defined in SearchResult.qSearchTerms .
 	SearchTermsMatched []int `json:"qSearchTermsMatched,omitempty"`
 	// Total number of distinct items in the search group.
@@ -6086,6 +11993,37 @@ type SearchGroup struct {
 	Items []*SearchGroupItem `json:"qItems,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchGroup) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchGroup) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qId"), v.Id, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateEnum(joinPath(path, "qGroupType"), v.GroupType); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SearchTermsMatched {
+		if err := validateRange(indexPath(joinPath(path, "qSearchTermsMatched"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateRange(joinPath(path, "qTotalNumberOfItems"), v.TotalNumberOfItems, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Items {
+		if err := item0.validate(indexPath(joinPath(path, "qItems"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type SearchGroupItem struct {
 	// Type of the group item.
 	//
@@ -6094,7 +12032,7 @@ type SearchGroupItem struct {
 	// • Field or FIELD
 	//
 	// • GenericObject or GENERIC_OBJECT
-	ItemType string `json:"qItemType,omitempty"`
+	ItemType SearchGroupItemType `json:"qItemType,omitempty"`
 	// Total number of distinct matches in the search group item.
 	TotalNumberOfMatches int `json:"qTotalNumberOfMatches,omitempty"`
 	// Identifier of the item.
@@ -6122,7 +12060,38 @@ type SearchGroupItem struct {
 	// • FieldMatchExact or FM_EXACT
 	//
 	// • FieldMatchLast or FM_LAST
-	MatchType string `json:"qMatchType,omitempty"`
+	MatchType SearchFieldMatchType `json:"qMatchType,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchGroupItem) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchGroupItem) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qItemType"), v.ItemType); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qTotalNumberOfMatches"), v.TotalNumberOfMatches, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.ItemMatches {
+		if err := item0.validate(indexPath(joinPath(path, "qItemMatches"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.SearchTermsMatched {
+		if err := validateRange(indexPath(joinPath(path, "qSearchTermsMatched"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := validateEnum(joinPath(path, "qMatchType"), v.MatchType); err != nil {
+		return err
+	}
+	return nil
 }
 
 type SearchGroupItemMatch struct {
@@ -6133,7 +12102,7 @@ type SearchGroupItemMatch struct {
 	Text string `json:"qText,omitempty"`
 	// Selection mode of a field.
 	// Suppressed by default. One and always one field value is selected when set to OneAndOnlyOne.
-	FieldSelectionMode string `json:"qFieldSelectionMode,omitempty"`
+	FieldSelectionMode SearchFieldSelectionMode `json:"qFieldSelectionMode,omitempty"`
 	// List of ranges.
 	// For example, if the search terms are Price and Make, and the search group item value is Make by Price vs Mileage, then there are two ranges: one for Price and one for Make.
 	Ranges []*SearchCharRange `json:"qRanges,omitempty"`
@@ -6145,6 +12114,26 @@ type SearchGroupItemMatch struct {
 	Attributes []*SearchAttribute `json:"qAttributes,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchGroupItemMatch) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchGroupItemMatch) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qFieldSelectionMode"), v.FieldSelectionMode); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Ranges {
+		if err := item0.validate(indexPath(joinPath(path, "qRanges"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type SearchGroupItemOptions struct {
 	// Type of the group item. Can be:
 	//
@@ -6157,7 +12146,7 @@ type SearchGroupItemOptions struct {
 	// • Field or FIELD
 	//
 	// • GenericObject or GENERIC_OBJECT
-	GroupItemType string `json:"qGroupItemType,omitempty"`
+	GroupItemType SearchGroupItemType `json:"qGroupItemType,omitempty"`
 	// Position starting from 0.
 	// The default value is 0.
 	Offset int `json:"qOffset,omitempty"`
@@ -6167,6 +12156,68 @@ type SearchGroupItemOptions struct {
 	Count *int `json:"qCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchGroupItemOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchGroupItemOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGroupItemType"), v.GroupItemType); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qOffset"), v.Offset, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.Count != nil {
+		if err := validateRange(joinPath(path, "qCount"), *v.Count, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SearchGroupItemType string
+
+const (
+	SearchGroupItemTypeField         SearchGroupItemType = "Field"
+	SearchGroupItemTypeGenericObject SearchGroupItemType = "GenericObject"
+)
+
+var valuesOfSearchGroupItemType = map[SearchGroupItemType]int{
+	SearchGroupItemTypeField:         1,
+	SearchGroupItemTypeGenericObject: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e SearchGroupItemType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the SearchGroupItemType constants
+func (e SearchGroupItemType) IsValid() bool {
+	_, ok := valuesOfSearchGroupItemType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e SearchGroupItemType) Int() (int, bool) {
+	n, ok := valuesOfSearchGroupItemType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *SearchGroupItemType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfSearchGroupItemType, "SearchGroupItemType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type SearchGroupOptions struct {
 	// Type of the group. Can be:
 	//
@@ -6179,7 +12230,7 @@ type SearchGroupOptions struct {
 	// • DatasetType or DATASET_GROUP
 	//
 	// • GenericObjectsType or GENERIC_OBJECTS_GROUP
-	GroupType string `json:"qGroupType,omitempty"`
+	GroupType SearchGroupType `json:"qGroupType,omitempty"`
 	// Position starting from 0.
 	// The default value is 0.
 	Offset int `json:"qOffset,omitempty"`
@@ -6189,16 +12240,67 @@ type SearchGroupOptions struct {
 	Count *int `json:"qCount,omitempty"`
 }
 
-// Deprecated: This will be removed in a future version
-type SearchMatchCombination struct {
-	// Index of the search result, starting from 0.
-	Id int `json:"qId,omitempty"`
-	// Information about the search matches.
-	FieldMatches []*SearchFieldMatch `json:"qFieldMatches,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchGroupOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchGroupOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qGroupType"), v.GroupType); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qOffset"), v.Offset, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.Count != nil {
+		if err := validateRange(joinPath(path, "qCount"), *v.Count, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SearchGroupType string
+
+const (
+	SearchGroupTypeDatasetGroup        SearchGroupType = "DatasetType"
+	SearchGroupTypeGenericObjectsGroup SearchGroupType = "GenericObjectsType"
+)
+
+var valuesOfSearchGroupType = map[SearchGroupType]int{
+	SearchGroupTypeDatasetGroup:        1,
+	SearchGroupTypeGenericObjectsGroup: 2,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e SearchGroupType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the SearchGroupType constants
+func (e SearchGroupType) IsValid() bool {
+	_, ok := valuesOfSearchGroupType[e]
+	return ok
 }
 
-// Deprecated: This will be removed in a future version
-type SearchMatchCombinations []*SearchMatchCombination
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e SearchGroupType) Int() (int, bool) {
+	n, ok := valuesOfSearchGroupType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *SearchGroupType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfSearchGroupType, "SearchGroupType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
 
 type SearchObjectOptions struct {
 	// This array is either empty or contains qProperty .
@@ -6211,7 +12313,22 @@ type SearchObjectOptions struct {
 	// • Utf8 or CHAR_ENCODING_UTF8
 	//
 	// • Utf16 or CHAR_ENCODING_UTF16
-	CharEncoding string `json:"qCharEncoding,omitempty"`
+	CharEncoding CharEncodingType `json:"qCharEncoding,omitempty"`
+}
+
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchObjectOptions) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchObjectOptions) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateEnum(joinPath(path, "qCharEncoding"), v.CharEncoding); err != nil {
+		return err
+	}
+	return nil
 }
 
 type SearchPage struct {
@@ -6235,16 +12352,69 @@ type SearchPage struct {
 	GroupItemOptions []*SearchGroupItemOptions `json:"qGroupItemOptions,omitempty"`
 }
 
-type SearchResult struct {
-	// List of the search terms.
-	SearchTerms []string `json:"qSearchTerms,omitempty"`
-	// Total number of groups.
-	TotalNumberOfGroups int `json:"qTotalNumberOfGroups,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchPage) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchPage) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qOffset"), v.Offset, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qCount"), v.Count, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if v.MaxNbrFieldMatches != nil {
+		if err := validateRange(joinPath(path, "qMaxNbrFieldMatches"), *v.MaxNbrFieldMatches, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.GroupOptions {
+		if err := item0.validate(indexPath(joinPath(path, "qGroupOptions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.GroupItemOptions {
+		if err := item0.validate(indexPath(joinPath(path, "qGroupItemOptions"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SearchResult struct {
+	// List of the search terms.
+	SearchTerms []string `json:"qSearchTerms,omitempty"`
+	// Total number of groups.
+	TotalNumberOfGroups int `json:"qTotalNumberOfGroups,omitempty"`
 	// List of search groups.
 	// The groups are numbered from the value of SearchPage.qOffset to the value of SearchPage.qOffset + SearchPage.qCount .
 	SearchGroupArray []*SearchGroup `json:"qSearchGroupArray,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchResult) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchResult) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qTotalNumberOfGroups"), v.TotalNumberOfGroups, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.SearchGroupArray {
+		if err := item0.validate(indexPath(joinPath(path, "qSearchGroupArray"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type SearchSuggestItem struct {
 	// Value of the suggestion.
 	Value string `json:"qValue,omitempty"`
@@ -6253,6 +12423,21 @@ type SearchSuggestItem struct {
 	Term int `json:"qTerm,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchSuggestItem) Validate() error {
+	return v.validate("")
+}
+
+func (v *SearchSuggestItem) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qTerm"), v.Term, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type SearchSuggestionResult struct {
 	// List of suggestions.
 	Suggestions []*SearchSuggestItem `json:"qSuggestions,omitempty"`
@@ -6260,43 +12445,21 @@ type SearchSuggestionResult struct {
 	FieldNames []string `json:"qFieldNames,omitempty"`
 }
 
-// Deprecated: This will be removed in a future version
-type SearchTermResult struct {
-	// Text of the associated value.
-	Text string `json:"qText,omitempty"`
-	// Element number of the associated value.
-	ElemNumber int `json:"qElemNumber,omitempty"`
-	// List of ranges.
-	// For example, if the user searches the term read and the associative value is Reading , then the corresponding range would be Read in Reading .
-	Ranges []*SearchCharRange `json:"qRanges,omitempty"`
-}
-
-// Stability: experimental
-type SearchValueOptions struct {
-	// List of the search fields.
-	// If empty, the search is performed in all fields of the app.
-	SearchFields []string `json:"qSearchFields,omitempty"`
-}
-
-// Stability: experimental
-type SearchValuePage struct {
-	// Position from the top, starting from 0.
-	// If the offset is set to 0, the first search result to be returned is at position 0.
-	Offset int `json:"qOffset,omitempty"`
-	// Number of search fields to return
-	Count int `json:"qCount,omitempty"`
-	// Maximum number of matching values to return per search result.
-	// When set to nil the default value is used, when set to point at a value that value is used (including golang zero values)
-	MaxNbrFieldMatches *int `json:"qMaxNbrFieldMatches,omitempty"`
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SearchSuggestionResult) Validate() error {
+	return v.validate("")
 }
 
-// Stability: experimental
-type SearchValueResult struct {
-	// List of the search terms.
-	SearchTerms []string `json:"qSearchTerms,omitempty"`
-	// List of search groups.
-	// The groups are numbered from the value of SearchPage.qOffset to the value of SearchPage.qOffset + SearchPage.qCount .
-	FieldMatches []*SearchFieldValueItem `json:"qFieldMatches,omitempty"`
+func (v *SearchSuggestionResult) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Suggestions {
+		if err := item0.validate(indexPath(joinPath(path, "qSuggestions"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type SelectInfo struct {
@@ -6329,6 +12492,21 @@ type SelectInfo struct {
 	SelectFieldSearch bool `json:"qSelectFieldSearch,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SelectInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *SelectInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.NumberFormat.validate(joinPath(path, "qNumberFormat")); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Indicates which selections are currently applied. It gives the current selections. Is the layout for SelectionObjectDef.
 type SelectionObject struct {
 	// Number of steps back.
@@ -6342,6 +12520,29 @@ type SelectionObject struct {
 	StateName string `json:"qStateName,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SelectionObject) Validate() error {
+	return v.validate("")
+}
+
+func (v *SelectionObject) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qBackCount"), v.BackCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qForwardCount"), v.ForwardCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	for i0, item0 := range v.Selections {
+		if err := item0.validate(indexPath(joinPath(path, "qSelections"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // To display the current selections.
 // Can be added to any generic object but is particularly meaningful when using session objects to monitor an app.
 //
@@ -6361,6 +12562,24 @@ type Size struct {
 	Cy int `json:"qcy,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *Size) Validate() error {
+	return v.validate("")
+}
+
+func (v *Size) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qcx"), v.Cx, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qcy"), v.Cy, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type SortCriteria struct {
 	// Sorts the field values according to their logical state (selected, optional, alternative or excluded).
 	SortByState int `json:"qSortByState,omitempty"`
@@ -6379,6 +12598,39 @@ type SortCriteria struct {
 	SortByGreyness int        `json:"qSortByGreyness,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *SortCriteria) Validate() error {
+	return v.validate("")
+}
+
+func (v *SortCriteria) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qSortByState"), v.SortByState, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByFrequency"), v.SortByFrequency, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByNumeric"), v.SortByNumeric, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByAscii"), v.SortByAscii, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByLoadOrder"), v.SortByLoadOrder, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByExpression"), v.SortByExpression, -128, 127); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSortByGreyness"), v.SortByGreyness, -128, 127); err != nil {
+		return err
+	}
+	return nil
+}
+
 type SourceKeyRecord struct {
 	// Name of the key field.
 	KeyFields []string `json:"qKeyFields,omitempty"`
@@ -6386,6 +12638,59 @@ type SourceKeyRecord struct {
 	Tables []string `json:"qTables,omitempty"`
 }
 
+type StateEnumType string
+
+const (
+	StateEnumTypeLocked       StateEnumType = "L"
+	StateEnumTypeSelected     StateEnumType = "S"
+	StateEnumTypeOption       StateEnumType = "O"
+	StateEnumTypeDeselected   StateEnumType = "D"
+	StateEnumTypeAlternative  StateEnumType = "A"
+	StateEnumTypeExcluded     StateEnumType = "X"
+	StateEnumTypeExclSelected StateEnumType = "XS"
+	StateEnumTypeExclLocked   StateEnumType = "XL"
+	StateEnumTypeNstates      StateEnumType = "NSTATES"
+)
+
+var valuesOfStateEnumType = map[StateEnumType]int{
+	StateEnumTypeLocked:       0,
+	StateEnumTypeSelected:     1,
+	StateEnumTypeOption:       2,
+	StateEnumTypeDeselected:   3,
+	StateEnumTypeAlternative:  4,
+	StateEnumTypeExcluded:     5,
+	StateEnumTypeExclSelected: 6,
+	StateEnumTypeExclLocked:   7,
+	StateEnumTypeNstates:      8,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e StateEnumType) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the StateEnumType constants
+func (e StateEnumType) IsValid() bool {
+	_, ok := valuesOfStateEnumType[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e StateEnumType) Int() (int, bool) {
+	n, ok := valuesOfStateEnumType[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *StateEnumType) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfStateEnumType, "StateEnumType")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type StateFieldValues struct {
 	// Name of the state.
 	StateName string `json:"qStateName,omitempty"`
@@ -6484,6 +12789,23 @@ type TableProfilingData struct {
 	FieldProfiling []*FieldInTableProfilingData `json:"qFieldProfiling,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableProfilingData) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableProfilingData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.FieldProfiling {
+		if err := item0.validate(indexPath(joinPath(path, "qFieldProfiling"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type TableRecord struct {
 	// Name of the table.
 	Name string `json:"qName,omitempty"`
@@ -6508,6 +12830,29 @@ type TableRecord struct {
 	ProfilingData *TableProfilingData `json:"qProfilingData,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableRecord) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableRecord) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Fields {
+		if err := item0.validate(indexPath(joinPath(path, "qFields"), i0)); err != nil {
+			return err
+		}
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	if err := v.ProfilingData.validate(joinPath(path, "qProfilingData")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TableRow struct {
 	// Array of field values.
 	Value []*FieldValue `json:"qValue,omitempty"`
@@ -6522,6 +12867,21 @@ type TableViewBroomPointSaveInfo struct {
 	Fields []string `json:"qFields,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewBroomPointSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewBroomPointSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TableViewConnectionPointSaveInfo struct {
 	// Information about the position of the connection point.
 	Pos *Point `json:"qPos,omitempty"`
@@ -6529,6 +12889,21 @@ type TableViewConnectionPointSaveInfo struct {
 	Fields []string `json:"qFields,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewConnectionPointSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewConnectionPointSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TableViewCtlSaveInfo struct {
 	// Internal view mode.
 	InternalView *TableViewSaveInfo `json:"qInternalView,omitempty"`
@@ -6536,6 +12911,24 @@ type TableViewCtlSaveInfo struct {
 	SourceView *TableViewSaveInfo `json:"qSourceView,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewCtlSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewCtlSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.InternalView.validate(joinPath(path, "qInternalView")); err != nil {
+		return err
+	}
+	if err := v.SourceView.validate(joinPath(path, "qSourceView")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TableViewDlgSaveInfo struct {
 	// Information about the position of the dialog window.
 	// Not used in Qlik Sense.
@@ -6551,6 +12944,27 @@ type TableViewDlgSaveInfo struct {
 	Mode int `json:"qMode,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewDlgSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewDlgSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	if err := v.CtlInfo.validate(joinPath(path, "qCtlInfo")); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qMode"), v.Mode, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TableViewSaveInfo struct {
 	// List of the tables in the database model viewer.
 	Tables []*TableViewTableWinSaveInfo `json:"qTables,omitempty"`
@@ -6566,6 +12980,33 @@ type TableViewSaveInfo struct {
 	ZoomFactor *Float64 `json:"qZoomFactor,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Tables {
+		if err := item0.validate(indexPath(joinPath(path, "qTables"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.BroomPoints {
+		if err := item0.validate(indexPath(joinPath(path, "qBroomPoints"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ConnectionPoints {
+		if err := item0.validate(indexPath(joinPath(path, "qConnectionPoints"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type TableViewTableWinSaveInfo struct {
 	// Information about the position of the table.
 	Pos *Rect `json:"qPos,omitempty"`
@@ -6573,6 +13014,21 @@ type TableViewTableWinSaveInfo struct {
 	Caption string `json:"qCaption,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TableViewTableWinSaveInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *TableViewTableWinSaveInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := v.Pos.validate(joinPath(path, "qPos")); err != nil {
+		return err
+	}
+	return nil
+}
+
 type TextMacro struct {
 	// Name of the variable.
 	Tag string `json:"qTag,omitempty"`
@@ -6590,6 +13046,63 @@ type TextMacro struct {
 	IsReserved bool `json:"qIsReserved,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TextMacro) Validate() error {
+	return v.validate("")
+}
+
+func (v *TextMacro) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qRefSeqNo"), v.RefSeqNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qSetSeqNo"), v.SetSeqNo, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
+type TotalMode string
+
+const (
+	TotalModeOff  TotalMode = "TOTAL_OFF"
+	TotalModeExpr TotalMode = "TOTAL_EXPR"
+)
+
+var valuesOfTotalMode = map[TotalMode]int{
+	TotalModeOff:  0,
+	TotalModeExpr: 1,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e TotalMode) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the TotalMode constants
+func (e TotalMode) IsValid() bool {
+	_, ok := valuesOfTotalMode[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e TotalMode) Int() (int, bool) {
+	n, ok := valuesOfTotalMode[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *TotalMode) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfTotalMode, "TotalMode")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type TransformAppParameters struct {
 	// The name (title) of the application
 	Name string `json:"qName,omitempty"`
@@ -6638,6 +13151,49 @@ type TreeData struct {
 	MeasureInfo []*NxMeasureInfo `json:"qMeasureInfo,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TreeData) Validate() error {
+	return v.validate("")
+}
+
+func (v *TreeData) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.NodesOnDim {
+		if err := validateRange(indexPath(joinPath(path, "qNodesOnDim"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := v.Error.validate(joinPath(path, "qError")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.DimensionInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qDimensionInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.EffectiveInterColumnSortOrder {
+		if err := validateRange(indexPath(joinPath(path, "qEffectiveInterColumnSortOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	if err := v.LastExpandedPos.validate(joinPath(path, "qLastExpandedPos")); err != nil {
+		return err
+	}
+	for i0, item0 := range v.TreeDataPages {
+		if err := item0.validate(indexPath(joinPath(path, "qTreeDataPages"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.MeasureInfo {
+		if err := item0.validate(indexPath(joinPath(path, "qMeasureInfo"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Defines the properties of a TreeData object.
 // For more information about the definition of a TreeData object, see Generic object.
 // Stability: stable
@@ -6680,6 +13236,43 @@ type TreeDataDef struct {
 	ContextSetExpression string `json:"qContextSetExpression,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *TreeDataDef) Validate() error {
+	return v.validate("")
+}
+
+func (v *TreeDataDef) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	for i0, item0 := range v.Dimensions {
+		if err := item0.validate(indexPath(joinPath(path, "qDimensions"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.InterColumnSortOrder {
+		if err := validateRange(indexPath(joinPath(path, "qInterColumnSortOrder"), i0), item0, -2147483648, 2147483647); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.InitialDataFetch {
+		if err := item0.validate(indexPath(joinPath(path, "qInitialDataFetch"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ExpansionState {
+		if err := item0.validate(indexPath(joinPath(path, "qExpansionState"), i0)); err != nil {
+			return err
+		}
+	}
+	for i0, item0 := range v.ValueExprs {
+		if err := item0.validate(indexPath(joinPath(path, "qValueExprs"), i0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Displays information about the number of possible undos and redos. Is the layout for UndoInfoDef.
 type UndoInfo struct {
 	// Number of possible undos.
@@ -6688,6 +13281,24 @@ type UndoInfo struct {
 	RedoCount int `json:"qRedoCount,omitempty"`
 }
 
+// Validate checks the values against the enums and integer formats of the schema, the error is a *ValidationError
+func (v *UndoInfo) Validate() error {
+	return v.validate("")
+}
+
+func (v *UndoInfo) validate(path string) error {
+	if v == nil {
+		return nil
+	}
+	if err := validateRange(joinPath(path, "qUndoCount"), v.UndoCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	if err := validateRange(joinPath(path, "qRedoCount"), v.RedoCount, -2147483648, 2147483647); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Defines if an object should contain information on the number of possible undo and redo.
 //
 // Properties:
@@ -6697,6 +13308,49 @@ type UndoInfo struct {
 type UndoInfoDef struct {
 }
 
+type UsageEnum string
+
+const (
+	UsageEnumAnalytics       UsageEnum = "ANALYTICS"
+	UsageEnumDataPreparation UsageEnum = "DATA_PREPARATION"
+	UsageEnumDataflowPrep    UsageEnum = "DATAFLOW_PREP"
+	UsageEnumSingleTablePrep UsageEnum = "SINGLE_TABLE_PREP"
+)
+
+var valuesOfUsageEnum = map[UsageEnum]int{
+	UsageEnumAnalytics:       0,
+	UsageEnumDataPreparation: 1,
+	UsageEnumDataflowPrep:    2,
+	UsageEnumSingleTablePrep: 3,
+}
+
+// String returns the name of the value as sent to Qlik Associative Engine
+func (e UsageEnum) String() string {
+	return string(e)
+}
+
+// IsValid tells if the value is one of the UsageEnum constants
+func (e UsageEnum) IsValid() bool {
+	_, ok := valuesOfUsageEnum[e]
+	return ok
+}
+
+// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid
+func (e UsageEnum) Int() (int, bool) {
+	n, ok := valuesOfUsageEnum[e]
+	return n, ok
+}
+
+// UnmarshalJSON accepts both the name and the numeric value
+func (e *UsageEnum) UnmarshalJSON(data []byte) error {
+	value, err := unmarshalEnum(data, valuesOfUsageEnum, "UsageEnum")
+	if err != nil {
+		return err
+	}
+	*e = value
+	return nil
+}
+
 type ValueExpr struct {
 	// Expression evaluated to dual.
 	V string `json:"qv,omitempty"`
@@ -6739,9377 +13393,655 @@ type VariableListDef struct {
 	ShowSession bool `json:"qShowSession,omitempty"`
 }
 
-type Doc struct {
-	*RemoteObject
-}
-
-// Aborts any selection mode in an app. For more information about selection mode, see BeginSelections method.
-//
-// Parameters:
-//
-// ◾ accept   -   Set this parameter to true to accept the selections before exiting the selection mode.
-//
-// Stability: locked
-func (obj *Doc) AbortModal(ctx context.Context, accept bool) error {
-	err := obj.RPC(ctx, "AbortModal", nil, accept)
-	return err
-}
-
-// Adds an alternate state in the app.
-// You can create multiple states within a Qlik Sense app and apply these states to specific objects within the app. Objects in a given state are not affected by user selections in the other states.
-//
-// Parameters:
-//
-// ◾ stateName   -   Name of the alternate state.
-//
-// Stability: locked
-func (obj *Doc) AddAlternateState(ctx context.Context, stateName string) error {
-	err := obj.RPC(ctx, "AddAlternateState", nil, stateName)
-	return err
-}
-
-// Adds a field on the fly.
-// The expression of a field on the fly is persisted but not its values.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the field.
-//
-// ◾ expr   -   Expression value.
-// It is not possible to use all aggregation functions. For example, you cannot add a field on the fly with an expression that uses the Sum or Count aggregation functions.
-//
-// Stability: locked
-func (obj *Doc) AddFieldFromExpression(ctx context.Context, name string, expr string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "AddFieldFromExpression", result, name, expr)
-	return result.Success, err
-}
-
-// Adds an session alternate state in the app.
-// You can create multiple states within a Qlik Sense app and apply these states to specific objects within the app. Objects in a given state are not affected by user selections in the other states.
-// A session alternate state is not persisted and is not included in the StateNames array in the AppLayout.
-// You can use the optional second parameter to choose any other state to get the initial selection on the new state from
-//
-// Parameters:
-//
-// ◾ stateName         -   Name of the alternate state.
-//
-// ◾ sourceStateName   -   Name of existing state to copy the initial selections from
-//
-// Stability: locked
-func (obj *Doc) AddSessionAlternateState(ctx context.Context, stateName string, sourceStateName string) error {
-	err := obj.RPC(ctx, "AddSessionAlternateState", nil, stateName, sourceStateName)
-	return err
-}
-
-// Applies a bookmark and verifies result dataset against originally selected values.
-// The operation is successful if qApplySuccess is set to true. qWarnings lists state and field with unmatching values
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: experimental
-func (obj *Doc) ApplyAndVerifyBookmark(ctx context.Context, id string) (*BookmarkApplyAndVerifyResult, error) {
-	result := &struct {
-		Result *BookmarkApplyAndVerifyResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyAndVerifyBookmark", result, id)
-	return result.Result, err
-}
-
-// Applies a bookmark and verifies result dataset against originally selected values.
-// The operation is successful if qApplySuccess is set to true. qWarnings lists state and field with unmatching values
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: experimental
-func (obj *Doc) ApplyAndVerifyBookmarkRaw(ctx context.Context, id string) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyAndVerifyBookmark", result, id)
-	return result.Result, err
-}
-
-// Applies a bookmark.
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: locked
-func (obj *Doc) ApplyBookmark(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "ApplyBookmark", result, id)
-	return result.Success, err
-}
-
-// Stability: experimental
-func (obj *Doc) ApplyGroupStates(ctx context.Context, groupStates []*GroupState) (*ApplyGroupStatesResult, error) {
-	result := &struct {
-		Result *ApplyGroupStatesResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyGroupStates", result, groupStates)
-	return result.Result, err
-}
-
-// Stability: experimental
-func (obj *Doc) ApplyGroupStatesRaw(ctx context.Context, groupStates any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyGroupStates", result, groupStates)
-	return result.Result, err
-}
-
-// Apply temporary bookmark identified by Id.
-// ApplyTemporaryBookmark method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the temporary selection state
-//
-// Stability: locked
-func (obj *Doc) ApplyTemporaryBookmark(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ApplyTemporaryBookmark", result, id)
-	return result.Return, err
-}
-
-// Loads the last logical operation (if any).
-// Stability: locked
-func (obj *Doc) Back(ctx context.Context) error {
-	err := obj.RPC(ctx, "Back", nil)
-	return err
-}
-
-// Returns the number of entries on the back stack.
-// Stability: locked
-func (obj *Doc) BackCount(ctx context.Context) (int, error) {
-	result := &struct {
-		Return int `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "BackCount", result)
-	return result.Return, err
-}
-
-// Change the owner of a session app.
-// Can be used by a privileged user when creating a session app to be consumed by another user.
-// Only useful in environments where it is possible to reconnect to a session app, currently only in cloud deployments.
-//
-// Parameters:
-//
-// ◾ newOwnerId   -   Identifier of the new app owner.
-//
-// Stability: experimental
-func (obj *Doc) ChangeSessionAppOwner(ctx context.Context, newOwnerId string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "ChangeSessionAppOwner", result, newOwnerId)
-	return result.Success, err
-}
-
-// Add a session app to a space.
-// Can be used by a privileged user when creating a session app to be consumed by other users.
-// Only useful in environments where it is possible to reconnect to a session app, currently only in cloud deployments.
-//
-// Parameters:
-//
-// ◾ spaceId   -   Identifier of the new space.
-//
-// Stability: experimental
-func (obj *Doc) ChangeSessionAppSpace(ctx context.Context, spaceId string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "ChangeSessionAppSpace", result, spaceId)
-	return result.Success, err
-}
-
-// Checks if a given expression is valid.
-// The expression is correct if the parameters qErrorMsg , qBadFieldNames and qDangerousFieldNames are empty.
-//
-// Parameters:
-//
-// ◾ expr     -   Expression to check.
-//
-// ◾ labels   -   List of labels.
-//
-// Stability: locked
-func (obj *Doc) CheckExpression(ctx context.Context, expr string, labels []string) (string, []*NxRange, []*NxRange, error) {
-	result := &struct {
-		ErrorMsg            string     `json:"qErrorMsg"`
-		BadFieldNames       []*NxRange `json:"qBadFieldNames"`
-		DangerousFieldNames []*NxRange `json:"qDangerousFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "CheckExpression", result, expr, labels)
-	return result.ErrorMsg, result.BadFieldNames, result.DangerousFieldNames, err
-}
-
-// Checks if a given expression is valid.
-// The expression is correct if the parameters qErrorMsg , qBadFieldNames and qDangerousFieldNames are empty.
-//
-// Parameters:
-//
-// ◾ expr     -   Expression to check.
-//
-// ◾ labels   -   List of labels.
-//
-// Stability: locked
-func (obj *Doc) CheckExpressionRaw(ctx context.Context, expr string, labels []string) (string, json.RawMessage, json.RawMessage, error) {
-	result := &struct {
-		ErrorMsg            string          `json:"qErrorMsg"`
-		BadFieldNames       json.RawMessage `json:"qBadFieldNames"`
-		DangerousFieldNames json.RawMessage `json:"qDangerousFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "CheckExpression", result, expr, labels)
-	return result.ErrorMsg, result.BadFieldNames, result.DangerousFieldNames, err
-}
-
-// Checks if:
-//
-// • A given expression is valid.
-//
-// • A number is correct according to the locale.
-//
-// Parameters:
-//
-// ◾ expr   -   Expression to check.
-//
-// Stability: locked
-func (obj *Doc) CheckNumberOrExpression(ctx context.Context, expr string) (string, []*NxRange, error) {
-	result := &struct {
-		ErrorMsg      string     `json:"qErrorMsg"`
-		BadFieldNames []*NxRange `json:"qBadFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "CheckNumberOrExpression", result, expr)
-	return result.ErrorMsg, result.BadFieldNames, err
-}
-
-// Checks if:
-//
-// • A given expression is valid.
-//
-// • A number is correct according to the locale.
-//
-// Parameters:
-//
-// ◾ expr   -   Expression to check.
-//
-// Stability: locked
-func (obj *Doc) CheckNumberOrExpressionRaw(ctx context.Context, expr string) (string, json.RawMessage, error) {
-	result := &struct {
-		ErrorMsg      string          `json:"qErrorMsg"`
-		BadFieldNames json.RawMessage `json:"qBadFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "CheckNumberOrExpression", result, expr)
-	return result.ErrorMsg, result.BadFieldNames, err
-}
-
-// Checks the syntax of a script.
-//
-// Example:
-//
-// "result": { "qErrors": [ { "qErrLen": 3, "qTabIx": 0, "qLineInTab": 0, "qColInLine": 0, "qTextPos": 0 }, { "qErrLen": 5, "qTabIx": 0, "qLineInTab": 0, "qColInLine": 1, "qTextPos": 4, "qSecondaryFailure": true } ] }
-// The first area is the primary error area, the second area is the secondary error area. The second area is optional and is shown only if qSecondaryFailure is set to true. The second area ends when the next statement in the script begins.
-//
-// The list of syntax errors in the script.
-// If there are no errors, the engine returns:
-// If there are errors, the engine returns the following properties in the response:
-//
-//	+-------------------+--------------------------------+---------+
-//	|       NAME        |          DESCRIPTION           |  TYPE   |
-//	+-------------------+--------------------------------+---------+
-//	| qErrLen           | Length of the word where the   | Integer |
-//	|                   | error is located.              |         |
-//	| qTabIx            | Number of the faulty section.  | Integer |
-//	| qLineInTab        | Line number in the section     | Integer |
-//	|                   | where the error is located.    |         |
-//	| qColInLine        | Position of the erroneous      | Integer |
-//	|                   | text from the beginning of the |         |
-//	|                   | line.                          |         |
-//	| qTextPos          | Position of the erroneous      | Integer |
-//	|                   | text from the beginning of the |         |
-//	|                   | script.                        |         |
-//	| qSecondaryFailure | The default value is false.    | Boolean |
-//	+-------------------+--------------------------------+---------+
-//
-// Stability: locked
-func (obj *Doc) CheckScriptSyntax(ctx context.Context) ([]*ScriptSyntaxError, error) {
-	result := &struct {
-		Errors []*ScriptSyntaxError `json:"qErrors"`
-	}{}
-	err := obj.RPC(ctx, "CheckScriptSyntax", result)
-	return result.Errors, err
-}
-
-// Checks the syntax of a script.
-//
-// Example:
-//
-// "result": { "qErrors": [ { "qErrLen": 3, "qTabIx": 0, "qLineInTab": 0, "qColInLine": 0, "qTextPos": 0 }, { "qErrLen": 5, "qTabIx": 0, "qLineInTab": 0, "qColInLine": 1, "qTextPos": 4, "qSecondaryFailure": true } ] }
-// The first area is the primary error area, the second area is the secondary error area. The second area is optional and is shown only if qSecondaryFailure is set to true. The second area ends when the next statement in the script begins.
-//
-// The list of syntax errors in the script.
-// If there are no errors, the engine returns:
-// If there are errors, the engine returns the following properties in the response:
-//
-//	+-------------------+--------------------------------+---------+
-//	|       NAME        |          DESCRIPTION           |  TYPE   |
-//	+-------------------+--------------------------------+---------+
-//	| qErrLen           | Length of the word where the   | Integer |
-//	|                   | error is located.              |         |
-//	| qTabIx            | Number of the faulty section.  | Integer |
-//	| qLineInTab        | Line number in the section     | Integer |
-//	|                   | where the error is located.    |         |
-//	| qColInLine        | Position of the erroneous      | Integer |
-//	|                   | text from the beginning of the |         |
-//	|                   | line.                          |         |
-//	| qTextPos          | Position of the erroneous      | Integer |
-//	|                   | text from the beginning of the |         |
-//	|                   | script.                        |         |
-//	| qSecondaryFailure | The default value is false.    | Boolean |
-//	+-------------------+--------------------------------+---------+
-//
-// Stability: locked
-func (obj *Doc) CheckScriptSyntaxRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Errors json.RawMessage `json:"qErrors"`
-	}{}
-	err := obj.RPC(ctx, "CheckScriptSyntax", result)
-	return result.Errors, err
-}
-
-// Clear selections in fields for current state. Locked fields are not cleared by default.
-//
-// Parameters:
-//
-// ◾ lockedAlso   -   When true, clears the selection for locked fields.
-//
-// ◾ stateName    -   Alternate state name. When set, applies to alternate state instead of current
-//
-// Stability: locked
-func (obj *Doc) ClearAll(ctx context.Context, lockedAlso bool, stateName string) error {
-	err := obj.RPC(ctx, "ClearAll", nil, lockedAlso, stateName)
-	return err
-}
-
-// Clear the soft properties of all generic objects in the app
-// Stability: experimental
-func (obj *Doc) ClearAllSoftPatches(ctx context.Context) error {
-	err := obj.RPC(ctx, "ClearAllSoftPatches", nil)
-	return err
-}
-
-// Clears entirely the undo and redo buffer.
-// Stability: locked
-func (obj *Doc) ClearUndoBuffer(ctx context.Context) error {
-	err := obj.RPC(ctx, "ClearUndoBuffer", nil)
-	return err
-}
-
-// Clones a bookmark.
-// The identifier is set by the engine.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to clone.
-//
-// Stability: locked
-func (obj *Doc) CloneBookmark(ctx context.Context, id string) (string, error) {
-	result := &struct {
-		CloneId string `json:"qCloneId"`
-	}{}
-	err := obj.RPC(ctx, "CloneBookmark", result, id)
-	return result.CloneId, err
-}
-
-// Clones a dimension.
-//
-// The identifier is set by the engine.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to clone.
-//
-// Stability: locked
-func (obj *Doc) CloneDimension(ctx context.Context, id string) (string, error) {
-	result := &struct {
-		CloneId string `json:"qCloneId"`
-	}{}
-	err := obj.RPC(ctx, "CloneDimension", result, id)
-	return result.CloneId, err
-}
-
-// Clones a measure.
-//
-// The identifier is set by the engine.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to clone.
-//
-// Stability: locked
-func (obj *Doc) CloneMeasure(ctx context.Context, id string) (string, error) {
-	result := &struct {
-		CloneId string `json:"qCloneId"`
-	}{}
-	err := obj.RPC(ctx, "CloneMeasure", result, id)
-	return result.CloneId, err
-}
-
-// Clones root level objects, such as sheets and stories. The CloneObject method works for both app objects and child objects.
-// When you clone an object that contains children, the children are cloned as well.
-// If you for example want to clone a visualization, you must provide the qID of the root object, in this case the sheet since CloneObject clones root level objects.
-// It is not possible to clone a session object.
-//
-// The identifier is set by the engine.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to clone. The identifier must be a root object.
-//
-// Stability: locked
-func (obj *Doc) CloneObject(ctx context.Context, id string) (string, error) {
-	result := &struct {
-		CloneId string `json:"qCloneId"`
-	}{}
-	err := obj.RPC(ctx, "CloneObject", result, id)
-	return result.CloneId, err
-}
-
-// Commits the draft of an object that was previously created by invoking the CreateDraft method.
-// Committing a draft replaces the corresponding published object.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the draft to commit.
-//
-// Deprecated: This will be removed in a future version
-// Stability: locked
-func (obj *Doc) CommitDraft(ctx context.Context, id string) error {
-	err := obj.RPC(ctx, "CommitDraft", nil, id)
-	return err
-}
-
-// Commits the current script version so that any future changes will be part of a new version.
-//
-// Parameters:
-//
-// ◾ commitMessage   -   Name of the version.
-// Only applicable to QCS.
-//
-// Stability: experimental
-func (obj *Doc) CommitScript(ctx context.Context, commitMessage string) error {
-	err := obj.RPC(ctx, "CommitScript", nil, commitMessage)
-	return err
-}
-
-// Creates a bookmark.
-//
-// Parameters:
-//
-// ◾ prop   -   Properties for the object.
-//
-// Stability: locked
-func (obj *Doc) CreateBookmark(ctx context.Context, prop *GenericBookmarkProperties) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateBookmark", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a bookmark.
-//
-// Parameters:
-//
-// ◾ prop   -   Properties for the object.
-//
-// Stability: locked
-func (obj *Doc) CreateBookmarkRaw(ctx context.Context, prop any) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateBookmark", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a bookmark with softpatches.
-//
-// Parameters:
-//
-// ◾ prop               -   Properties for the object.
-//
-// ◾ objectIdsToPatch   -   Add softpatches for this objects if available. If empty all softpatches are added to the bookmark.
-//
-// Stability: experimental
-func (obj *Doc) CreateBookmarkEx(ctx context.Context, prop *GenericBookmarkProperties, objectIdsToPatch []string) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateBookmarkEx", result, prop, objectIdsToPatch)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a bookmark with softpatches.
-//
-// Parameters:
-//
-// ◾ prop               -   Properties for the object.
-//
-// ◾ objectIdsToPatch   -   Add softpatches for this objects if available. If empty all softpatches are added to the bookmark.
-//
-// Stability: experimental
-func (obj *Doc) CreateBookmarkExRaw(ctx context.Context, prop any, objectIdsToPatch []string) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateBookmarkEx", result, prop, objectIdsToPatch)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a connection.
-// A connection indicates from which data source the data should be taken.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Doc) CreateConnection(ctx context.Context, connection *Connection) (string, error) {
-	result := &struct {
-		ConnectionId string `json:"qConnectionId"`
-	}{}
-	err := obj.RPC(ctx, "CreateConnection", result, connection)
-	return result.ConnectionId, err
-}
-
-// Creates a connection.
-// A connection indicates from which data source the data should be taken.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Doc) CreateConnectionRaw(ctx context.Context, connection any) (string, error) {
-	result := &struct {
-		ConnectionId string `json:"qConnectionId"`
-	}{}
-	err := obj.RPC(ctx, "CreateConnection", result, connection)
-	return result.ConnectionId, err
-}
-
-// Creates a master dimension.
-// A master dimension is stored in the library of an app and can be used in many objects. Several generic objects can contain the same dimension.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties.
-//
-// Stability: locked
-func (obj *Doc) CreateDimension(ctx context.Context, prop *GenericDimensionProperties) (*GenericDimension, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateDimension", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericDimension{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a master dimension.
-// A master dimension is stored in the library of an app and can be used in many objects. Several generic objects can contain the same dimension.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties.
-//
-// Stability: locked
-func (obj *Doc) CreateDimensionRaw(ctx context.Context, prop any) (*GenericDimension, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateDimension", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericDimension{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a draft of an object.
-// This method can be used to create a draft of a sheet or a story that is published. This is a way to continue working on a sheet or a story that is published.
-// Replace the published object by the content of the draft by invoking the CommitDraft method.
-//
-// The identifier is set by the engine.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to create a draft from.
-//
-// Deprecated: This will be removed in a future version
-// Stability: locked
-func (obj *Doc) CreateDraft(ctx context.Context, id string) (string, error) {
-	result := &struct {
-		DraftId string `json:"qDraftId"`
-	}{}
-	err := obj.RPC(ctx, "CreateDraft", result, id)
-	return result.DraftId, err
-}
-
-// Creates a master measure.
-// A master measure is stored in the library of an app and can be used in many objects. Several generic objects can contain the same measure.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties.
-//
-// Stability: locked
-func (obj *Doc) CreateMeasure(ctx context.Context, prop *GenericMeasureProperties) (*GenericMeasure, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateMeasure", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericMeasure{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a master measure.
-// A master measure is stored in the library of an app and can be used in many objects. Several generic objects can contain the same measure.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties.
-//
-// Stability: locked
-func (obj *Doc) CreateMeasureRaw(ctx context.Context, prop any) (*GenericMeasure, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateMeasure", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericMeasure{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a generic object at app level. For more information on generic objects, see Generic object.
-// It is possible to create a generic object that is linked to another object.
-// A linked object is an object that points to a linking object. The linking object is defined in the properties of the linked object (in qExtendsId ).
-// The linked object has the same properties as the linking object.
-// The linking object cannot be a transient object.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the object.
-//
-// Stability: locked
-func (obj *Doc) CreateObject(ctx context.Context, prop *GenericObjectProperties) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a generic object at app level. For more information on generic objects, see Generic object.
-// It is possible to create a generic object that is linked to another object.
-// A linked object is an object that points to a linking object. The linking object is defined in the properties of the linked object (in qExtendsId ).
-// The linked object has the same properties as the linking object.
-// The linking object cannot be a transient object.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the object.
-//
-// Stability: locked
-func (obj *Doc) CreateObjectRaw(ctx context.Context, prop any) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a transient object. For example, you can use a transient object to create an app overview or a story overview.
-// It is possible to create a transient object that is linked to another object.
-// A linked object is an object that points to a linking object. The linking object is defined in the properties of the linked object (in qExtendsId ).
-// The linked object has the same properties as the linking object.
-// The linking object cannot be a transient object.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the object.
-//
-// Stability: locked
-func (obj *Doc) CreateSessionObject(ctx context.Context, prop *GenericObjectProperties) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a transient object. For example, you can use a transient object to create an app overview or a story overview.
-// It is possible to create a transient object that is linked to another object.
-// A linked object is an object that points to a linking object. The linking object is defined in the properties of the linked object (in qExtendsId ).
-// The linked object has the same properties as the linking object.
-// The linking object cannot be a transient object.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the object.
-//
-// Stability: locked
-func (obj *Doc) CreateSessionObjectRaw(ctx context.Context, prop any) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a transient variable.
-// To set some properties to the variable, use the SetProperties method.
-//
-// Definition:
-//
-// A variable in Qlik Sense is a named entity, containing a data value. This value can be static or be the result of a calculation. A variable acquires its value at the same time that the variable is created or after when updating the properties of the variable. Variables can be used in bookmarks and can contain numeric or alphanumeric data. Any change made to the variable is applied everywhere the variable is used.
-// When a variable is used in an expression, it is substituted by its value or the variable's definition.
-//
-// Example:
-//
-// The variable x contains the text string Sum(Sales) .
-// In a chart, you define the expression $(x)/12 . The effect is exactly the same as having the chart expression Sum(Sales)/12 .
-// However, if you change the value of the variable x to Sum(Budget) , the data in the chart are immediately recalculated with the expression interpreted as Sum(Budget)/12 .
-//
-// Parameters:
-//
-// ◾ prop   -   Name of the variable. Variable names are case sensitive.
-//
-// Stability: locked
-func (obj *Doc) CreateSessionVariable(ctx context.Context, prop *GenericVariableProperties) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionVariable", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a transient variable.
-// To set some properties to the variable, use the SetProperties method.
-//
-// Definition:
-//
-// A variable in Qlik Sense is a named entity, containing a data value. This value can be static or be the result of a calculation. A variable acquires its value at the same time that the variable is created or after when updating the properties of the variable. Variables can be used in bookmarks and can contain numeric or alphanumeric data. Any change made to the variable is applied everywhere the variable is used.
-// When a variable is used in an expression, it is substituted by its value or the variable's definition.
-//
-// Example:
-//
-// The variable x contains the text string Sum(Sales) .
-// In a chart, you define the expression $(x)/12 . The effect is exactly the same as having the chart expression Sum(Sales)/12 .
-// However, if you change the value of the variable x to Sum(Budget) , the data in the chart are immediately recalculated with the expression interpreted as Sum(Budget)/12 .
-//
-// Parameters:
-//
-// ◾ prop   -   Name of the variable. Variable names are case sensitive.
-//
-// Stability: locked
-func (obj *Doc) CreateSessionVariableRaw(ctx context.Context, prop any) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionVariable", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Create temporary bookmark
-// CreateTemporaryBookmark method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ options            -   Options for the temporary bookmark
-//
-// ◾ objectIdsToPatch   -   Add softpatches for these objects to the bookmark if available. If IncludePatches is true, softpatches are included for all objects.
-// Any session objects included are also added to the bookmark. IncludePatches has no effect on the patching of session objects.
-//
-// Stability: locked
-func (obj *Doc) CreateTemporaryBookmark(ctx context.Context, options *NxTempBookmarkOptions, objectIdsToPatch []string) (string, bool, error) {
-	result := &struct {
-		Id     string `json:"qId"`
-		Return bool   `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateTemporaryBookmark", result, options, objectIdsToPatch)
-	return result.Id, result.Return, err
-}
-
-// Create temporary bookmark
-// CreateTemporaryBookmark method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ options            -   Options for the temporary bookmark
-//
-// ◾ objectIdsToPatch   -   Add softpatches for these objects to the bookmark if available. If IncludePatches is true, softpatches are included for all objects.
-// Any session objects included are also added to the bookmark. IncludePatches has no effect on the patching of session objects.
-//
-// Stability: locked
-func (obj *Doc) CreateTemporaryBookmarkRaw(ctx context.Context, options any, objectIdsToPatch []string) (string, bool, error) {
-	result := &struct {
-		Id     string `json:"qId"`
-		Return bool   `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateTemporaryBookmark", result, options, objectIdsToPatch)
-	return result.Id, result.Return, err
-}
-
-// Creates a variable.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable. Variable names are case sensitive.
-//
-// Deprecated: Use _Doc::CreateVariableEx_ method instead
-// Stability: locked
-func (obj *Doc) CreateVariable(ctx context.Context, name string) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateVariable", result, name)
-	return result.Return, err
-}
-
-// Creates a variable.
-// To create a variable via a script, you need to use the SetScript method. For more information, see Create a variable.
-// To set some properties to the variable, use the SetProperties method.
-// In a published app, only transient variables can be created. See CreateSessionVariable method.
-//
-// Definition:
-//
-// A variable in Qlik Sense is a named entity, containing a data value. This value can be static or be the result of a calculation. A variable acquires its value at the same time that the variable is created or after when updating the properties of the variable. Variables can be used in bookmarks and can contain numeric or alphanumeric data. Any change made to the variable is applied everywhere the variable is used.
-// When a variable is used in an expression, it is substituted by its value or the variable's definition.
-//
-// Example:
-//
-// The variable x contains the text string Sum(Sales) .
-// In a chart, you define the expression $(x)/12 . The effect is exactly the same as having the chart expression Sum(Sales)/12 .
-// However, if you change the value of the variable x to Sum(Budget) , the data in the chart are immediately recalculated with the expression interpreted as Sum(Budget)/12 .
-//
-// Parameters:
-//
-// ◾ prop   -   Name of the variable. Variable names are case sensitive and must be unique.
-//
-// Stability: locked
-func (obj *Doc) CreateVariableEx(ctx context.Context, prop *GenericVariableProperties) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateVariableEx", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a variable.
-// To create a variable via a script, you need to use the SetScript method. For more information, see Create a variable.
-// To set some properties to the variable, use the SetProperties method.
-// In a published app, only transient variables can be created. See CreateSessionVariable method.
-//
-// Definition:
-//
-// A variable in Qlik Sense is a named entity, containing a data value. This value can be static or be the result of a calculation. A variable acquires its value at the same time that the variable is created or after when updating the properties of the variable. Variables can be used in bookmarks and can contain numeric or alphanumeric data. Any change made to the variable is applied everywhere the variable is used.
-// When a variable is used in an expression, it is substituted by its value or the variable's definition.
-//
-// Example:
-//
-// The variable x contains the text string Sum(Sales) .
-// In a chart, you define the expression $(x)/12 . The effect is exactly the same as having the chart expression Sum(Sales)/12 .
-// However, if you change the value of the variable x to Sum(Budget) , the data in the chart are immediately recalculated with the expression interpreted as Sum(Budget)/12 .
-//
-// Parameters:
-//
-// ◾ prop   -   Name of the variable. Variable names are case sensitive and must be unique.
-//
-// Stability: locked
-func (obj *Doc) CreateVariableExRaw(ctx context.Context, prop any) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateVariableEx", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Deletes a connection.
-// In Qlik Sense Enterprise, there is an additional file connection named AttachedFiles . The AttachedFiles connection can only be removed by the administrator of the system.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection to remove.
-//
-// Stability: locked
-func (obj *Doc) DeleteConnection(ctx context.Context, connectionId string) error {
-	err := obj.RPC(ctx, "DeleteConnection", nil, connectionId)
-	return err
-}
-
-// Removes a bookmark.
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: locked
-func (obj *Doc) DestroyBookmark(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyBookmark", result, id)
-	return result.Success, err
-}
-
-// Removes a dimension.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the dimension to remove.
-//
-// Stability: locked
-func (obj *Doc) DestroyDimension(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyDimension", result, id)
-	return result.Success, err
-}
-
-// Removes the draft of an object.
-// The children of the draft object (if any) are removed as well.
-// This method can be used to cancel the work on the draft of an object. For example, if you had created a draft of a sheet that is published, you might not want anymore to replace the published sheet.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id         -   Identifier of the draft object to remove.
-//
-// ◾ sourceId   -   Identifier of the source object (the object from which a draft was created).
-//
-// Deprecated: This will be removed in a future version
-// Stability: locked
-func (obj *Doc) DestroyDraft(ctx context.Context, id string, sourceId string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyDraft", result, id, sourceId)
-	return result.Success, err
-}
-
-// Removes a generic measure.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the measure to remove.
-//
-// Stability: locked
-func (obj *Doc) DestroyMeasure(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyMeasure", result, id)
-	return result.Success, err
-}
-
-// Removes an app object.
-// The children of the object (if any) are removed as well.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to remove.
-//
-// Stability: locked
-func (obj *Doc) DestroyObject(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyObject", result, id)
-	return result.Success, err
-}
-
-// Removes a transient object.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the transient object to remove.
-//
-// Stability: locked
-func (obj *Doc) DestroySessionObject(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroySessionObject", result, id)
-	return result.Success, err
-}
-
-// Removes a transient variable.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the variable.
-//
-// Stability: locked
-func (obj *Doc) DestroySessionVariable(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroySessionVariable", result, id)
-	return result.Success, err
-}
-
-// Removes a transient variable.
-//
-// •*qSuccess** is set to true if the operation is successful.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the variable.
-//
-// Stability: locked
-func (obj *Doc) DestroySessionVariableById(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroySessionVariableById", result, id)
-	return result.Success, err
-}
-
-// Removes a transient variable.
-//
-// •*qSuccess** is set to true if the operation is successful.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable.
-//
-// Stability: locked
-func (obj *Doc) DestroySessionVariableByName(ctx context.Context, name string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroySessionVariableByName", result, name)
-	return result.Success, err
-}
-
-// Removes a variable.
-// Script-defined variables cannot be removed using the DestroyVariableById method or the DestroyVariableByName method. For more information, see Remove a variable.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the variable.
-//
-// Stability: locked
-func (obj *Doc) DestroyVariableById(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyVariableById", result, id)
-	return result.Success, err
-}
-
-// Removes a variable.
-// Script-defined variables cannot be removed using the DestroyVariableById method or the DestroyVariableByName method. For more information, see Remove a variable.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable.
-//
-// Stability: locked
-func (obj *Doc) DestroyVariableByName(ctx context.Context, name string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyVariableByName", result, name)
-	return result.Success, err
-}
-
-// Reloads the script that is set in an app.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+----------------------------------------+----------------------------------+
-//	|           AUDIT ACTIVITY LOG           |        SYSTEM SERVICE LOG        |
-//	+----------------------------------------+----------------------------------+
-//	| <MachineName>_AuditActivity_Engine.txt | <MachineName>_Service_Engine.txt |
-//	| in Qlik Sense Enterprise               | in Qlik Sense Enterprise         |
-//	| <MachineName>_AuditActivity_Engine.log | <MachineName>_Service_Engine.log |
-//	| in Qlik Sense Desktop                  | in Qlik Sense Desktop            |
-//	+----------------------------------------+----------------------------------+
-//
-// Where to find the log files:
-//
-// The location of the log files depends on whether you have installed Qlik Sense Enterprise or Qlik Sense Desktop.
-//
-//	+-------------------------------------+----------------------------------------+
-//	|        QLIK SENSE ENTERPRISE        |           QLIK SENSE DESKTOP           |
-//	+-------------------------------------+----------------------------------------+
-//	| %ProgramData%/Qlik/Sense/Log/Engine | %UserProfile%/Documents/Qlik/Sense/Log |
-//	+-------------------------------------+----------------------------------------+
-//
-// Parameters:
-//
-// ◾ mode      -   Error handling mode
-// One of:
-//
-// • 0: for default mode.
-//
-// • 1: for ABEND; the reload of the script ends if an error occurs.
-//
-// • 2: for ignore; the reload of the script continues even if an error is detected in the script.
-//
-// ◾ partial   -   Set to true for partial reload.
-// The default value is false.
-//
-// ◾ debug     -   Set to true if debug breakpoints are to be honored. The execution of the script will be in debug mode.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Doc) DoReload(ctx context.Context, mode int, partial bool, debug bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "DoReload", result, mode, partial, debug)
-	return result.Return, err
-}
-
-// Reloads the script that is set in an app and returns the path to the script log file.
-// A log file is created per reload.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+--------------------------+--------------------------+
-//	|    AUDIT ACTIVITY LOG    |    SYSTEM SERVICE LOG    |
-//	+--------------------------+--------------------------+
-//	| < MachineName>           | < MachineName> Service   |
-//	| AuditActivity Engine.txt | Engine.txt in Qlik       |
-//	| in Qlik Sense Enterprise | Sense Enterprise  <      |
-//	|  < MachineName>          | MachineName> Service     |
-//	| AuditActivity Engine.log | Engine.log in Qlik Sense |
-//	| in Qlik Sense Desktop    | Desktop                  |
-//	+--------------------------+--------------------------+
-//
-// Where to find the log files:
-//
-// The location of the log files depends on whether you have installed Qlik Sense Enterprise or Qlik Sense Desktop.
-//
-//	+-------------------------------------+----------------------------------------+
-//	|        QLIK SENSE ENTERPRISE        |           QLIK SENSE DESKTOP           |
-//	+-------------------------------------+----------------------------------------+
-//	| %ProgramData%/Qlik/Sense/Log/Engine | %UserProfile%/Documents/Qlik/Sense/Log |
-//	+-------------------------------------+----------------------------------------+
-//
-// DoReloadExParams:
-//
-//	+----------+--------------------------------+---------+
-//	|   NAME   |          DESCRIPTION           |  TYPE   |
-//	+----------+--------------------------------+---------+
-//	| qMode    | Error handling mode  One of:   | Integer |
-//	|          |    * 0: for default mode.  *   |         |
-//	|          | 1: for ABEND; the reload of    |         |
-//	|          | the script ends if an error    |         |
-//	|          | occurs.  * 2: for ignore; the  |         |
-//	|          | reload of the script continues |         |
-//	|          | even if an error is detected   |         |
-//	|          | in the script.                 |         |
-//	| qPartial | Set to true for partial        | Boolean |
-//	|          | reload.  The default value is  |         |
-//	|          | false.                         |         |
-//	| qDebug   | Set to true if debug           | Boolean |
-//	|          | breakpoints are to be honored. |         |
-//	|          | The execution of the script    |         |
-//	|          | will be in debug mode.  The    |         |
-//	|          | default value is false.        |         |
-//	+----------+--------------------------------+---------+
-//
-// DoReloadExResult:
-//
-//	+----------------+--------------------------------+---------+
-//	|      NAME      |          DESCRIPTION           |  TYPE   |
-//	+----------------+--------------------------------+---------+
-//	| qSuccess       | The operation is successful if | Boolean |
-//	|                | qSuccess is set to True.       |         |
-//	| qScriptLogFile | Path to the script log file.   | String  |
-//	+----------------+--------------------------------+---------+
-//
-// If the data load has successfully finished, no matter how the indexing behaves, true is returned. This happens even if there is a timeout, a memory limit is reached or any other error occurs during the indexing.
-// Stability: locked
-func (obj *Doc) DoReloadEx(ctx context.Context, params *DoReloadExParams) (*DoReloadExResult, error) {
-	result := &struct {
-		Result *DoReloadExResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "DoReloadEx", result, params)
-	return result.Result, err
-}
-
-// Reloads the script that is set in an app and returns the path to the script log file.
-// A log file is created per reload.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+--------------------------+--------------------------+
-//	|    AUDIT ACTIVITY LOG    |    SYSTEM SERVICE LOG    |
-//	+--------------------------+--------------------------+
-//	| < MachineName>           | < MachineName> Service   |
-//	| AuditActivity Engine.txt | Engine.txt in Qlik       |
-//	| in Qlik Sense Enterprise | Sense Enterprise  <      |
-//	|  < MachineName>          | MachineName> Service     |
-//	| AuditActivity Engine.log | Engine.log in Qlik Sense |
-//	| in Qlik Sense Desktop    | Desktop                  |
-//	+--------------------------+--------------------------+
-//
-// Where to find the log files:
-//
-// The location of the log files depends on whether you have installed Qlik Sense Enterprise or Qlik Sense Desktop.
-//
-//	+-------------------------------------+----------------------------------------+
-//	|        QLIK SENSE ENTERPRISE        |           QLIK SENSE DESKTOP           |
-//	+-------------------------------------+----------------------------------------+
-//	| %ProgramData%/Qlik/Sense/Log/Engine | %UserProfile%/Documents/Qlik/Sense/Log |
-//	+-------------------------------------+----------------------------------------+
-//
-// DoReloadExParams:
-//
-//	+----------+--------------------------------+---------+
-//	|   NAME   |          DESCRIPTION           |  TYPE   |
-//	+----------+--------------------------------+---------+
-//	| qMode    | Error handling mode  One of:   | Integer |
-//	|          |    * 0: for default mode.  *   |         |
-//	|          | 1: for ABEND; the reload of    |         |
-//	|          | the script ends if an error    |         |
-//	|          | occurs.  * 2: for ignore; the  |         |
-//	|          | reload of the script continues |         |
-//	|          | even if an error is detected   |         |
-//	|          | in the script.                 |         |
-//	| qPartial | Set to true for partial        | Boolean |
-//	|          | reload.  The default value is  |         |
-//	|          | false.                         |         |
-//	| qDebug   | Set to true if debug           | Boolean |
-//	|          | breakpoints are to be honored. |         |
-//	|          | The execution of the script    |         |
-//	|          | will be in debug mode.  The    |         |
-//	|          | default value is false.        |         |
-//	+----------+--------------------------------+---------+
-//
-// DoReloadExResult:
-//
-//	+----------------+--------------------------------+---------+
-//	|      NAME      |          DESCRIPTION           |  TYPE   |
-//	+----------------+--------------------------------+---------+
-//	| qSuccess       | The operation is successful if | Boolean |
-//	|                | qSuccess is set to True.       |         |
-//	| qScriptLogFile | Path to the script log file.   | String  |
-//	+----------------+--------------------------------+---------+
-//
-// If the data load has successfully finished, no matter how the indexing behaves, true is returned. This happens even if there is a timeout, a memory limit is reached or any other error occurs during the indexing.
-// Stability: locked
-func (obj *Doc) DoReloadExRaw(ctx context.Context, params any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "DoReloadEx", result, params)
-	return result.Result, err
-}
-
-// Saves an app. All objects and data in the data model are saved.
-//
-// Parameters:
-//
-// ◾ fileName   -   Name of the file to save.
-//
-// Stability: locked
-func (obj *Doc) DoSave(ctx context.Context, fileName string) error {
-	err := obj.RPC(ctx, "DoSave", nil, fileName)
-	return err
-}
-
-// Evaluates an expression and returns the result as a string.
-//
-// Example:
-//
-// The client sends:
-// ```
-// {
-// "handle": 1,
-// "method": "Evaluate",
-// "params": {
-// "qExpression": "Sum(Holes)"
-// },
-// "id": 6,
-// "jsonrpc": "2.0"
-// }
-// ```
-// The engine returns:
-// ```
-// {
-// "jsonrpc": "2.0",
-// "id": 6,
-// "result": {
-// "qReturn": "361716"
-// }
-// }
-// ```
-//
-// Parameters:
-//
-// ◾ expression   -   Expression to evaluate.
-//
-// Stability: locked
-func (obj *Doc) Evaluate(ctx context.Context, expression string) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "Evaluate", result, expression)
-	return result.Return, err
-}
-
-// Evaluates an expression and returns the result as a dual.
-//
-// Example:
-//
-// The client sends:
-// ```
-// {
-// "handle": 1,
-// "method": "EvaluateEx",
-// "params": {
-// "qExpression": "Sum(Holes)"
-// },
-// "id": 7,
-// "jsonrpc": "2.0"
-// }
-// ```
-// The engine returns:
-// ```
-// {
-// "jsonrpc": "2.0",
-// "id": 7,
-// "result": {
-// "qReturn": "361716"
-// }
-// }
-// ```
-//
-// Parameters:
-//
-// ◾ expression   -   Expression to evaluate.
-//
-// Stability: locked
-func (obj *Doc) EvaluateEx(ctx context.Context, expression string) (*FieldValue, error) {
-	result := &struct {
-		Value *FieldValue `json:"qValue"`
-	}{}
-	err := obj.RPC(ctx, "EvaluateEx", result, expression)
-	return result.Value, err
-}
-
-// Evaluates an expression and returns the result as a dual.
-//
-// Example:
-//
-// The client sends:
-// ```
-// {
-// "handle": 1,
-// "method": "EvaluateEx",
-// "params": {
-// "qExpression": "Sum(Holes)"
-// },
-// "id": 7,
-// "jsonrpc": "2.0"
-// }
-// ```
-// The engine returns:
-// ```
-// {
-// "jsonrpc": "2.0",
-// "id": 7,
-// "result": {
-// "qReturn": "361716"
-// }
-// }
-// ```
-//
-// Parameters:
-//
-// ◾ expression   -   Expression to evaluate.
-//
-// Stability: locked
-func (obj *Doc) EvaluateExRaw(ctx context.Context, expression string) (json.RawMessage, error) {
-	result := &struct {
-		Value json.RawMessage `json:"qValue"`
-	}{}
-	err := obj.RPC(ctx, "EvaluateEx", result, expression)
-	return result.Value, err
-}
-
-// Expands the expression.
-//
-// Parameters:
-//
-// ◾ expression   -   The expression string to expand.
-//
-// Stability: locked
-func (obj *Doc) ExpandExpression(ctx context.Context, expression string) (string, error) {
-	result := &struct {
-		ExpandedExpression string `json:"qExpandedExpression"`
-	}{}
-	err := obj.RPC(ctx, "ExpandExpression", result, expression)
-	return result.ExpandedExpression, err
-}
-
-// Applies a bookmark to reduce (slice) the data on. Returns a url and file size to the reduced application. Section Access is always applied.
-// This API is only available on Sense Enterprise on Windows
-//
-// Parameters:
-//
-// ◾ options   -   BookmarkId used to reduced the app on and an expire time.
-//
-// Stability: locked
-func (obj *Doc) ExportReducedData(ctx context.Context, options *NxDownloadOptions) (*NxDownloadInfo, error) {
-	result := &struct {
-		DownloadInfo *NxDownloadInfo `json:"qDownloadInfo"`
-	}{}
-	err := obj.RPC(ctx, "ExportReducedData", result, options)
-	return result.DownloadInfo, err
-}
-
-// Applies a bookmark to reduce (slice) the data on. Returns a url and file size to the reduced application. Section Access is always applied.
-// This API is only available on Sense Enterprise on Windows
-//
-// Parameters:
-//
-// ◾ options   -   BookmarkId used to reduced the app on and an expire time.
-//
-// Stability: locked
-func (obj *Doc) ExportReducedDataRaw(ctx context.Context, options any) (json.RawMessage, error) {
-	result := &struct {
-		DownloadInfo json.RawMessage `json:"qDownloadInfo"`
-	}{}
-	err := obj.RPC(ctx, "ExportReducedData", result, options)
-	return result.DownloadInfo, err
-}
-
-// Retrieves any fields that belong to the same archipelago as the specified field and that match at least one of the specified tags.
-// Tags set by Qlik Sense are prefixed by the $ sign.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field.
-// This method looks for fields that belong to the same archipelago as this specified field.
-//
-// ◾ tags        -   List of tags.
-// This method looks for fields that match at least one of the tags in this list.
-//
-// Stability: locked
-func (obj *Doc) FindMatchingFields(ctx context.Context, fieldName string, tags []string) ([]*NxMatchingFieldInfo, error) {
-	result := &struct {
-		FieldNames []*NxMatchingFieldInfo `json:"qFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "FindMatchingFields", result, fieldName, tags)
-	return result.FieldNames, err
-}
-
-// Retrieves any fields that belong to the same archipelago as the specified field and that match at least one of the specified tags.
-// Tags set by Qlik Sense are prefixed by the $ sign.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field.
-// This method looks for fields that belong to the same archipelago as this specified field.
-//
-// ◾ tags        -   List of tags.
-// This method looks for fields that match at least one of the tags in this list.
-//
-// Stability: locked
-func (obj *Doc) FindMatchingFieldsRaw(ctx context.Context, fieldName string, tags []string) (json.RawMessage, error) {
-	result := &struct {
-		FieldNames json.RawMessage `json:"qFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "FindMatchingFields", result, fieldName, tags)
-	return result.FieldNames, err
-}
-
-// Loads the next logical operation (if any).
-// Stability: locked
-func (obj *Doc) Forward(ctx context.Context) error {
-	err := obj.RPC(ctx, "Forward", nil)
-	return err
-}
-
-// Returns the number of entries on the Forward stack.
-// Stability: locked
-func (obj *Doc) ForwardCount(ctx context.Context) (int, error) {
-	result := &struct {
-		Return int `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ForwardCount", result)
-	return result.Return, err
-}
-
-// Returns the identifier and the type of any generic object in the app.
-// Stability: locked
-func (obj *Doc) GetAllInfos(ctx context.Context) ([]*NxInfo, error) {
-	result := &struct {
-		Infos []*NxInfo `json:"qInfos"`
-	}{}
-	err := obj.RPC(ctx, "GetAllInfos", result)
-	return result.Infos, err
-}
-
-// Returns the identifier and the type of any generic object in the app.
-// Stability: locked
-func (obj *Doc) GetAllInfosRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Infos json.RawMessage `json:"qInfos"`
-	}{}
-	err := obj.RPC(ctx, "GetAllInfos", result)
-	return result.Infos, err
-}
-
-// Evaluates an app.
-// Returns dynamic properties (if any) in addition to the engine (fixed) properties.
-// A data set is returned.
-// Stability: locked
-func (obj *Doc) GetAppLayout(ctx context.Context) (*NxAppLayout, error) {
-	result := &struct {
-		Layout *NxAppLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetAppLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates an app.
-// Returns dynamic properties (if any) in addition to the engine (fixed) properties.
-// A data set is returned.
-// Stability: locked
-func (obj *Doc) GetAppLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetAppLayout", result)
-	return result.Layout, err
-}
-
-// Gets the properties of an app.
-// Stability: locked
-func (obj *Doc) GetAppProperties(ctx context.Context) (*NxAppProperties, error) {
-	result := &struct {
-		Prop *NxAppProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetAppProperties", result)
-	return result.Prop, err
-}
-
-// Gets the properties of an app.
-// Stability: locked
-func (obj *Doc) GetAppPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetAppProperties", result)
-	return result.Prop, err
-}
-
-// Computes a set of association scores for each pair of fields between two given tables that have been loaded in an app.
-// When a table contains some synthetic keys, all fields in the synthetic key tables are analyzed against fields in other tables. To denote that a field is a synthetic key, the field name is prefixed by [Synthetic Key]: .
-//
-// Parameters:
-//
-// ◾ table1   -   Name of the first table.
-//
-// ◾ table2   -   Name of the second table.
-//
-// Stability: locked
-func (obj *Doc) GetAssociationScores(ctx context.Context, table1 string, table2 string) ([]*AssociationScore, error) {
-	result := &struct {
-		Score []*AssociationScore `json:"qScore"`
-	}{}
-	err := obj.RPC(ctx, "GetAssociationScores", result, table1, table2)
-	return result.Score, err
-}
-
-// Computes a set of association scores for each pair of fields between two given tables that have been loaded in an app.
-// When a table contains some synthetic keys, all fields in the synthetic key tables are analyzed against fields in other tables. To denote that a field is a synthetic key, the field name is prefixed by [Synthetic Key]: .
-//
-// Parameters:
-//
-// ◾ table1   -   Name of the first table.
-//
-// ◾ table2   -   Name of the second table.
-//
-// Stability: locked
-func (obj *Doc) GetAssociationScoresRaw(ctx context.Context, table1 string, table2 string) (json.RawMessage, error) {
-	result := &struct {
-		Score json.RawMessage `json:"qScore"`
-	}{}
-	err := obj.RPC(ctx, "GetAssociationScores", result, table1, table2)
-	return result.Score, err
-}
-
-// Returns the handle of a bookmark.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: locked
-func (obj *Doc) GetBookmark(ctx context.Context, id string) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetBookmark", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns all bookmarks compatible with options.
-//
-// Parameters:
-//
-// ◾ options   -   Bookmark type filter and requested properties.
-//
-// Stability: locked
-func (obj *Doc) GetBookmarks(ctx context.Context, options *NxGetBookmarkOptions) ([]*NxContainerEntry, error) {
-	result := &struct {
-		List []*NxContainerEntry `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetBookmarks", result, options)
-	return result.List, err
-}
-
-// Returns all bookmarks compatible with options.
-//
-// Parameters:
-//
-// ◾ options   -   Bookmark type filter and requested properties.
-//
-// Stability: locked
-func (obj *Doc) GetBookmarksRaw(ctx context.Context, options any) (json.RawMessage, error) {
-	result := &struct {
-		List json.RawMessage `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetBookmarks", result, options)
-	return result.List, err
-}
-
-// Retrieves a connection and returns:
-//
-// • The creation time of the connection.
-//
-// • The identifier of the connection.
-//
-// • The type of the connection.
-//
-// • The name of the connection.
-//
-// • The connection string.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetConnection(ctx context.Context, connectionId string) (*Connection, error) {
-	result := &struct {
-		Connection *Connection `json:"qConnection"`
-	}{}
-	err := obj.RPC(ctx, "GetConnection", result, connectionId)
-	return result.Connection, err
-}
-
-// Retrieves a connection and returns:
-//
-// • The creation time of the connection.
-//
-// • The identifier of the connection.
-//
-// • The type of the connection.
-//
-// • The name of the connection.
-//
-// • The connection string.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetConnectionRaw(ctx context.Context, connectionId string) (json.RawMessage, error) {
-	result := &struct {
-		Connection json.RawMessage `json:"qConnection"`
-	}{}
-	err := obj.RPC(ctx, "GetConnection", result, connectionId)
-	return result.Connection, err
-}
-
-// Lists the connections in an app.
-// In Qlik Sense Enterprise, there is an additional file connection named AttachedFiles . This connection is stored in the Qlik Sense repository.
-// Stability: locked
-func (obj *Doc) GetConnections(ctx context.Context) ([]*Connection, error) {
-	result := &struct {
-		Connections []*Connection `json:"qConnections"`
-	}{}
-	err := obj.RPC(ctx, "GetConnections", result)
-	return result.Connections, err
-}
-
-// Lists the connections in an app.
-// In Qlik Sense Enterprise, there is an additional file connection named AttachedFiles . This connection is stored in the Qlik Sense repository.
-// Stability: locked
-func (obj *Doc) GetConnectionsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Connections json.RawMessage `json:"qConnections"`
-	}{}
-	err := obj.RPC(ctx, "GetConnections", result)
-	return result.Connections, err
-}
-
-// Lists the content libraries.
-// To differentiate a global content library from an app specific content library, you can check the property qAppSpecific . If this property is set to true, it means that the content library is app specific.
-// There is always one specific content library per app.
-//
-// Qlik Sense:
-//
-// Returns the global content libraries and the app specific content library.
-// When using Qlik Sense, you can have more than one global content library. The global content libraries are common to all apps in the Qlik Sense repository.
-// By default, there is one global content library named Default .
-//
-// Qlik Sense Desktop:
-//
-// Returns the global content library and the app specific content library from the disk.
-// Stability: locked
-func (obj *Doc) GetContentLibraries(ctx context.Context) (*ContentLibraryList, error) {
-	result := &struct {
-		List *ContentLibraryList `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetContentLibraries", result)
-	return result.List, err
-}
-
-// Lists the content libraries.
-// To differentiate a global content library from an app specific content library, you can check the property qAppSpecific . If this property is set to true, it means that the content library is app specific.
-// There is always one specific content library per app.
-//
-// Qlik Sense:
-//
-// Returns the global content libraries and the app specific content library.
-// When using Qlik Sense, you can have more than one global content library. The global content libraries are common to all apps in the Qlik Sense repository.
-// By default, there is one global content library named Default .
-//
-// Qlik Sense Desktop:
-//
-// Returns the global content library and the app specific content library from the disk.
-// Stability: locked
-func (obj *Doc) GetContentLibrariesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		List json.RawMessage `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetContentLibraries", result)
-	return result.List, err
-}
-
-// Gives information about an ODBC, OLEDB or CUSTOM connection. See Outputs for more details.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Name of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseInfo(ctx context.Context, connectionId string) (*DatabaseInfo, error) {
-	result := &struct {
-		Info *DatabaseInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseInfo", result, connectionId)
-	return result.Info, err
-}
-
-// Gives information about an ODBC, OLEDB or CUSTOM connection. See Outputs for more details.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Name of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseInfoRaw(ctx context.Context, connectionId string) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseInfo", result, connectionId)
-	return result.Info, err
-}
-
-// Lists the owners of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseOwners(ctx context.Context, connectionId string, database string) ([]*DatabaseOwner, error) {
-	result := &struct {
-		Owners []*DatabaseOwner `json:"qOwners"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseOwners", result, connectionId, database)
-	return result.Owners, err
-}
-
-// Lists the owners of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseOwnersRaw(ctx context.Context, connectionId string, database string) (json.RawMessage, error) {
-	result := &struct {
-		Owners json.RawMessage `json:"qOwners"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseOwners", result, connectionId, database)
-	return result.Owners, err
-}
-
-// Lists the fields inside a table of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// ◾ table          -   Name of the table.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTableFields(ctx context.Context, connectionId string, database string, owner string, table string) ([]*DataField, error) {
-	result := &struct {
-		Fields []*DataField `json:"qFields"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTableFields", result, connectionId, database, owner, table)
-	return result.Fields, err
-}
-
-// Lists the fields inside a table of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// ◾ table          -   Name of the table.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTableFieldsRaw(ctx context.Context, connectionId string, database string, owner string, table string) (json.RawMessage, error) {
-	result := &struct {
-		Fields json.RawMessage `json:"qFields"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTableFields", result, connectionId, database, owner, table)
-	return result.Fields, err
-}
-
-// Retrieves the values of the specified table of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// ◾ table          -   Name of the table.
-//
-// ◾ conditions     -
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTablePreview(ctx context.Context, connectionId string, database string, owner string, table string, conditions *FilterInfo) ([]*DataRecord, int, error) {
-	result := &struct {
-		Preview  []*DataRecord `json:"qPreview"`
-		RowCount int           `json:"qRowCount"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTablePreview", result, connectionId, database, owner, table, conditions)
-	return result.Preview, result.RowCount, err
-}
-
-// Retrieves the values of the specified table of a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// ◾ table          -   Name of the table.
-//
-// ◾ conditions     -
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTablePreviewRaw(ctx context.Context, connectionId string, database string, owner string, table string, conditions any) (json.RawMessage, int, error) {
-	result := &struct {
-		Preview  json.RawMessage `json:"qPreview"`
-		RowCount int             `json:"qRowCount"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTablePreview", result, connectionId, database, owner, table, conditions)
-	return result.Preview, result.RowCount, err
-}
-
-// Lists the tables inside a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTables(ctx context.Context, connectionId string, database string, owner string) ([]*DataTable, error) {
-	result := &struct {
-		Tables []*DataTable `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTables", result, connectionId, database, owner)
-	return result.Tables, err
-}
-
-// Lists the tables inside a database for a ODBC, OLEDB or CUSTOM connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ database       -   Name of the database.
-// If qDatabase is not set then qOwner must be set.
-//
-// ◾ owner          -   Owner of the database.
-// If qOwner is not set then qDatabase must be set.
-//
-// Stability: locked
-func (obj *Doc) GetDatabaseTablesRaw(ctx context.Context, connectionId string, database string, owner string) (json.RawMessage, error) {
-	result := &struct {
-		Tables json.RawMessage `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabaseTables", result, connectionId, database, owner)
-	return result.Tables, err
-}
-
-// Lists the databases inside a ODBC, OLEDB or CUSTOM data source.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetDatabases(ctx context.Context, connectionId string) ([]*Database, error) {
-	result := &struct {
-		Databases []*Database `json:"qDatabases"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabases", result, connectionId)
-	return result.Databases, err
-}
-
-// Lists the databases inside a ODBC, OLEDB or CUSTOM data source.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetDatabasesRaw(ctx context.Context, connectionId string) (json.RawMessage, error) {
-	result := &struct {
-		Databases json.RawMessage `json:"qDatabases"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabases", result, connectionId)
-	return result.Databases, err
-}
-
-// Returns the handle of a dimension.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the dimension.
-//
-// Stability: locked
-func (obj *Doc) GetDimension(ctx context.Context, id string) (*GenericDimension, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetDimension", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericDimension{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a script that contains one section. This section contains SET statements that give localized information from the regional settings of the computer.
-// The computer regional settings are retrieved when the engine starts.
-//
-// Parameters:
-//
-// ◾ localizedMainSection   -   Name of the script section.
-// The default value is Main .
-//
-// Stability: locked
-func (obj *Doc) GetEmptyScript(ctx context.Context, localizedMainSection string) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetEmptyScript", result, localizedMainSection)
-	return result.Return, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik chart expressions supported within a given App.
-// Stability: experimental
-func (obj *Doc) GetExpressionBNF(ctx context.Context) ([]*BNFDef, string, error) {
-	result := &struct {
-		BnfDefs []*BNFDef `json:"qBnfDefs"`
-		BnfHash string    `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetExpressionBNF", result)
-	return result.BnfDefs, result.BnfHash, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik chart expressions supported within a given App.
-// Stability: experimental
-func (obj *Doc) GetExpressionBNFRaw(ctx context.Context) (json.RawMessage, string, error) {
-	result := &struct {
-		BnfDefs json.RawMessage `json:"qBnfDefs"`
-		BnfHash string          `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetExpressionBNF", result)
-	return result.BnfDefs, result.BnfHash, err
-}
-
-// Gets a string hash calculated from the current Backus-Naur Form (BNF) grammar of the Qlik chart expressions supported within a given App.
-// Stability: experimental
-func (obj *Doc) GetExpressionBNFHash(ctx context.Context) (string, error) {
-	result := &struct {
-		BnfHash string `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetExpressionBNFHash", result)
-	return result.BnfHash, err
-}
-
-// Retrieves the variables that are tagged as favorite.
-// Stability: locked
-func (obj *Doc) GetFavoriteVariables(ctx context.Context) ([]string, error) {
-	result := &struct {
-		Names []string `json:"qNames"`
-	}{}
-	err := obj.RPC(ctx, "GetFavoriteVariables", result)
-	return result.Names, err
-}
-
-// Returns a handle to a field.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field.
-//
-// ◾ stateName   -   Name of the alternate state.
-// Default state is current selections.
-//
-// Stability: locked
-func (obj *Doc) GetField(ctx context.Context, fieldName string, stateName string) (*Field, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetField", result, fieldName, stateName)
-	if err != nil {
-		return nil, err
-	}
-	return &Field{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Get sample values from either a column in a table or from a field.
-// Supports wildcard matches in tables or field names:
-// - '*' for zero or more characters.
-// - '?' for one character.
-//
-// Parameters:
-//
-// ◾ fieldsOrColumnsWithWildcards   -   Pairs of table (optionally) and field names. Support wildcard matches.
-//
-// ◾ maxNumberOfValues              -   Max number of sample values returned. Depending on the column or field size the number of returned samples can be less than MaxNumberOfValues. If MaxNumberOfValues is negative all sample values are returned.
-//
-// ◾ randSeed                       -   Optional. Sets the random number seed. Should only be set for test purposes.
-//
-// Stability: locked
-func (obj *Doc) GetFieldAndColumnSamples(ctx context.Context, fieldsOrColumnsWithWildcards []*FieldOrColumn, maxNumberOfValues int, randSeed int) ([]*SampleResult, error) {
-	result := &struct {
-		Result []*SampleResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldAndColumnSamples", result, fieldsOrColumnsWithWildcards, maxNumberOfValues, randSeed)
-	return result.Result, err
-}
-
-// Get sample values from either a column in a table or from a field.
-// Supports wildcard matches in tables or field names:
-// - '*' for zero or more characters.
-// - '?' for one character.
-//
-// Parameters:
-//
-// ◾ fieldsOrColumnsWithWildcards   -   Pairs of table (optionally) and field names. Support wildcard matches.
-//
-// ◾ maxNumberOfValues              -   Max number of sample values returned. Depending on the column or field size the number of returned samples can be less than MaxNumberOfValues. If MaxNumberOfValues is negative all sample values are returned.
-//
-// ◾ randSeed                       -   Optional. Sets the random number seed. Should only be set for test purposes.
-//
-// Stability: locked
-func (obj *Doc) GetFieldAndColumnSamplesRaw(ctx context.Context, fieldsOrColumnsWithWildcards any, maxNumberOfValues int, randSeed int) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldAndColumnSamples", result, fieldsOrColumnsWithWildcards, maxNumberOfValues, randSeed)
-	return result.Result, err
-}
-
-// Returns the description of a field.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field.
-//
-// Stability: locked
-func (obj *Doc) GetFieldDescription(ctx context.Context, fieldName string) (*FieldDescription, error) {
-	result := &struct {
-		Return *FieldDescription `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldDescription", result, fieldName)
-	return result.Return, err
-}
-
-// Returns the description of a field.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field.
-//
-// Stability: locked
-func (obj *Doc) GetFieldDescriptionRaw(ctx context.Context, fieldName string) (json.RawMessage, error) {
-	result := &struct {
-		Return json.RawMessage `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldDescription", result, fieldName)
-	return result.Return, err
-}
-
-// Find the field-on-the-fly by passing its readable name.
-//
-// Parameters:
-//
-// ◾ readableName   -   Readable name of the field-on-the-fly.
-//
-// Stability: locked
-func (obj *Doc) GetFieldOnTheFlyByName(ctx context.Context, readableName string) (string, error) {
-	result := &struct {
-		Name string `json:"qName"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldOnTheFlyByName", result, readableName)
-	return result.Name, err
-}
-
-// Retrives any fields from an expression.
-//
-// Parameters:
-//
-// ◾ expr   -   Expression to get fields from.
-//
-// Stability: locked
-func (obj *Doc) GetFieldsFromExpression(ctx context.Context, expr string) ([]string, error) {
-	result := &struct {
-		FieldNames []string `json:"qFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldsFromExpression", result, expr)
-	return result.FieldNames, err
-}
-
-// Returns a list of resource ids (QRI) for fields that belongs to the datamodel.
-// Key fields (that belongs to multiple tables), returns one resource identifier per table.
-// GetFieldsResourceIds method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ fieldNames   -   List of fields names that resource ids should be returned from.
-//
-// Stability: locked
-func (obj *Doc) GetFieldsResourceIds(ctx context.Context, fieldNames []string) ([]*NxFieldResourceId, error) {
-	result := &struct {
-		Fields []*NxFieldResourceId `json:"qFields"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldsResourceIds", result, fieldNames)
-	return result.Fields, err
-}
-
-// Returns a list of resource ids (QRI) for fields that belongs to the datamodel.
-// Key fields (that belongs to multiple tables), returns one resource identifier per table.
-// GetFieldsResourceIds method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ fieldNames   -   List of fields names that resource ids should be returned from.
-//
-// Stability: locked
-func (obj *Doc) GetFieldsResourceIdsRaw(ctx context.Context, fieldNames []string) (json.RawMessage, error) {
-	result := &struct {
-		Fields json.RawMessage `json:"qFields"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldsResourceIds", result, fieldNames)
-	return result.Fields, err
-}
-
-// Lists the fields of a table for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// ◾ table          -   Name of the table.
-// This parameter must be set for XLS , XLSX , HTML   and XML files.
-//
-// Stability: locked
-func (obj *Doc) GetFileTableFields(ctx context.Context, connectionId string, relativePath string, dataFormat *FileDataFormat, table string) ([]*DataField, string, error) {
-	result := &struct {
-		Fields     []*DataField `json:"qFields"`
-		FormatSpec string       `json:"qFormatSpec"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTableFields", result, connectionId, relativePath, dataFormat, table)
-	return result.Fields, result.FormatSpec, err
-}
-
-// Lists the fields of a table for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// ◾ table          -   Name of the table.
-// This parameter must be set for XLS , XLSX , HTML   and XML files.
-//
-// Stability: locked
-func (obj *Doc) GetFileTableFieldsRaw(ctx context.Context, connectionId string, relativePath string, dataFormat any, table string) (json.RawMessage, string, error) {
-	result := &struct {
-		Fields     json.RawMessage `json:"qFields"`
-		FormatSpec string          `json:"qFormatSpec"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTableFields", result, connectionId, relativePath, dataFormat, table)
-	return result.Fields, result.FormatSpec, err
-}
-
-// Lists the values in a table for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// ◾ table          -   Name of the table.
-// This parameter must be set for XLS , XLSX , HTML   and XML files.
-//
-// Stability: locked
-func (obj *Doc) GetFileTablePreview(ctx context.Context, connectionId string, relativePath string, dataFormat *FileDataFormat, table string) ([]*DataRecord, string, error) {
-	result := &struct {
-		Preview    []*DataRecord `json:"qPreview"`
-		FormatSpec string        `json:"qFormatSpec"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTablePreview", result, connectionId, relativePath, dataFormat, table)
-	return result.Preview, result.FormatSpec, err
-}
-
-// Lists the values in a table for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// ◾ table          -   Name of the table.
-// This parameter must be set for XLS , XLSX , HTML   and XML files.
-//
-// Stability: locked
-func (obj *Doc) GetFileTablePreviewRaw(ctx context.Context, connectionId string, relativePath string, dataFormat any, table string) (json.RawMessage, string, error) {
-	result := &struct {
-		Preview    json.RawMessage `json:"qPreview"`
-		FormatSpec string          `json:"qFormatSpec"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTablePreview", result, connectionId, relativePath, dataFormat, table)
-	return result.Preview, result.FormatSpec, err
-}
-
-// Lists the tables for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// Stability: locked
-func (obj *Doc) GetFileTables(ctx context.Context, connectionId string, relativePath string, dataFormat *FileDataFormat) ([]*DataTable, error) {
-	result := &struct {
-		Tables []*DataTable `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTables", result, connectionId, relativePath, dataFormat)
-	return result.Tables, err
-}
-
-// Lists the tables for a folder connection.
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// Stability: locked
-func (obj *Doc) GetFileTablesRaw(ctx context.Context, connectionId string, relativePath string, dataFormat any) (json.RawMessage, error) {
-	result := &struct {
-		Tables json.RawMessage `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTables", result, connectionId, relativePath, dataFormat)
-	return result.Tables, err
-}
-
-// Lists the tables and fields of a JSON or XML file for a folder connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// Stability: locked
-func (obj *Doc) GetFileTablesEx(ctx context.Context, connectionId string, relativePath string, dataFormat *FileDataFormat) ([]*DataTableEx, error) {
-	result := &struct {
-		Tables []*DataTableEx `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTablesEx", result, connectionId, relativePath, dataFormat)
-	return result.Tables, err
-}
-
-// Lists the tables and fields of a JSON or XML file for a folder connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// ◾ dataFormat     -   Type of the file.
-//
-// Stability: locked
-func (obj *Doc) GetFileTablesExRaw(ctx context.Context, connectionId string, relativePath string, dataFormat any) (json.RawMessage, error) {
-	result := &struct {
-		Tables json.RawMessage `json:"qTables"`
-	}{}
-	err := obj.RPC(ctx, "GetFileTablesEx", result, connectionId, relativePath, dataFormat)
-	return result.Tables, err
-}
-
-// Lists the items for a folder connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Relative path of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetFolderItemsForConnection(ctx context.Context, connectionId string, relativePath string) ([]*FolderItem, error) {
-	result := &struct {
-		FolderItems []*FolderItem `json:"qFolderItems"`
-	}{}
-	err := obj.RPC(ctx, "GetFolderItemsForConnection", result, connectionId, relativePath)
-	return result.FolderItems, err
-}
-
-// Lists the items for a folder connection.
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection.
-//
-// ◾ relativePath   -   Relative path of the connection.
-//
-// Stability: locked
-func (obj *Doc) GetFolderItemsForConnectionRaw(ctx context.Context, connectionId string, relativePath string) (json.RawMessage, error) {
-	result := &struct {
-		FolderItems json.RawMessage `json:"qFolderItems"`
-	}{}
-	err := obj.RPC(ctx, "GetFolderItemsForConnection", result, connectionId, relativePath)
-	return result.FolderItems, err
-}
-
-// Stability: experimental
-func (obj *Doc) GetGroupStates(ctx context.Context) ([]*GroupState, error) {
-	result := &struct {
-		GroupStates []*GroupState `json:"qGroupStates"`
-	}{}
-	err := obj.RPC(ctx, "GetGroupStates", result)
-	return result.GroupStates, err
-}
-
-// Stability: experimental
-func (obj *Doc) GetGroupStatesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		GroupStates json.RawMessage `json:"qGroupStates"`
-	}{}
-	err := obj.RPC(ctx, "GetGroupStates", result)
-	return result.GroupStates, err
-}
-
-// Gets the content of a file.
-//
-// Parameters:
-//
-// ◾ path   -   ["lib://CONNECTION_NAME\\\<the name of the file you want to use>.txt"]
-// OR
-// ["lib://Connection_Name\\\<Folder under your connection>\\\<the name of the file you want to use>.txt"]
-// [ ] should be used when the first variable contains a lib reference.
-//
-// Stability: locked
-func (obj *Doc) GetIncludeFileContent(ctx context.Context, path string) (string, error) {
-	result := &struct {
-		Content string `json:"qContent"`
-	}{}
-	err := obj.RPC(ctx, "GetIncludeFileContent", result, path)
-	return result.Content, err
-}
-
-// Returns the content of a library.
-//
-// Global content library:
-//
-// In Qlik Sense Desktop, the content files are retrieved from:
-// _%userprofile%\Documents\Qlik\Sense\Content\Default_
-// In Qlik Sense Enterprise, the content files are retrieved from the Qlik Sense repository.
-//
-// App specific content library:
-//
-// The embedded files are returned.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the content library.
-// It corresponds to the property qContentLibraryListItem/qName returned by the GetContentLibraries method.
-//
-// Stability: locked
-func (obj *Doc) GetLibraryContent(ctx context.Context, name string) (*StaticContentList, error) {
-	result := &struct {
-		List *StaticContentList `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetLibraryContent", result, name)
-	return result.List, err
-}
-
-// Returns the content of a library.
-//
-// Global content library:
-//
-// In Qlik Sense Desktop, the content files are retrieved from:
-// _%userprofile%\Documents\Qlik\Sense\Content\Default_
-// In Qlik Sense Enterprise, the content files are retrieved from the Qlik Sense repository.
-//
-// App specific content library:
-//
-// The embedded files are returned.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the content library.
-// It corresponds to the property qContentLibraryListItem/qName returned by the GetContentLibraries method.
-//
-// Stability: locked
-func (obj *Doc) GetLibraryContentRaw(ctx context.Context, name string) (json.RawMessage, error) {
-	result := &struct {
-		List json.RawMessage `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetLibraryContent", result, name)
-	return result.List, err
-}
-
-// Gets the lineage information of the app. The lineage information includes the LOAD and STORE statements from the data load script associated with this app.
-// An array of lineage information.
-// Stability: locked
-func (obj *Doc) GetLineage(ctx context.Context) ([]*LineageInfo, error) {
-	result := &struct {
-		Lineage []*LineageInfo `json:"qLineage"`
-	}{}
-	err := obj.RPC(ctx, "GetLineage", result)
-	return result.Lineage, err
-}
-
-// Gets the lineage information of the app. The lineage information includes the LOAD and STORE statements from the data load script associated with this app.
-// An array of lineage information.
-// Stability: locked
-func (obj *Doc) GetLineageRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Lineage json.RawMessage `json:"qLineage"`
-	}{}
-	err := obj.RPC(ctx, "GetLineage", result)
-	return result.Lineage, err
-}
-
-// Returns locale information.
-// Stability: locked
-func (obj *Doc) GetLocaleInfo(ctx context.Context) (*LocaleInfo, error) {
-	result := &struct {
-		Return *LocaleInfo `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetLocaleInfo", result)
-	return result.Return, err
-}
-
-// Returns locale information.
-// Stability: locked
-func (obj *Doc) GetLocaleInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Return json.RawMessage `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetLocaleInfo", result)
-	return result.Return, err
-}
-
-// Returns a list of table states.
-//
-// The following states apply:
-//
-// • 0 The table is not loosely coupled.
-//
-// • 1 The table is loosely coupled.
-//
-// • 2 The table is loosely coupled and cannot be changed to another state using the Qlik Engine API.
-//
-// The last three values in the vector are for internal use.
-//
-// In case of circular references, the engine automatically sets the table state to loosely coupled to avoid creating loops.
-// Stability: locked
-func (obj *Doc) GetLooselyCoupledVector(ctx context.Context) ([]byte, error) {
-	result := &struct {
-		V []byte `json:"qv"`
-	}{}
-	err := obj.RPC(ctx, "GetLooselyCoupledVector", result)
-	return result.V, err
-}
-
-// Retrieves any fields that match all of the specified tags or just one of them in the data model of an app.
-// Tags set by Qlik Sense are prefixed by the $ sign.
-//
-// Parameters:
-//
-// ◾ tags                -   List of tags.
-// The GetMatchingFields method looks for fields that match one or all of the tags in this list, depending on the value of qMatchingFieldMode .
-//
-// ◾ matchingFieldMode   -   Matching field mode.
-// The default value is MATCHINGFIELDMODE_MATCH_ALL.
-//
-// One of:
-//
-// • MATCHINGFIELDMODE_MATCH_ALL
-//
-// • MATCHINGFIELDMODE_MATCH_ONE
-//
-// Stability: locked
-func (obj *Doc) GetMatchingFields(ctx context.Context, tags []string, matchingFieldMode string) ([]*NxMatchingFieldInfo, error) {
-	result := &struct {
-		FieldNames []*NxMatchingFieldInfo `json:"qFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "GetMatchingFields", result, tags, matchingFieldMode)
-	return result.FieldNames, err
-}
-
-// Retrieves any fields that match all of the specified tags or just one of them in the data model of an app.
-// Tags set by Qlik Sense are prefixed by the $ sign.
-//
-// Parameters:
-//
-// ◾ tags                -   List of tags.
-// The GetMatchingFields method looks for fields that match one or all of the tags in this list, depending on the value of qMatchingFieldMode .
-//
-// ◾ matchingFieldMode   -   Matching field mode.
-// The default value is MATCHINGFIELDMODE_MATCH_ALL.
-//
-// One of:
-//
-// • MATCHINGFIELDMODE_MATCH_ALL
-//
-// • MATCHINGFIELDMODE_MATCH_ONE
-//
-// Stability: locked
-func (obj *Doc) GetMatchingFieldsRaw(ctx context.Context, tags []string, matchingFieldMode string) (json.RawMessage, error) {
-	result := &struct {
-		FieldNames json.RawMessage `json:"qFieldNames"`
-	}{}
-	err := obj.RPC(ctx, "GetMatchingFields", result, tags, matchingFieldMode)
-	return result.FieldNames, err
-}
-
-// Returns the handle of a measure.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the measure.
-//
-// Stability: locked
-func (obj *Doc) GetMeasure(ctx context.Context, id string) (*GenericMeasure, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetMeasure", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericMeasure{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns the handle of a measure with a label.
-// If multiple measures has the same label the first is returned.
-//
-// Parameters:
-//
-// ◾ label   -   is the label of the measure to be returned.
-//
-// Stability: locked
-func (obj *Doc) GetMeasureWithLabel(ctx context.Context, label string) (*GenericMeasure, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetMeasureWithLabel", result, label)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericMeasure{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Lists the media files.
-// Deprecated: Use _GetLibraryContent_ method instead
-// Stability: locked
-func (obj *Doc) GetMediaList(ctx context.Context) (*MediaList, error) {
-	result := &struct {
-		List   *MediaList `json:"qList"`
-		Return bool       `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetMediaList", result)
-	return result.List, err
-}
-
-// Lists the media files.
-// Deprecated: Use _GetLibraryContent_ method instead
-// Stability: locked
-func (obj *Doc) GetMediaListRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		List   json.RawMessage `json:"qList"`
-		Return bool            `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetMediaList", result)
-	return result.List, err
-}
-
-// Returns the type of the app object and the corresponding handle.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object to retrieve.
-//
-// Stability: locked
-func (obj *Doc) GetObject(ctx context.Context, id string) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetObject", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns all objects compatible with options.
-//
-// Parameters:
-//
-// ◾ options   -   Object type filter and requested properties.
-//
-// Stability: locked
-func (obj *Doc) GetObjects(ctx context.Context, options *NxGetObjectOptions) ([]*NxContainerEntry, error) {
-	result := &struct {
-		List []*NxContainerEntry `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetObjects", result, options)
-	return result.List, err
-}
-
-// Returns all objects compatible with options.
-//
-// Parameters:
-//
-// ◾ options   -   Object type filter and requested properties.
-//
-// Stability: locked
-func (obj *Doc) GetObjectsRaw(ctx context.Context, options any) (json.RawMessage, error) {
-	result := &struct {
-		List json.RawMessage `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetObjects", result, options)
-	return result.List, err
-}
-
-// Get or create a generic object at app level with a specific Id and Type.
-// Id and Type are specified in the GenericObjectProperties passed in.
-// All other fields in this parameter serve as default properties.
-// If the object does not exist with that Id, it is created and initialized from the default properties.
-// If the object already exists, it is not changed. The properties passed in are not used.
-// The call will fail if the Id is already used for another purpose, such as for an object of a different Type.
-//
-// Parameters:
-//
-// ◾ prop   -   GenericObjectProperties with at least Info : { "qId": "<identifier of the new generic object>", "qType": "<type of the new generic object>" }
-//
-// Stability: experimental
-func (obj *Doc) GetOrCreateObject(ctx context.Context, prop *GenericObjectProperties) (*GenericObject, error) {
-	result := &struct {
-		NewObject bool             `json:"qNewObject"`
-		Return    *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetOrCreateObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Get or create a generic object at app level with a specific Id and Type.
-// Id and Type are specified in the GenericObjectProperties passed in.
-// All other fields in this parameter serve as default properties.
-// If the object does not exist with that Id, it is created and initialized from the default properties.
-// If the object already exists, it is not changed. The properties passed in are not used.
-// The call will fail if the Id is already used for another purpose, such as for an object of a different Type.
-//
-// Parameters:
-//
-// ◾ prop   -   GenericObjectProperties with at least Info : { "qId": "<identifier of the new generic object>", "qType": "<type of the new generic object>" }
-//
-// Stability: experimental
-func (obj *Doc) GetOrCreateObjectRaw(ctx context.Context, prop any) (*GenericObject, error) {
-	result := &struct {
-		NewObject bool             `json:"qNewObject"`
-		Return    *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetOrCreateObject", result, prop)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Gets values in script.
-// Stability: locked
-func (obj *Doc) GetScript(ctx context.Context) (string, error) {
-	result := &struct {
-		Script string `json:"qScript"`
-	}{}
-	err := obj.RPC(ctx, "GetScript", result)
-	return result.Script, err
-}
-
-// Lists the breakpoints in the script of an app.
-// Stability: locked
-func (obj *Doc) GetScriptBreakpoints(ctx context.Context) ([]*EditorBreakpoint, error) {
-	result := &struct {
-		Breakpoints []*EditorBreakpoint `json:"qBreakpoints"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptBreakpoints", result)
-	return result.Breakpoints, err
-}
-
-// Lists the breakpoints in the script of an app.
-// Stability: locked
-func (obj *Doc) GetScriptBreakpointsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Breakpoints json.RawMessage `json:"qBreakpoints"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptBreakpoints", result)
-	return result.Breakpoints, err
-}
-
-// Gets script and script meta-data.
-// Stability: locked
-func (obj *Doc) GetScriptEx(ctx context.Context) (*AppScript, error) {
-	result := &struct {
-		Script *AppScript `json:"qScript"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptEx", result)
-	return result.Script, err
-}
-
-// Gets script and script meta-data.
-// Stability: locked
-func (obj *Doc) GetScriptExRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Script json.RawMessage `json:"qScript"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptEx", result)
-	return result.Script, err
-}
-
-// Gets script meta-data.
-// Stability: experimental
-func (obj *Doc) GetScriptMeta(ctx context.Context) (*AppScriptMeta, error) {
-	result := &struct {
-		Meta *AppScriptMeta `json:"qMeta"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptMeta", result)
-	return result.Meta, err
-}
-
-// Gets script meta-data.
-// Stability: experimental
-func (obj *Doc) GetScriptMetaRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Meta json.RawMessage `json:"qMeta"`
-	}{}
-	err := obj.RPC(ctx, "GetScriptMeta", result)
-	return result.Meta, err
-}
-
-// Returns a set analysis expression from active selections or from a saved bookmark. Fields on the fly and Calculated dimensions will not be included in the generated expressions, instead a message indicating 'missing fields' will provided within the expression.
-//
-//	| | BookmarkId empty | BookmarkId set |
-//	|-----------------------|--------------------------------------|----------------------------------------------------|
-//	|StateName empty (or $) | Default selections state is returned.| Default state ($) in bookmark with id is returned. |
-//	|StateName set | State selections is returned. | State in bookmark with id is returned. |
-//
-// Parameters:
-//
-// ◾ stateName    -   Optional. The name of the state to get set analysis expression for. If left empty, the default state will be retrieved.
-//
-// ◾ bookmarkId   -   Optional. The Id of the bookmark to get the set analysis expression for. If left empty, the current selection will be retrieved.
-//
-// Stability: locked
-func (obj *Doc) GetSetAnalysis(ctx context.Context, stateName string, bookmarkId string) (string, error) {
-	result := &struct {
-		SetExpression string `json:"qSetExpression"`
-	}{}
-	err := obj.RPC(ctx, "GetSetAnalysis", result, stateName, bookmarkId)
-	return result.SetExpression, err
-}
-
-// Retrieves the data of a specific table.
-//
-// Parameters:
-//
-// ◾ offset          -   Position from the top, starting from 0.
-// If the offset is set to 0, the rows starting from the position/index 0 are shown.
-//
-// ◾ rows            -   Number of rows to show.
-//
-// ◾ syntheticMode   -   If this parameter is set to true, the internal data/table representation is shown. Synthetic fields are present (if any).
-//
-// ◾ tableName       -   Name of the table.
-//
-// Stability: locked
-func (obj *Doc) GetTableData(ctx context.Context, offset int, rows int, syntheticMode bool, tableName string) ([]*TableRow, error) {
-	result := &struct {
-		Data []*TableRow `json:"qData"`
-	}{}
-	err := obj.RPC(ctx, "GetTableData", result, offset, rows, syntheticMode, tableName)
-	return result.Data, err
-}
-
-// Retrieves the data of a specific table.
-//
-// Parameters:
-//
-// ◾ offset          -   Position from the top, starting from 0.
-// If the offset is set to 0, the rows starting from the position/index 0 are shown.
-//
-// ◾ rows            -   Number of rows to show.
-//
-// ◾ syntheticMode   -   If this parameter is set to true, the internal data/table representation is shown. Synthetic fields are present (if any).
-//
-// ◾ tableName       -   Name of the table.
-//
-// Stability: locked
-func (obj *Doc) GetTableDataRaw(ctx context.Context, offset int, rows int, syntheticMode bool, tableName string) (json.RawMessage, error) {
-	result := &struct {
-		Data json.RawMessage `json:"qData"`
-	}{}
-	err := obj.RPC(ctx, "GetTableData", result, offset, rows, syntheticMode, tableName)
-	return result.Data, err
-}
-
-// Returns profile data for a given table.
-//
-// Parameters:
-//
-// ◾ tableName   -   Name of the table
-//
-// Stability: experimental
-func (obj *Doc) GetTableProfileData(ctx context.Context, tableName string) (*TableProfilingData, error) {
-	result := &struct {
-		Profiling *TableProfilingData `json:"qProfiling"`
-	}{}
-	err := obj.RPC(ctx, "GetTableProfileData", result, tableName)
-	return result.Profiling, err
-}
-
-// Returns profile data for a given table.
-//
-// Parameters:
-//
-// ◾ tableName   -   Name of the table
-//
-// Stability: experimental
-func (obj *Doc) GetTableProfileDataRaw(ctx context.Context, tableName string) (json.RawMessage, error) {
-	result := &struct {
-		Profiling json.RawMessage `json:"qProfiling"`
-	}{}
-	err := obj.RPC(ctx, "GetTableProfileData", result, tableName)
-	return result.Profiling, err
-}
-
-// Returns:
-//
-// • The list of tables in an app and the fields inside each table.
-//
-// • The list of derived fields.
-//
-// • The list of key fields.
-//
-// Parameters:
-//
-// ◾ windowSize         -   Size of the window that is used to display the results.
-//
-// ◾ nullSize           -
-//
-// ◾ cellHeight         -   Height of a cell in a table in pixels.
-//
-// ◾ syntheticMode      -   One of:
-//
-// • true for internal table viewer:
-// Shows a more detailed view on how the Qlik engine defines the relations between fields and the quality of the keys.
-//
-// • false for source table viewer:
-// Shows the natural relation between fields without reference to synthetic keys and resultant linking synthetic tables. Instead synthetic keys are represented by multiple connectors between tables.
-//
-// ◾ includeSysVars     -   If set to true, the system variables are included.
-//
-// ◾ includeProfiling   -   If set to true, profiling information is included.
-//
-// Stability: locked
-func (obj *Doc) GetTablesAndKeys(ctx context.Context, windowSize *Size, nullSize *Size, cellHeight int, syntheticMode bool, includeSysVars bool, includeProfiling bool) ([]*TableRecord, []*SourceKeyRecord, error) {
-	result := &struct {
-		Tr []*TableRecord     `json:"qtr"`
-		K  []*SourceKeyRecord `json:"qk"`
-	}{}
-	err := obj.RPC(ctx, "GetTablesAndKeys", result, windowSize, nullSize, cellHeight, syntheticMode, includeSysVars, includeProfiling)
-	return result.Tr, result.K, err
-}
-
-// Returns:
-//
-// • The list of tables in an app and the fields inside each table.
-//
-// • The list of derived fields.
-//
-// • The list of key fields.
-//
-// Parameters:
-//
-// ◾ windowSize         -   Size of the window that is used to display the results.
-//
-// ◾ nullSize           -
-//
-// ◾ cellHeight         -   Height of a cell in a table in pixels.
-//
-// ◾ syntheticMode      -   One of:
-//
-// • true for internal table viewer:
-// Shows a more detailed view on how the Qlik engine defines the relations between fields and the quality of the keys.
-//
-// • false for source table viewer:
-// Shows the natural relation between fields without reference to synthetic keys and resultant linking synthetic tables. Instead synthetic keys are represented by multiple connectors between tables.
-//
-// ◾ includeSysVars     -   If set to true, the system variables are included.
-//
-// ◾ includeProfiling   -   If set to true, profiling information is included.
-//
-// Stability: locked
-func (obj *Doc) GetTablesAndKeysRaw(ctx context.Context, windowSize any, nullSize any, cellHeight int, syntheticMode bool, includeSysVars bool, includeProfiling bool) (json.RawMessage, json.RawMessage, error) {
-	result := &struct {
-		Tr json.RawMessage `json:"qtr"`
-		K  json.RawMessage `json:"qk"`
-	}{}
-	err := obj.RPC(ctx, "GetTablesAndKeys", result, windowSize, nullSize, cellHeight, syntheticMode, includeSysVars, includeProfiling)
-	return result.Tr, result.K, err
-}
-
-// Fetches updated variables after a statement execution.
-//
-// If qRefSeqNo and qSetSeqNo are set to 0, it means that the variables were not updated.
-// Stability: locked
-func (obj *Doc) GetTextMacros(ctx context.Context) ([]*TextMacro, error) {
-	result := &struct {
-		Macros []*TextMacro `json:"qMacros"`
-	}{}
-	err := obj.RPC(ctx, "GetTextMacros", result)
-	return result.Macros, err
-}
-
-// Fetches updated variables after a statement execution.
-//
-// If qRefSeqNo and qSetSeqNo are set to 0, it means that the variables were not updated.
-// Stability: locked
-func (obj *Doc) GetTextMacrosRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Macros json.RawMessage `json:"qMacros"`
-	}{}
-	err := obj.RPC(ctx, "GetTextMacros", result)
-	return result.Macros, err
-}
-
-// Returns a handle to a variable.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable.
-//
-// Deprecated: Use _Doc::GetVariableById_ method or _Doc::GetVariableByName_ method instead
-// Stability: locked
-func (obj *Doc) GetVariable(ctx context.Context, name string) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetVariable", result, name)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Gets the handle of a variable.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the variable.
-//
-// Stability: locked
-func (obj *Doc) GetVariableById(ctx context.Context, id string) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetVariableById", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Gets the handle of a variable.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable.
-//
-// Stability: locked
-func (obj *Doc) GetVariableByName(ctx context.Context, name string) (*GenericVariable, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetVariableByName", result, name)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericVariable{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Stability: locked
-func (obj *Doc) GetVariables(ctx context.Context, listDef *VariableListDef) ([]*NxVariableListItem, error) {
-	result := &struct {
-		List []*NxVariableListItem `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetVariables", result, listDef)
-	return result.List, err
-}
-
-// Stability: locked
-func (obj *Doc) GetVariablesRaw(ctx context.Context, listDef any) (json.RawMessage, error) {
-	result := &struct {
-		List json.RawMessage `json:"qList"`
-	}{}
-	err := obj.RPC(ctx, "GetVariables", result, listDef)
-	return result.List, err
-}
-
-// Returns information about the position of the tables in the data model viewer.
-// The position of the broom points and the position of the connection points cannot be retrieved in Qlik Sense.
-// Stability: locked
-func (obj *Doc) GetViewDlgSaveInfo(ctx context.Context) (*TableViewDlgSaveInfo, error) {
-	result := &struct {
-		Return *TableViewDlgSaveInfo `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetViewDlgSaveInfo", result)
-	return result.Return, err
-}
-
-// Returns information about the position of the tables in the data model viewer.
-// The position of the broom points and the position of the connection points cannot be retrieved in Qlik Sense.
-// Stability: locked
-func (obj *Doc) GetViewDlgSaveInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Return json.RawMessage `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetViewDlgSaveInfo", result)
-	return result.Return, err
-}
-
-// Guesses the data format for a given file.
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection file.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// Stability: locked
-func (obj *Doc) GuessFileType(ctx context.Context, connectionId string, relativePath string) (*FileDataFormat, error) {
-	result := &struct {
-		DataFormat *FileDataFormat `json:"qDataFormat"`
-	}{}
-	err := obj.RPC(ctx, "GuessFileType", result, connectionId, relativePath)
-	return result.DataFormat, err
-}
-
-// Guesses the data format for a given file.
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// FileType:
-//
-// Recognized file formats are:
-//
-// • CSV for Delimited
-//
-// • FIX for Fixed Record
-//
-// • DIF for Data Interchange Format
-//
-// • EXCELBIFF_ for Microsoft Excel (XLS)
-//
-// • EXCELOOXML_ for Microsoft Excel (XLSX)
-//
-// • HTML for HTML
-//
-// • QVD for QVD file
-//
-// • XML for XML
-//
-// • QVX for QVX file
-//
-// • JSON for JSON format
-//
-// • KML for KML file
-//
-// • PARQUET for PARQUET file
-//
-// Parameters:
-//
-// ◾ connectionId   -   Identifier of the connection file.
-//
-// ◾ relativePath   -   Path of the connection file.
-//
-// Stability: locked
-func (obj *Doc) GuessFileTypeRaw(ctx context.Context, connectionId string, relativePath string) (json.RawMessage, error) {
-	result := &struct {
-		DataFormat json.RawMessage `json:"qDataFormat"`
-	}{}
-	err := obj.RPC(ctx, "GuessFileType", result, connectionId, relativePath)
-	return result.DataFormat, err
-}
-
-// Locks all selections in fields for current state.
-//
-// Parameters:
-//
-// ◾ stateName   -   Alternate state name. When set, applies to alternate state instead of current.
-//
-// Stability: locked
-func (obj *Doc) LockAll(ctx context.Context, stateName string) error {
-	err := obj.RPC(ctx, "LockAll", nil, stateName)
-	return err
-}
-
-// Updates a connection.
-// The identifier of a connection cannot be updated. qType cannot be modified with the ModifyConnection method.
-//
-// Parameters:
-//
-// ◾ connectionId          -   Identifier of the connection.
-//
-// ◾ connection            -   Information about the connection.
-// Properties that can be updated.
-//
-// ◾ overrideCredentials   -   Set this parameter to true to override the user name and password.
-//
-// Stability: locked
-func (obj *Doc) ModifyConnection(ctx context.Context, connectionId string, connection *Connection, overrideCredentials bool) error {
-	err := obj.RPC(ctx, "ModifyConnection", nil, connectionId, connection, overrideCredentials)
-	return err
-}
-
-// Updates a connection.
-// The identifier of a connection cannot be updated. qType cannot be modified with the ModifyConnection method.
-//
-// Parameters:
-//
-// ◾ connectionId          -   Identifier of the connection.
-//
-// ◾ connection            -   Information about the connection.
-// Properties that can be updated.
-//
-// ◾ overrideCredentials   -   Set this parameter to true to override the user name and password.
-//
-// Stability: locked
-func (obj *Doc) ModifyConnectionRaw(ctx context.Context, connectionId string, connection any, overrideCredentials bool) error {
-	err := obj.RPC(ctx, "ModifyConnection", nil, connectionId, connection, overrideCredentials)
-	return err
-}
-
-// Publishes an app.
-// All app objects are published. Generic objects, bookmarks, dimensions and measures inside the app are published.
-// An app can only be published once and cannot be published to more than one stream.
-//
-// Parameters:
-//
-// ◾ streamId   -   Identifier of the stream.
-//
-// ◾ name       -   Name of the published app.
-// If this parameter is not set, the engine automatically gives a new name to the published app.
-//
-// Stability: locked
-func (obj *Doc) Publish(ctx context.Context, streamId string, name string) error {
-	err := obj.RPC(ctx, "Publish", nil, streamId, name)
-	return err
-}
-
-// Redoes the previous operation.
-//
-// The operation is successful if qSuccess is set to true.
-// Stability: locked
-func (obj *Doc) Redo(ctx context.Context) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "Redo", result)
-	return result.Success, err
-}
-
-// Removes an alternate state in the app.
-//
-// Parameters:
-//
-// ◾ stateName   -   Name of the alternate state.
-//
-// Stability: locked
-func (obj *Doc) RemoveAlternateState(ctx context.Context, stateName string) error {
-	err := obj.RPC(ctx, "RemoveAlternateState", nil, stateName)
-	return err
-}
-
-// Removes an session alternate state in the app.
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ stateName   -   Name of the alternate state.
-//
-// Stability: locked
-func (obj *Doc) RemoveSessionAlternateState(ctx context.Context, stateName string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "RemoveSessionAlternateState", result, stateName)
-	return result.Success, err
-}
-
-// Removes a variable.
-//
-// Parameters:
-//
-// ◾ name   -   Name of the variable. Variable names are case sensitive.
-//
-// Deprecated: Use _Doc::DestroyVariableById_ method or _Doc::DestroyVariableByName_ method instead
-// Stability: locked
-func (obj *Doc) RemoveVariable(ctx context.Context, name string) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "RemoveVariable", result, name)
-	return result.Return, err
-}
-
-// Replace a bookmark. Optional inparams to change the original bookmarks properties, original are kept if left out.
-//
-// Parameters:
-//
-// ◾ id                 -   Identifier of the bookmark.
-//
-// ◾ ignorePatches      -   Set to true to exclude patches from the bookmark. Default is false.
-//
-// ◾ objectIdsToPatch   -   Add softpatches for this objects if available. If empty all softpatches are added to the bookmark. Ignored if IgnorePatches is set to true.
-//
-// Stability: locked
-func (obj *Doc) ReplaceBookmark(ctx context.Context, id string, ignorePatches bool, objectIdsToPatch []string) (*GenericBookmark, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ReplaceBookmark", result, id, ignorePatches, objectIdsToPatch)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericBookmark{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Restore a temporary selection state identified by Id.
-// RestoreTempSelectionState method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the temporary selection state
-//
-// Stability: locked
-func (obj *Doc) RestoreTempSelectionState(ctx context.Context, id string) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "RestoreTempSelectionState", result, id)
-	return result.Return, err
-}
-
-// Resumes the app as the user left it.
-// Stability: locked
-func (obj *Doc) Resume(ctx context.Context) error {
-	err := obj.RPC(ctx, "Resume", nil)
-	return err
-}
-
-// Save a copy of an app with a different name.
-// Can be used to save a session app as an ordinary app.
-//
-// Parameters:
-//
-// ◾ newAppName   -   <Name of the saved app>
-//
-// Stability: locked
-func (obj *Doc) SaveAs(ctx context.Context, newAppName string) (string, error) {
-	result := &struct {
-		NewAppId string `json:"qNewAppId"`
-	}{}
-	err := obj.RPC(ctx, "SaveAs", result, newAppName)
-	return result.NewAppId, err
-}
-
-// Saves all objects that were modified in the app.
-// Data from the data model are not saved.
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *Doc) SaveObjects(ctx context.Context) error {
-	err := obj.RPC(ctx, "SaveObjects", nil)
-	return err
-}
-
-// Scrambles a field so the data is not recognizable. Some properties are retained to help debugging. For example, special characters are not changed, and small numbers are scrambled to another small number.
-// Update access is required to use the function in Qlik Sense Enterprise.
-//
-// Parameters:
-//
-// ◾ fieldName   -   Name of the field to scramble.
-//
-// Stability: locked
-func (obj *Doc) Scramble(ctx context.Context, fieldName string) error {
-	err := obj.RPC(ctx, "Scramble", nil, fieldName)
-	return err
-}
-
-// Returns the search matches for one or more search terms.
-// The search results depend on the search context.
-// _SearchCombinationOptions_
-//
-// SearchMatchCombinations:
-//
-//	+--------------------------+-------------------------------+------------------------+
-//	|           NAME           |          DESCRIPTION          |          TYPE          |
-//	+--------------------------+-------------------------------+------------------------+
-//	| qSearchMatchCombinations | Array of search combinations. | Array of               |
-//	|                          |                               | SearchMatchCombination |
-//	|                          |                               |                        |
-//	+--------------------------+-------------------------------+------------------------+
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search fields and the search context.
-//
-// ◾ terms     -   List of terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Deprecated: Use _SearchResults_ method instead
-// Stability: locked
-func (obj *Doc) SearchAssociations(ctx context.Context, options *SearchCombinationOptions, terms []string, page *SearchPage) (*SearchAssociationResult, error) {
-	result := &struct {
-		Results *SearchAssociationResult `json:"qResults"`
-	}{}
-	err := obj.RPC(ctx, "SearchAssociations", result, options, terms, page)
-	return result.Results, err
-}
-
-// Returns the search matches for one or more search terms.
-// The search results depend on the search context.
-// _SearchCombinationOptions_
-//
-// SearchMatchCombinations:
-//
-//	+--------------------------+-------------------------------+------------------------+
-//	|           NAME           |          DESCRIPTION          |          TYPE          |
-//	+--------------------------+-------------------------------+------------------------+
-//	| qSearchMatchCombinations | Array of search combinations. | Array of               |
-//	|                          |                               | SearchMatchCombination |
-//	|                          |                               |                        |
-//	+--------------------------+-------------------------------+------------------------+
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search fields and the search context.
-//
-// ◾ terms     -   List of terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Deprecated: Use _SearchResults_ method instead
-// Stability: locked
-func (obj *Doc) SearchAssociationsRaw(ctx context.Context, options any, terms []string, page any) (json.RawMessage, error) {
-	result := &struct {
-		Results json.RawMessage `json:"qResults"`
-	}{}
-	err := obj.RPC(ctx, "SearchAssociations", result, options, terms, page)
-	return result.Results, err
-}
-
-// Returns the generic objects corresponding to one or more search terms. The search is performed within the title, subtitle, footnote and type. In addition, associated dimension values are also searched in. For example, if the country “Japan” is selected and the object contains the dimension City, the object will appear in the results for “Osaka” but not for “Johannesburg”. The generic objects with the following types will never appear in the results: slideitem , sheet , story , slide , masterobject , snapshot , LoadModel , appprops and searchhistory .
-//
-// Parameters:
-//
-// ◾ options   -   Information about attributes.
-//
-// ◾ terms     -   Terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *Doc) SearchObjects(ctx context.Context, options *SearchObjectOptions, terms []string, page *SearchPage) (*SearchResult, error) {
-	result := &struct {
-		Result *SearchResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchObjects", result, options, terms, page)
-	return result.Result, err
-}
-
-// Returns the generic objects corresponding to one or more search terms. The search is performed within the title, subtitle, footnote and type. In addition, associated dimension values are also searched in. For example, if the country “Japan” is selected and the object contains the dimension City, the object will appear in the results for “Osaka” but not for “Johannesburg”. The generic objects with the following types will never appear in the results: slideitem , sheet , story , slide , masterobject , snapshot , LoadModel , appprops and searchhistory .
-//
-// Parameters:
-//
-// ◾ options   -   Information about attributes.
-//
-// ◾ terms     -   Terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *Doc) SearchObjectsRaw(ctx context.Context, options any, terms []string, page any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchObjects", result, options, terms, page)
-	return result.Result, err
-}
-
-// Returns the search matches for one or more search terms.
-// Search results are organized in search groups. The type of search group indicates where the search matches come from (from data for example).
-// Each search group contains search results that correspond to a combination of search terms.
-// For example, if the search terms are organic , pasta , and America , the possible combination of search groups are:
-//
-// • organic
-//
-// • pasta
-//
-// • America
-//
-// • organic, pasta, America
-//
-// • organic, pasta
-//
-// • organic, America
-//
-// • pasta, America
-//
-// For every search group, there are one or more search group items. Each subgroup item contains results that correspond to an item type (for example a field).
-// For every search group item, there are one or several search matches. The position of the match in each search result is given.
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search combinations.
-//
-// ◾ terms     -   Terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *Doc) SearchResults(ctx context.Context, options *SearchCombinationOptions, terms []string, page *SearchPage) (*SearchResult, error) {
-	result := &struct {
-		Result *SearchResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchResults", result, options, terms, page)
-	return result.Result, err
-}
-
-// Returns the search matches for one or more search terms.
-// Search results are organized in search groups. The type of search group indicates where the search matches come from (from data for example).
-// Each search group contains search results that correspond to a combination of search terms.
-// For example, if the search terms are organic , pasta , and America , the possible combination of search groups are:
-//
-// • organic
-//
-// • pasta
-//
-// • America
-//
-// • organic, pasta, America
-//
-// • organic, pasta
-//
-// • organic, America
-//
-// • pasta, America
-//
-// For every search group, there are one or more search group items. Each subgroup item contains results that correspond to an item type (for example a field).
-// For every search group item, there are one or several search matches. The position of the match in each search result is given.
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search combinations.
-//
-// ◾ terms     -   Terms to search for.
-//
-// ◾ page      -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *Doc) SearchResultsRaw(ctx context.Context, options any, terms []string, page any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchResults", result, options, terms, page)
-	return result.Result, err
-}
-
-// Returns search terms suggestions.
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search combinations.
-//
-// ◾ terms     -   Terms to search for.
-//
-// Stability: locked
-func (obj *Doc) SearchSuggest(ctx context.Context, options *SearchCombinationOptions, terms []string) (*SearchSuggestionResult, error) {
-	result := &struct {
-		Result *SearchSuggestionResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchSuggest", result, options, terms)
-	return result.Result, err
-}
-
-// Returns search terms suggestions.
-//
-// Parameters:
-//
-// ◾ options   -   Information about the search combinations.
-//
-// ◾ terms     -   Terms to search for.
-//
-// Stability: locked
-func (obj *Doc) SearchSuggestRaw(ctx context.Context, options any, terms []string) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchSuggest", result, options, terms)
-	return result.Result, err
-}
-
-// Stability: experimental
-func (obj *Doc) SearchValues(ctx context.Context, options *SearchValueOptions, terms []string, page *SearchValuePage) (*SearchValueResult, error) {
-	result := &struct {
-		Result *SearchValueResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchValues", result, options, terms, page)
-	return result.Result, err
-}
-
-// Stability: experimental
-func (obj *Doc) SearchValuesRaw(ctx context.Context, options any, terms []string, page any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SearchValues", result, options, terms, page)
-	return result.Result, err
-}
-
-// Selects all search hits for a specified group.
-// The results depend on the search context.
-// _SearchCombinationOptions_.
-//
-// Parameters:
-//
-// ◾ options    -   Information about the search fields and the search context.
-//
-// ◾ terms      -   List of terms to search for.
-//
-// ◾ matchIx    -   Index (value of qId ) of the search result to select.
-//
-// ◾ softLock   -   This parameter is deprecated and should not be set.
-//
-// Stability: locked
-func (obj *Doc) SelectAssociations(ctx context.Context, options *SearchCombinationOptions, terms []string, matchIx int, softLock bool) error {
-	err := obj.RPC(ctx, "SelectAssociations", nil, options, terms, matchIx, softLock)
-	return err
-}
-
-// Selects all search hits for a specified group.
-// The results depend on the search context.
-// _SearchCombinationOptions_.
-//
-// Parameters:
-//
-// ◾ options    -   Information about the search fields and the search context.
-//
-// ◾ terms      -   List of terms to search for.
-//
-// ◾ matchIx    -   Index (value of qId ) of the search result to select.
-//
-// ◾ softLock   -   This parameter is deprecated and should not be set.
-//
-// Stability: locked
-func (obj *Doc) SelectAssociationsRaw(ctx context.Context, options any, terms []string, matchIx int, softLock bool) error {
-	err := obj.RPC(ctx, "SelectAssociations", nil, options, terms, matchIx, softLock)
-	return err
-}
-
-// Sends a generic command to a custom connector.
-// For more information on the commands that can be sent to a custom connector, see the QVX SDK help.
-//
-// Parameters:
-//
-// ◾ provider           -   Connector file name.
-// Command to be executed by the connector.
-//
-// ◾ command            -   One of:
-//
-// • JsonRequest
-//
-// • GetCustomCaption
-//
-// • IsConnected
-//
-// • DisableQlikViewSelectButton
-//
-// • HaveStarField
-//
-// ◾ method             -   Method name to be used within the command.
-// The available methods depend on the chosen connector.
-//
-// ◾ parameters         -   Parameters of the command.
-// No parameters are required.
-//
-// ◾ appendConnection   -   Name of the connection.
-//
-// Stability: locked
-func (obj *Doc) SendGenericCommandToCustomConnector(ctx context.Context, provider string, command string, method string, parameters []string, appendConnection string) (string, error) {
-	result := &struct {
-		Result string `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "SendGenericCommandToCustomConnector", result, provider, command, method, parameters, appendConnection)
-	return result.Result, err
-}
-
-// Sets properties to an app.
-// The qLastReloadTime, qMigrationHash and qSavedInProductVersion properties does not need to be set but if they are, they should match the current values in the app layout.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties of an app.
-//
-// Stability: locked
-func (obj *Doc) SetAppProperties(ctx context.Context, prop *NxAppProperties) error {
-	err := obj.RPC(ctx, "SetAppProperties", nil, prop)
-	return err
-}
-
-// Sets properties to an app.
-// The qLastReloadTime, qMigrationHash and qSavedInProductVersion properties does not need to be set but if they are, they should match the current values in the app layout.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the properties of an app.
-//
-// Stability: locked
-func (obj *Doc) SetAppPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetAppProperties", nil, prop)
-	return err
-}
-
-// Set some variables as favorite.
-//
-// Parameters:
-//
-// ◾ names   -   Variables to set as favorite.
-//
-// Stability: locked
-func (obj *Doc) SetFavoriteVariables(ctx context.Context, names []string) error {
-	err := obj.RPC(ctx, "SetFavoriteVariables", nil, names)
-	return err
-}
-
-// Limits the number of rows of data to load from a data source.
-// This method works when reloading in debug mode.
-//
-// Parameters:
-//
-// ◾ limit   -   Fetch limit.
-// Number of rows to load.
-//
-// Stability: locked
-func (obj *Doc) SetFetchLimit(ctx context.Context, limit int) error {
-	err := obj.RPC(ctx, "SetFetchLimit", nil, limit)
-	return err
-}
-
-// Sets a list of table states, one for each table.
-//
-// The following states apply:
-//
-// • 0 The table is not loosely coupled.
-//
-// • 1 The table is loosely coupled.
-//
-// • 2 The table is loosely coupled and cannot be changed to another state using the Qlik Engine API.
-//
-// The last three values in the vector are for internal use.
-//
-// Parameters:
-//
-// ◾ v   -   The list of table states to set. A state will not be changed if already set to 2.
-//
-// Stability: locked
-func (obj *Doc) SetLooselyCoupledVector(ctx context.Context, v []byte) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SetLooselyCoupledVector", result, v)
-	return result.Return, err
-}
-
-// Prohibit binary load of this app.
-// An app with prohibit binary load set cannot be loaded binary. For the setting to have effect a save is required.
-//
-// Parameters:
-//
-// ◾ prohibit   -   True or false.
-//
-// Stability: locked
-func (obj *Doc) SetProhibitBinaryLoad(ctx context.Context, prohibit bool) error {
-	err := obj.RPC(ctx, "SetProhibitBinaryLoad", nil, prohibit)
-	return err
-}
-
-// Sets values in script.
-//
-// Parameters:
-//
-// ◾ script   -   Script content.
-//
-// Stability: locked
-func (obj *Doc) SetScript(ctx context.Context, script string) error {
-	err := obj.RPC(ctx, "SetScript", nil, script)
-	return err
-}
-
-// Set some breakpoints in the script of an app.
-//
-// Parameters:
-//
-// ◾ breakpoints   -   Information about the breakpoints.
-//
-// Stability: locked
-func (obj *Doc) SetScriptBreakpoints(ctx context.Context, breakpoints []*EditorBreakpoint) error {
-	err := obj.RPC(ctx, "SetScriptBreakpoints", nil, breakpoints)
-	return err
-}
-
-// Set some breakpoints in the script of an app.
-//
-// Parameters:
-//
-// ◾ breakpoints   -   Information about the breakpoints.
-//
-// Stability: locked
-func (obj *Doc) SetScriptBreakpointsRaw(ctx context.Context, breakpoints any) error {
-	err := obj.RPC(ctx, "SetScriptBreakpoints", nil, breakpoints)
-	return err
-}
-
-// Sets the positions of the tables in the data model viewer.
-// The position of the broom points and the position of the connection points cannot be set in Qlik Sense.
-//
-// Parameters:
-//
-// ◾ info   -   Information about the table.
-//
-// Stability: locked
-func (obj *Doc) SetViewDlgSaveInfo(ctx context.Context, info *TableViewDlgSaveInfo) error {
-	err := obj.RPC(ctx, "SetViewDlgSaveInfo", nil, info)
-	return err
-}
-
-// Sets the positions of the tables in the data model viewer.
-// The position of the broom points and the position of the connection points cannot be set in Qlik Sense.
-//
-// Parameters:
-//
-// ◾ info   -   Information about the table.
-//
-// Stability: locked
-func (obj *Doc) SetViewDlgSaveInfoRaw(ctx context.Context, info any) error {
-	err := obj.RPC(ctx, "SetViewDlgSaveInfo", nil, info)
-	return err
-}
-
-// Store current selection state temporarily.
-// The temporary selection state will be stored for 30min by default if TTL parameter is not present or positive.
-//
-// StoreTempSelectionState method is only supported in SaaS Editions of Qlik Sense.
-//
-// Parameters:
-//
-// ◾ tTLOfTempState   -   Time to live in seconds for stored selection state
-//
-// Stability: locked
-func (obj *Doc) StoreTempSelectionState(ctx context.Context, tTLOfTempState int) (string, bool, error) {
-	result := &struct {
-		Id     string `json:"qId"`
-		Return bool   `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "StoreTempSelectionState", result, tTLOfTempState)
-	return result.Id, result.Return, err
-}
-
-// Transform current app into an instance of the targeted mode
-//
-// Parameters:
-//
-// ◾ dstParameters   -   Attributes that should be set in the new app.
-//
-// Stability: locked
-func (obj *Doc) TransformApp(ctx context.Context, dstParameters *TransformAppParameters) (*TransformAppResult, error) {
-	result := &struct {
-		Result *TransformAppResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "TransformApp", result, dstParameters)
-	return result.Result, err
-}
-
-// Transform current app into an instance of the targeted mode
-//
-// Parameters:
-//
-// ◾ dstParameters   -   Attributes that should be set in the new app.
-//
-// Stability: locked
-func (obj *Doc) TransformAppRaw(ctx context.Context, dstParameters any) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "TransformApp", result, dstParameters)
-	return result.Result, err
-}
-
-// Undoes the previous operation.
-//
-// The operation is successful if qSuccess is set to true.
-// Stability: locked
-func (obj *Doc) Undo(ctx context.Context) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "Undo", result)
-	return result.Success, err
-}
-
-// Unlocks all selections in fields for current state.
-//
-// Parameters:
-//
-// ◾ stateName   -   Alternate state name. When set, applies to alternate state instead of current.
-//
-// Stability: locked
-func (obj *Doc) UnlockAll(ctx context.Context, stateName string) error {
-	err := obj.RPC(ctx, "UnlockAll", nil, stateName)
-	return err
-}
-
-type Field struct {
-	*RemoteObject
-}
-
-// Clears the selections in a specific field.
-// Stability: locked
-func (obj *Field) Clear(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "Clear", result)
-	return result.Return, err
-}
-
-// Maintains the selections in the current field while clearing the selections in the other fields.
-//
-// Parameters:
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) ClearAllButThis(ctx context.Context, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ClearAllButThis", result, softLock)
-	return result.Return, err
-}
-
-// Returns the AND mode status of a field.
-// Stability: locked
-func (obj *Field) GetAndMode(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetAndMode", result)
-	return result.Return, err
-}
-
-// Retrieves the number of distinct values in a field.
-// Stability: locked
-func (obj *Field) GetCardinal(ctx context.Context) (int, error) {
-	result := &struct {
-		Return int `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetCardinal", result)
-	return result.Return, err
-}
-
-// Gets the properties of a field.
-//
-// The property OneAndOnlyOne is set to true if one and only value has been selected in the field prior setting the property.
-// Stability: locked
-func (obj *Field) GetNxProperties(ctx context.Context) (*NxFieldProperties, error) {
-	result := &struct {
-		Properties *NxFieldProperties `json:"qProperties"`
-	}{}
-	err := obj.RPC(ctx, "GetNxProperties", result)
-	return result.Properties, err
-}
-
-// Gets the properties of a field.
-//
-// The property OneAndOnlyOne is set to true if one and only value has been selected in the field prior setting the property.
-// Stability: locked
-func (obj *Field) GetNxPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Properties json.RawMessage `json:"qProperties"`
-	}{}
-	err := obj.RPC(ctx, "GetNxProperties", result)
-	return result.Properties, err
-}
-
-// Locks all selected values of a specific field.
-// Stability: locked
-func (obj *Field) Lock(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "Lock", result)
-	return result.Return, err
-}
-
-// Selects some values in a field, by entering the element numbers related to the values to select.
-//
-// Parameters:
-//
-// ◾ values       -   Indexes (or element numbers) of the values to select.
-//
-// ◾ toggleMode   -   Set to true to keep any selections present in the list object.
-// If this parameter is set to false, selections made before accepting the list object search become alternative.
-//
-// ◾ softLock     -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) LowLevelSelect(ctx context.Context, values []int, toggleMode bool, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "LowLevelSelect", result, values, toggleMode, softLock)
-	return result.Return, err
-}
-
-// Selects field values matching a search string.
-//
-// Parameters:
-//
-// ◾ match                -   String to search for.
-// Can contain wild cards or numeric search criteria.
-// The characters <>"~()='[] have pre-defined meanings when used at the start of the string.
-// To use them, EnableSpecialCharacterEscapingInSearch needs to be enabled and the delimiter \ needs to precede the special character.
-//
-// ◾ softLock             -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// ◾ excludedValuesMode   -   Include excluded values in search.
-//
-// Stability: locked
-func (obj *Field) Select(ctx context.Context, match string, softLock bool, excludedValuesMode int) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "Select", result, match, softLock, excludedValuesMode)
-	return result.Return, err
-}
-
-// Selects all values of a field. Excluded values are also selected.
-//
-// Parameters:
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectAll(ctx context.Context, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectAll", result, softLock)
-	return result.Return, err
-}
-
-// Selects all alternatives values in a specific field.
-// In a field that contains at least one selected value, the values that are neither selected nor excluded are alternatives values.
-//
-// Parameters:
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectAlternative(ctx context.Context, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectAlternative", result, softLock)
-	return result.Return, err
-}
-
-// Inverts the current selections.
-//
-// Parameters:
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectExcluded(ctx context.Context, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectExcluded", result, softLock)
-	return result.Return, err
-}
-
-// Selects all possible values in a specific field.
-//
-// Parameters:
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectPossible(ctx context.Context, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectPossible", result, softLock)
-	return result.Return, err
-}
-
-// Selects some values in a field, by entering the values to select.
-//
-// Parameters:
-//
-// ◾ fieldValues   -   List of the values to select.
-//
-// ◾ toggleMode    -   The default value is false.
-//
-// ◾ softLock      -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectValues(ctx context.Context, fieldValues []*FieldValue, toggleMode bool, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectValues", result, fieldValues, toggleMode, softLock)
-	return result.Return, err
-}
-
-// Selects some values in a field, by entering the values to select.
-//
-// Parameters:
-//
-// ◾ fieldValues   -   List of the values to select.
-//
-// ◾ toggleMode    -   The default value is false.
-//
-// ◾ softLock      -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Field) SelectValuesRaw(ctx context.Context, fieldValues any, toggleMode bool, softLock bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SelectValues", result, fieldValues, toggleMode, softLock)
-	return result.Return, err
-}
-
-// Sets a field in the AND mode.
-//
-// Parameters:
-//
-// ◾ andMode   -   Specifies if the AND mode applies to the field.
-// Set this parameter to true to enter the AND mode.
-//
-// Stability: locked
-func (obj *Field) SetAndMode(ctx context.Context, andMode bool) error {
-	err := obj.RPC(ctx, "SetAndMode", nil, andMode)
-	return err
-}
-
-// Sets some properties to a field.
-//
-// Parameters:
-//
-// ◾ properties   -   Information about the properties of the field.
-//
-// Stability: locked
-func (obj *Field) SetNxProperties(ctx context.Context, properties *NxFieldProperties) error {
-	err := obj.RPC(ctx, "SetNxProperties", nil, properties)
-	return err
-}
-
-// Sets some properties to a field.
-//
-// Parameters:
-//
-// ◾ properties   -   Information about the properties of the field.
-//
-// Stability: locked
-func (obj *Field) SetNxPropertiesRaw(ctx context.Context, properties any) error {
-	err := obj.RPC(ctx, "SetNxProperties", nil, properties)
-	return err
-}
-
-// Toggle selects field values matching a search string.
-//
-// Parameters:
-//
-// ◾ match                -   String to search for.
-// Can contain wild cards or numeric search criteria.
-//
-// ◾ softLock             -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// ◾ excludedValuesMode   -   Include excluded values in search.
-//
-// Stability: locked
-func (obj *Field) ToggleSelect(ctx context.Context, match string, softLock bool, excludedValuesMode int) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ToggleSelect", result, match, softLock, excludedValuesMode)
-	return result.Return, err
-}
-
-// Unlocks all selected values of a specific field if the target (or handle ) is a field.
-// Stability: locked
-func (obj *Field) Unlock(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "Unlock", result)
-	return result.Return, err
-}
-
-type GenericBookmark struct {
-	*RemoteObject
-}
-
-// Applies a bookmark.
-//
-// The operation is successful if qSuccess is set to true.
-// Stability: locked
-func (obj *GenericBookmark) Apply(ctx context.Context) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "Apply", result)
-	return result.Success, err
-}
-
-// Applies a bookmark and verify result dataset against originally selected values.
-//
-// The operation is successful if qApplySuccess is set to true. qWarnings lists state and field with unmatching values
-// Stability: experimental
-func (obj *GenericBookmark) ApplyAndVerify(ctx context.Context) (*BookmarkApplyAndVerifyResult, error) {
-	result := &struct {
-		Result *BookmarkApplyAndVerifyResult `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyAndVerify", result)
-	return result.Result, err
-}
-
-// Applies a bookmark and verify result dataset against originally selected values.
-//
-// The operation is successful if qApplySuccess is set to true. qWarnings lists state and field with unmatching values
-// Stability: experimental
-func (obj *GenericBookmark) ApplyAndVerifyRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Result json.RawMessage `json:"qResult"`
-	}{}
-	err := obj.RPC(ctx, "ApplyAndVerify", result)
-	return result.Result, err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericBookmark) ApplyPatches(ctx context.Context, patches []*NxPatch) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericBookmark) ApplyPatchesRaw(ctx context.Context, patches any) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Adds the generic bookmark to the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericBookmark) Approve(ctx context.Context) error {
-	err := obj.RPC(ctx, "Approve", nil)
-	return err
-}
-
-// Retrieves the values of a field for the default state.
-//
-// Fieldvalue:
-//
-//	+------------+--------------------------------+---------+
-//	|    NAME    |          DESCRIPTION           |  TYPE   |
-//	+------------+--------------------------------+---------+
-//	| qText      | Text related to the field      | String  |
-//	|            | value.                         |         |
-//	| qIsNumeric | Is set to true if the value is | Boolean |
-//	|            | a numeric.  Default is false.  |         |
-//	| qNumber    | Numeric value of the field.    | Double  |
-//	|            | This parameter is displayed if |         |
-//	|            | qIsNumeric is set to true.     |         |
-//	+------------+--------------------------------+---------+
-//
-// Parameters:
-//
-// ◾ field               -   Name of the field.
-//
-// ◾ getExcludedValues   -   If set to true, only NOT-selected values are returned.
-//
-// ◾ dataPage            -   Range of returned values.
-//
-// Stability: locked
-func (obj *GenericBookmark) GetFieldValues(ctx context.Context, field string, getExcludedValues bool, dataPage *BookmarkFieldPage) ([]*FieldValue, error) {
-	result := &struct {
-		FieldValues []*FieldValue `json:"qFieldValues"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldValues", result, field, getExcludedValues, dataPage)
-	return result.FieldValues, err
-}
-
-// Retrieves the values of a field for the default state.
-//
-// Fieldvalue:
-//
-//	+------------+--------------------------------+---------+
-//	|    NAME    |          DESCRIPTION           |  TYPE   |
-//	+------------+--------------------------------+---------+
-//	| qText      | Text related to the field      | String  |
-//	|            | value.                         |         |
-//	| qIsNumeric | Is set to true if the value is | Boolean |
-//	|            | a numeric.  Default is false.  |         |
-//	| qNumber    | Numeric value of the field.    | Double  |
-//	|            | This parameter is displayed if |         |
-//	|            | qIsNumeric is set to true.     |         |
-//	+------------+--------------------------------+---------+
-//
-// Parameters:
-//
-// ◾ field               -   Name of the field.
-//
-// ◾ getExcludedValues   -   If set to true, only NOT-selected values are returned.
-//
-// ◾ dataPage            -   Range of returned values.
-//
-// Stability: locked
-func (obj *GenericBookmark) GetFieldValuesRaw(ctx context.Context, field string, getExcludedValues bool, dataPage any) (json.RawMessage, error) {
-	result := &struct {
-		FieldValues json.RawMessage `json:"qFieldValues"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldValues", result, field, getExcludedValues, dataPage)
-	return result.FieldValues, err
-}
-
-// Retrieves the values of a field per state.
-//
-// Fieldvalue:
-//
-//	+------------+--------------------------------+---------+
-//	|    NAME    |          DESCRIPTION           |  TYPE   |
-//	+------------+--------------------------------+---------+
-//	| qText      | Text related to the field      | String  |
-//	|            | value.                         |         |
-//	| qIsNumeric | Is set to true if the value is | Boolean |
-//	|            | a numeric.  Default is false.  |         |
-//	| qNumber    | Numeric value of the field.    | Double  |
-//	|            | This parameter is displayed if |         |
-//	|            | qIsNumeric is set to true.     |         |
-//	+------------+--------------------------------+---------+
-//
-// Parameters:
-//
-// ◾ field               -   Name of the field.
-//
-// ◾ getExcludedValues   -   When to true, only NOT-selected values are returned.
-//
-// ◾ dataPages           -   Range of returned values per state, an empty array will return the values of all states. Only one page without a state name returns the values for all states, using the start and end indices of the first page.
-//
-// Stability: locked
-func (obj *GenericBookmark) GetFieldValuesEx(ctx context.Context, field string, getExcludedValues bool, dataPages *BookmarkStateFieldPages) ([]*StateFieldValues, error) {
-	result := &struct {
-		FieldValues []*StateFieldValues `json:"qFieldValues"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldValuesEx", result, field, getExcludedValues, dataPages)
-	return result.FieldValues, err
-}
-
-// Retrieves the values of a field per state.
-//
-// Fieldvalue:
-//
-//	+------------+--------------------------------+---------+
-//	|    NAME    |          DESCRIPTION           |  TYPE   |
-//	+------------+--------------------------------+---------+
-//	| qText      | Text related to the field      | String  |
-//	|            | value.                         |         |
-//	| qIsNumeric | Is set to true if the value is | Boolean |
-//	|            | a numeric.  Default is false.  |         |
-//	| qNumber    | Numeric value of the field.    | Double  |
-//	|            | This parameter is displayed if |         |
-//	|            | qIsNumeric is set to true.     |         |
-//	+------------+--------------------------------+---------+
-//
-// Parameters:
-//
-// ◾ field               -   Name of the field.
-//
-// ◾ getExcludedValues   -   When to true, only NOT-selected values are returned.
-//
-// ◾ dataPages           -   Range of returned values per state, an empty array will return the values of all states. Only one page without a state name returns the values for all states, using the start and end indices of the first page.
-//
-// Stability: locked
-func (obj *GenericBookmark) GetFieldValuesExRaw(ctx context.Context, field string, getExcludedValues bool, dataPages any) (json.RawMessage, error) {
-	result := &struct {
-		FieldValues json.RawMessage `json:"qFieldValues"`
-	}{}
-	err := obj.RPC(ctx, "GetFieldValuesEx", result, field, getExcludedValues, dataPages)
-	return result.FieldValues, err
-}
-
-// Returns:
-//
-// • The type of the object.
-//
-// • The identifier of the object.
-// Stability: locked
-func (obj *GenericBookmark) GetInfo(ctx context.Context) (*NxInfo, error) {
-	result := &struct {
-		Info *NxInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Returns:
-//
-// • The type of the object.
-//
-// • The identifier of the object.
-// Stability: locked
-func (obj *GenericBookmark) GetInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated.
-// Stability: locked
-func (obj *GenericBookmark) GetLayout(ctx context.Context) (*GenericBookmarkLayout, error) {
-	result := &struct {
-		Layout *GenericBookmarkLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated.
-// Stability: locked
-func (obj *GenericBookmark) GetLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Shows the properties of an object.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericBookmark) GetProperties(ctx context.Context) (*GenericBookmarkProperties, error) {
-	result := &struct {
-		Prop *GenericBookmarkProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Shows the properties of an object.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericBookmark) GetPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Publishes a bookmark.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericBookmark) Publish(ctx context.Context) error {
-	err := obj.RPC(ctx, "Publish", nil)
-	return err
-}
-
-// Sets some properties for a bookmark.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the bookmark.
-//
-// Stability: locked
-func (obj *GenericBookmark) SetProperties(ctx context.Context, prop *GenericBookmarkProperties) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets some properties for a bookmark.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the bookmark.
-//
-// Stability: locked
-func (obj *GenericBookmark) SetPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Removes the generic bookmark from the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericBookmark) UnApprove(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnApprove", nil)
-	return err
-}
-
-// Unpublishes a bookmark.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericBookmark) UnPublish(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnPublish", nil)
-	return err
-}
-
-type GenericDimension struct {
-	*RemoteObject
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericDimension) ApplyPatches(ctx context.Context, patches []*NxPatch) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericDimension) ApplyPatchesRaw(ctx context.Context, patches any) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Adds the generic dimension to the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericDimension) Approve(ctx context.Context) error {
-	err := obj.RPC(ctx, "Approve", nil)
-	return err
-}
-
-// Get a cyclic dimension's active field.
-// This operation is only possible for cyclic dimensions.
-// Stability: experimental
-func (obj *GenericDimension) GetActiveField(ctx context.Context) (int, error) {
-	result := &struct {
-		Return int `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetActiveField", result)
-	return result.Return, err
-}
-
-// Returns the definition of a dimension.
-//
-// The definition of the dimension is returned.
-// Stability: locked
-func (obj *GenericDimension) GetDimension(ctx context.Context) (*NxLibraryDimensionDef, error) {
-	result := &struct {
-		Dim *NxLibraryDimensionDef `json:"qDim"`
-	}{}
-	err := obj.RPC(ctx, "GetDimension", result)
-	return result.Dim, err
-}
-
-// Returns the definition of a dimension.
-//
-// The definition of the dimension is returned.
-// Stability: locked
-func (obj *GenericDimension) GetDimensionRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Dim json.RawMessage `json:"qDim"`
-	}{}
-	err := obj.RPC(ctx, "GetDimension", result)
-	return result.Dim, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericDimension) GetInfo(ctx context.Context) (*NxInfo, error) {
-	result := &struct {
-		Info *NxInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericDimension) GetInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Evaluates a dimension and displays its properties, including the dynamic properties.
-// Stability: locked
-func (obj *GenericDimension) GetLayout(ctx context.Context) (*GenericDimensionLayout, error) {
-	result := &struct {
-		Layout *GenericDimensionLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates a dimension and displays its properties, including the dynamic properties.
-// Stability: locked
-func (obj *GenericDimension) GetLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericDimension) GetLinkedObjects(ctx context.Context) ([]*NxLinkedObjectInfo, error) {
-	result := &struct {
-		Items []*NxLinkedObjectInfo `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericDimension) GetLinkedObjectsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Items json.RawMessage `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Shows the properties of an object.
-// Returns the identifier and the definition of the dimension.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-// Stability: locked
-func (obj *GenericDimension) GetProperties(ctx context.Context) (*GenericDimensionProperties, error) {
-	result := &struct {
-		Prop *GenericDimensionProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Shows the properties of an object.
-// Returns the identifier and the definition of the dimension.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-// Stability: locked
-func (obj *GenericDimension) GetPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Publishes a dimension.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericDimension) Publish(ctx context.Context) error {
-	err := obj.RPC(ctx, "Publish", nil)
-	return err
-}
-
-// Set a cyclic dimension's active field directly.
-// This operation is only possible for cyclic dimensions.
-//
-// Parameters:
-//
-// ◾ ix   -   Index of the new active field.
-//
-// Stability: experimental
-func (obj *GenericDimension) SetActiveField(ctx context.Context, ix int) error {
-	err := obj.RPC(ctx, "SetActiveField", nil, ix)
-	return err
-}
-
-// Sets some properties for a dimension.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the dimension.
-//
-// Stability: locked
-func (obj *GenericDimension) SetProperties(ctx context.Context, prop *GenericDimensionProperties) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets some properties for a dimension.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the dimension.
-//
-// Stability: locked
-func (obj *GenericDimension) SetPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Step active field in a cyclic dimension.
-// This operation is only possible for cyclic dimensions.
-//
-// Parameters:
-//
-// ◾ step   -   The number of steps made through the dimension. Positive values step forward and negative values step backward.
-//
-// Stability: experimental
-func (obj *GenericDimension) StepCycle(ctx context.Context, step int) error {
-	err := obj.RPC(ctx, "StepCycle", nil, step)
-	return err
-}
-
-// Removes the generic dimension from the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericDimension) UnApprove(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnApprove", nil)
-	return err
-}
-
-// Unpublishes a dimension.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericDimension) UnPublish(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnPublish", nil)
-	return err
-}
-
-type GenericMeasure struct {
-	*RemoteObject
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericMeasure) ApplyPatches(ctx context.Context, patches []*NxPatch) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericMeasure) ApplyPatchesRaw(ctx context.Context, patches any) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Adds the generic measure to the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericMeasure) Approve(ctx context.Context) error {
-	err := obj.RPC(ctx, "Approve", nil)
-	return err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericMeasure) GetInfo(ctx context.Context) (*NxInfo, error) {
-	result := &struct {
-		Info *NxInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericMeasure) GetInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Evaluates a measure and displays its properties, including the dynamic properties.
-// Stability: locked
-func (obj *GenericMeasure) GetLayout(ctx context.Context) (*GenericMeasureLayout, error) {
-	result := &struct {
-		Layout *GenericMeasureLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates a measure and displays its properties, including the dynamic properties.
-// Stability: locked
-func (obj *GenericMeasure) GetLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericMeasure) GetLinkedObjects(ctx context.Context) ([]*NxLinkedObjectInfo, error) {
-	result := &struct {
-		Items []*NxLinkedObjectInfo `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericMeasure) GetLinkedObjectsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Items json.RawMessage `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Returns the definition of a measure.
-// Stability: locked
-func (obj *GenericMeasure) GetMeasure(ctx context.Context) (*NxLibraryMeasureDef, error) {
-	result := &struct {
-		Measure *NxLibraryMeasureDef `json:"qMeasure"`
-	}{}
-	err := obj.RPC(ctx, "GetMeasure", result)
-	return result.Measure, err
-}
-
-// Returns the definition of a measure.
-// Stability: locked
-func (obj *GenericMeasure) GetMeasureRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Measure json.RawMessage `json:"qMeasure"`
-	}{}
-	err := obj.RPC(ctx, "GetMeasure", result)
-	return result.Measure, err
-}
-
-// Shows the properties of an object.
-// Returns the identifier and the definition of the measure.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericMeasure) GetProperties(ctx context.Context) (*GenericMeasureProperties, error) {
-	result := &struct {
-		Prop *GenericMeasureProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Shows the properties of an object.
-// Returns the identifier and the definition of the measure.
-// If the member delta is set to true in the request object, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericMeasure) GetPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Publishes a measure.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericMeasure) Publish(ctx context.Context) error {
-	err := obj.RPC(ctx, "Publish", nil)
-	return err
-}
-
-// Sets some properties for a measure.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the measure.
-//
-// Stability: locked
-func (obj *GenericMeasure) SetProperties(ctx context.Context, prop *GenericMeasureProperties) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets some properties for a measure.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the measure.
-//
-// Stability: locked
-func (obj *GenericMeasure) SetPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Removes the generic measure from the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericMeasure) UnApprove(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnApprove", nil)
-	return err
-}
-
-// Unpublishes a measure.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericMeasure) UnPublish(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnPublish", nil)
-	return err
-}
-
-type GenericObject struct {
-	*RemoteObject
-}
-
-// Aborts the results of a search in a list object.
-// This method applies to list objects (objects with one dimension).
-// After an abort on a list object search, the GetLayout Method does not return any more search results but it does return the values in the field.
-//
-// Parameters:
-//
-// ◾ path   -   Path to the definition of the list object.
-// For example, /qListObjectDef .
-//
-// Stability: locked
-func (obj *GenericObject) AbortListObjectSearch(ctx context.Context, path string) error {
-	err := obj.RPC(ctx, "AbortListObjectSearch", nil, path)
-	return err
-}
-
-// Accept the results of a search in a list object. The search results become selected in the field.
-// This method applies to list objects (objects with one dimension).
-// The search results are displayed using the GetLayout Method.
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the list object.
-// For example, /qListObjectDef .
-//
-// ◾ toggleMode   -   Set to true to keep any selections present in the list object.
-// If this parameter is set to false, selections made before accepting the list object search become alternative.
-//
-// ◾ softLock     -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) AcceptListObjectSearch(ctx context.Context, path string, toggleMode bool, softLock bool) error {
-	err := obj.RPC(ctx, "AcceptListObjectSearch", nil, path, toggleMode, softLock)
-	return err
-}
-
-// You can use the AddGroupMembers method with any object that contains an object grouping definition.
-// This method allows you to add one or more members to an existing group of objects directly.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For exampleb /qNxGroupDef .
-//
-// ◾ members         -   Array of IDs for the objects and/or subgroups to add to the group.
-//
-// ◾ targetGroupId   -   Name of the group the Members will be added to (if not the called object).
-//
-// ◾ posId           -   Id of the member whose position to insert into.
-//
-// Stability: experimental
-func (obj *GenericObject) AddGroupMembers(ctx context.Context, path string, members []*NxGroupObjectId, targetGroupId string, posId string) error {
-	err := obj.RPC(ctx, "AddGroupMembers", nil, path, members, targetGroupId, posId)
-	return err
-}
-
-// You can use the AddGroupMembers method with any object that contains an object grouping definition.
-// This method allows you to add one or more members to an existing group of objects directly.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For exampleb /qNxGroupDef .
-//
-// ◾ members         -   Array of IDs for the objects and/or subgroups to add to the group.
-//
-// ◾ targetGroupId   -   Name of the group the Members will be added to (if not the called object).
-//
-// ◾ posId           -   Id of the member whose position to insert into.
-//
-// Stability: experimental
-func (obj *GenericObject) AddGroupMembersRaw(ctx context.Context, path string, members any, targetGroupId string, posId string) error {
-	err := obj.RPC(ctx, "AddGroupMembers", nil, path, members, targetGroupId, posId)
-	return err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties.
-// It is possible to apply a patch to the properties of a generic object, that is not persistent. Such a patch is called a soft patch.
-// In that case, the result of the operation on the properties (add, remove or delete) is not shown when doing GetProperties , and only a GetLayout call shows the result of the operation.
-// Properties that are not persistent are called soft properties. Once the engine session is over, soft properties are cleared. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Soft properties apply only to generic objects.
-//
-// Parameters:
-//
-// ◾ patches     -   Array of patches.
-//
-// ◾ softPatch   -   If set to true, it means that the properties to be applied are not persistent. The patch is a soft patch.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) ApplyPatches(ctx context.Context, patches []*NxPatch, softPatch bool) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches, softPatch)
-	return err
-}
-
-// Applies a patch to the properties of an object. Allows an update to some of the properties.
-// It is possible to apply a patch to the properties of a generic object, that is not persistent. Such a patch is called a soft patch.
-// In that case, the result of the operation on the properties (add, remove or delete) is not shown when doing GetProperties , and only a GetLayout call shows the result of the operation.
-// Properties that are not persistent are called soft properties. Once the engine session is over, soft properties are cleared. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Soft properties apply only to generic objects.
-//
-// Parameters:
-//
-// ◾ patches     -   Array of patches.
-//
-// ◾ softPatch   -   If set to true, it means that the properties to be applied are not persistent. The patch is a soft patch.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) ApplyPatchesRaw(ctx context.Context, patches any, softPatch bool) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches, softPatch)
-	return err
-}
-
-// Adds the generic object to the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericObject) Approve(ctx context.Context) error {
-	err := obj.RPC(ctx, "Approve", nil)
-	return err
-}
-
-// Begins the selection mode. The app enters the modal state. The specified object enters the selection mode and a modal window is opened. The selection mode can apply to only one object in an app at a time.
-// When a visualization is in selection mode, selections can be made in this visualization. The visualization is not sorted until the selection mode is ended. Once the selection mode is ended and if the selections are accepted, the visualization is sorted according to the sort criteria. For more information about:
-//
-// • Ending the selection mode, see EndSelections Method.
-//
-// • The sort criteria, see ListObjectDef or HyperCubeDef.
-//
-// Example:
-//
-// A sheet contains a list object and a chart. If the list object is in selection mode then the chart cannot be in selection mode. No selection on the chart can be made until the list object exits the selection mode.
-//
-// Parameters:
-//
-// ◾ paths   -   List of the paths to the definition of the objects to enter selection mode.
-// For example, /qListObjectDef .
-//
-// Stability: locked
-func (obj *GenericObject) BeginSelections(ctx context.Context, paths []string) error {
-	err := obj.RPC(ctx, "BeginSelections", nil, paths)
-	return err
-}
-
-// Clears the selections in a dimension of a visualization.
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the visualization.
-// For example, /qListObjectDef .
-//
-// ◾ colIndices   -   Array of dimension numbers or indexes. The selections are cleared in the specified dimensions.
-// Dimension numbers/indexes start from 0.
-// If this parameter is not set, all dimensions are cleared.
-//
-// Stability: locked
-func (obj *GenericObject) ClearSelections(ctx context.Context, path string, colIndices []int) error {
-	err := obj.RPC(ctx, "ClearSelections", nil, path, colIndices)
-	return err
-}
-
-// Clears the soft properties of a generic object.
-// For more information on how to add soft properties to a generic object, see ApplyPatches Method.
-// Stability: locked
-func (obj *GenericObject) ClearSoftPatches(ctx context.Context) error {
-	err := obj.RPC(ctx, "ClearSoftPatches", nil)
-	return err
-}
-
-// Collapses the left dimensions of a pivot table. This method applies only to pivot tables that are not always fully expanded.
-// In the definition of the hypercube (in HyperCubeDef ), the parameter qAlwaysFullyExpanded must be set to false.
-//
-// Parameters:
-//
-// ◾ path   -   Path to the definition of the object to be collapsed.
-// For example, /qHyperCubeDef .
-//
-// ◾ row    -   Row index in the data matrix.
-// Indexing starts from 0.
-//
-// ◾ col    -   Column index. The index is based on the left dimension indexes.
-// Indexing starts from 0.
-//
-// ◾ all    -   If set to true, it collapses all cells.
-// Parameters qRow and qCol are not used if qAll is set to true, but they need to be set (for example to 0).
-//
-// Stability: locked
-func (obj *GenericObject) CollapseLeft(ctx context.Context, path string, row int, col int, all bool) error {
-	err := obj.RPC(ctx, "CollapseLeft", nil, path, row, col, all)
-	return err
-}
-
-// Collapses the top dimensions of a pivot table. This method applies only to pivot tables that are not always fully expanded.
-// In the definition of the hypercube (in HyperCubeDef ), the parameter qAlwaysFullyExpanded must be set to false.
-//
-// Parameters:
-//
-// ◾ path   -   Path to the definition of the object to be collapsed
-// For example, /qHyperCubeDef .
-//
-// ◾ row    -   Row index. The index is based on the top dimension indexes.
-// Indexing starts from 0.
-//
-// ◾ col    -   Column index in the data matrix.
-// Indexing starts from 0.
-//
-// ◾ all    -   If set to true, it collapses all cells.
-// Parameters qRow and qCol are not used if qAll is set to true, but they need to be set (for example to 0).
-//
-// Stability: locked
-func (obj *GenericObject) CollapseTop(ctx context.Context, path string, row int, col int, all bool) error {
-	err := obj.RPC(ctx, "CollapseTop", nil, path, row, col, all)
-	return err
-}
-
-// Copies the properties of a generic object and its children.
-// The source object is specified by the parameter qFromId and the destination object is referenced by its handle.
-// The identifier of the destination object is the same as before the copy takes place.
-//
-// Parameters:
-//
-// ◾ fromId   -   Identifier of the object to copy.
-//
-// Stability: locked
-func (obj *GenericObject) CopyFrom(ctx context.Context, fromId string) error {
-	err := obj.RPC(ctx, "CopyFrom", nil, fromId)
-	return err
-}
-
-// Creates a generic object that is a child of another generic object.
-// It is possible to update the properties of the child's parent at the same time that the child is created. Both operations are performed by the same call.
-// It is possible to create a child that is linked to another generic object. The two objects have the same properties.
-//
-// Parameters:
-//
-// ◾ prop          -   Information about the child.
-// It is possible to create a child that is linked to another object.
-//
-// ◾ propForThis   -   Identifier of the parent's object.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) CreateChild(ctx context.Context, prop *GenericObjectProperties, propForThis *GenericObjectProperties) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateChild", result, prop, propForThis)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a generic object that is a child of another generic object.
-// It is possible to update the properties of the child's parent at the same time that the child is created. Both operations are performed by the same call.
-// It is possible to create a child that is linked to another generic object. The two objects have the same properties.
-//
-// Parameters:
-//
-// ◾ prop          -   Information about the child.
-// It is possible to create a child that is linked to another object.
-//
-// ◾ propForThis   -   Identifier of the parent's object.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) CreateChildRaw(ctx context.Context, prop any, propForThis any) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateChild", result, prop, propForThis)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// You can use the CreateGroup method with any object that contains an object grouping definition.
-// This method allows you to create a new subgroup of objects directly and add it to a group's members.
-// Returns the ID of the created subgroup.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example /qNxGroupDef .
-//
-// ◾ groupDef        -   Definition of the new group.
-//
-// ◾ targetGroupId   -   Id of the group to create the new subgroup in (if not the called object).
-//
-// Stability: experimental
-func (obj *GenericObject) CreateGroup(ctx context.Context, path string, groupDef *NxGroupDef, targetGroupId string) (string, error) {
-	result := &struct {
-		GroupId string `json:"qGroupId"`
-	}{}
-	err := obj.RPC(ctx, "CreateGroup", result, path, groupDef, targetGroupId)
-	return result.GroupId, err
-}
-
-// You can use the CreateGroup method with any object that contains an object grouping definition.
-// This method allows you to create a new subgroup of objects directly and add it to a group's members.
-// Returns the ID of the created subgroup.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example /qNxGroupDef .
-//
-// ◾ groupDef        -   Definition of the new group.
-//
-// ◾ targetGroupId   -   Id of the group to create the new subgroup in (if not the called object).
-//
-// Stability: experimental
-func (obj *GenericObject) CreateGroupRaw(ctx context.Context, path string, groupDef any, targetGroupId string) (string, error) {
-	result := &struct {
-		GroupId string `json:"qGroupId"`
-	}{}
-	err := obj.RPC(ctx, "CreateGroup", result, path, groupDef, targetGroupId)
-	return result.GroupId, err
-}
-
-// Removes all children and all children to the children on an object.
-//
-// Parameters:
-//
-// ◾ propForThis   -   Identifier of the parent's object and property to update.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) DestroyAllChildren(ctx context.Context, propForThis *GenericObjectProperties) error {
-	err := obj.RPC(ctx, "DestroyAllChildren", nil, propForThis)
-	return err
-}
-
-// Removes all children and all children to the children on an object.
-//
-// Parameters:
-//
-// ◾ propForThis   -   Identifier of the parent's object and property to update.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) DestroyAllChildrenRaw(ctx context.Context, propForThis any) error {
-	err := obj.RPC(ctx, "DestroyAllChildren", nil, propForThis)
-	return err
-}
-
-// Removes a child object.
-// It is possible to update the properties of the child's parent at the same time that the child is removed. Both operations are performed by the same call.
-// Removing a linked object, invalidate the linking object.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id            -   Identifier of the child to remove.
-//
-// ◾ propForThis   -   Identifier of the parent's object and property to update.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) DestroyChild(ctx context.Context, id string, propForThis *GenericObjectProperties) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyChild", result, id, propForThis)
-	return result.Success, err
-}
-
-// Removes a child object.
-// It is possible to update the properties of the child's parent at the same time that the child is removed. Both operations are performed by the same call.
-// Removing a linked object, invalidate the linking object.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ id            -   Identifier of the child to remove.
-//
-// ◾ propForThis   -   Identifier of the parent's object and property to update.
-// Should be set to update the properties of the parent's object at the same time the child is created.
-//
-// Stability: locked
-func (obj *GenericObject) DestroyChildRaw(ctx context.Context, id string, propForThis any) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DestroyChild", result, id, propForThis)
-	return result.Success, err
-}
-
-// You can use the drillUp method with any object that contains a drill-down group as a dimension.
-// This method allows you to move between different levels of information (from a detailed level to a less detailed level of information). You can go back to previous visualizations up to the highest level of the hierarchy.
-// If you try to drill up more steps than there are available levels, the first level of the hierarchy is displayed.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ dimNo      -   Dimension number or index starting from 0.
-// The default value is 0.
-//
-// ◾ nbrSteps   -   Number of steps you want to drill up.
-// The default value is 0.
-//
-// Stability: locked
-func (obj *GenericObject) DrillUp(ctx context.Context, path string, dimNo int, nbrSteps int) error {
-	err := obj.RPC(ctx, "DrillUp", nil, path, dimNo, nbrSteps)
-	return err
-}
-
-// Adds a snapshot to a generic object.
-// Only one snapshot can be embedded in a generic object.
-// If you embed a snapshot in an object that already contains a snapshot, the new snapshot overwrites the previous one.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the bookmark.
-//
-// Stability: locked
-func (obj *GenericObject) EmbedSnapshotObject(ctx context.Context, id string) error {
-	err := obj.RPC(ctx, "EmbedSnapshotObject", nil, id)
-	return err
-}
-
-// Ends the selection mode on a visualization. The selections are accepted or aborted when exiting the selection mode, depending on the qAccept parameter value.
-//
-// Parameters:
-//
-// ◾ accept   -   Set this parameter to true to accept the selections before exiting the selection mode.
-//
-// Stability: locked
-func (obj *GenericObject) EndSelections(ctx context.Context, accept bool) error {
-	err := obj.RPC(ctx, "EndSelections", nil, accept)
-	return err
-}
-
-// Expands the left dimensions of a pivot table. This method applies only to pivot tables that are not always fully expanded.
-// In the definition of the hypercube (in HyperCubeDef ), the parameter qAlwaysFullyExpanded must be set to false.
-//
-// Parameters:
-//
-// ◾ path   -   Path to the definition of the object to be expanded.
-// For example, /qHyperCubeDef .
-//
-// ◾ row    -   Row index in the data matrix to expand.
-// Indexing starts from 0.
-//
-// ◾ col    -   Column index. The index is based on the left dimension indexes.
-// Indexing starts from 0.
-//
-// ◾ all    -   If set to true, it expands all cells.
-// Parameters qRow and qCol are not used if qAll is set to true, but they need to be set (for example to 0).
-//
-// Stability: locked
-func (obj *GenericObject) ExpandLeft(ctx context.Context, path string, row int, col int, all bool) error {
-	err := obj.RPC(ctx, "ExpandLeft", nil, path, row, col, all)
-	return err
-}
-
-// Expands the top dimensions of a pivot table. This method applies only to pivot tables that are not always fully expanded.
-// In the definition of the hypercube (in HyperCubeDef ), the parameter qAlwaysFullyExpanded must be set to false.
-//
-// Parameters:
-//
-// ◾ path   -   Path to the definition of the object to be expanded.
-// For example, /qHyperCubeDef .
-//
-// ◾ row    -   Row index. The index is based on the top dimension indexes.
-// Indexing starts from 0.
-//
-// ◾ col    -   Column index in the data matrix.
-// Indexing starts from 0.
-//
-// ◾ all    -   If set to true, it expands all cells.
-// Parameters qRow and qCol are not used if qAll is set to true, but they need to be set (for example to 0).
-//
-// Stability: locked
-func (obj *GenericObject) ExpandTop(ctx context.Context, path string, row int, col int, all bool) error {
-	err := obj.RPC(ctx, "ExpandTop", nil, path, row, col, all)
-	return err
-}
-
-// Exports the data of any generic object to an Excel file or a open XML file. If the object contains excluded values, those excluded values are not exported.
-// This API has limited functionality and will not support CSV export from all types of objects. Consider using Excel export instead. Treemap and bar chart are not supported.
-// ExportData method is not supported in SaaS Editions of Qlik Sense.
-//
-// Default limitations in number of rows and columns:
-//
-// The default maximum number of rows and columns in the Excel export file is:
-//
-// • 1048566 rows per sheet. For pivot tables: 1048566 column dimensions. 10 rows can be added after the export.
-//
-// • 16384 columns per sheet. If the number of columns exceeds the limit, the exported file is truncated and a warning message is sent.
-//
-// Default limitation in number of columns:
-//
-// The default maximum number of columns in the export file is:
-//
-// • 1000 to export to a CSV file
-//
-// Default limitations in number of cells:
-//
-// The default maximum number of cells in the export file is:
-//
-// • 5000000 to export to a CSV file
-//
-// The exported file is truncated if the number of cells exceeds the limit. A warning message with code 1000 is sent.
-// There is an option to export only the possible values ( qExportState is P).
-//
-// Default limitation in size:
-//
-// If the exported file is larger than the maximum value, then an out-of-memory error with code 13000 is returned.
-//
-// Exported files are temporary and are available only for a certain time span and only to the user who created them.
-//
-// Parameters:
-//
-// ◾ fileType      -   Type of the file to export.
-//
-// One of:
-//
-// • CSV_C or EXPORT_CSV_C
-//
-// • CSV_T or EXPORT_CSV_T
-//
-// • OOXML or EXPORT_OOXML
-//
-// • PARQUET or EXPORT_PARQUET
-//
-// ◾ path          -   Path to the definition of the object to be exported.
-// For example, /qHyperCubeDef .
-// This parameter is mandatory if the file type is CSVC_ or CSVT_ .
-//
-// ◾ fileName      -   Name of the exported file after download from browser.
-// This parameter is optional and only used in Qlik Sense Desktop.
-//
-// ◾ exportState   -   Defines the values to be exported.
-// The default value is A.
-//
-// One of:
-//
-// • P or EXPORT_POSSIBLE
-//
-// • A or EXPORT_ALL
-//
-// ◾ serveOnce     -   If the exported file should be served only once
-// This parameter is optional and only used in Qlik Sense Enterprise (Windows)
-// Default value: false
-//
-// Stability: locked
-func (obj *GenericObject) ExportData(ctx context.Context, fileType string, path string, fileName string, exportState string, serveOnce bool) (string, []int, error) {
-	result := &struct {
-		Url      string `json:"qUrl"`
-		Warnings []int  `json:"qWarnings"`
-	}{}
-	err := obj.RPC(ctx, "ExportData", result, fileType, path, fileName, exportState, serveOnce)
-	return result.Url, result.Warnings, err
-}
-
-// Returns the type of the object and the corresponding handle.
-//
-// Parameters:
-//
-// ◾ id   -   Identifier of the object.
-//
-// Stability: locked
-func (obj *GenericObject) GetChild(ctx context.Context, id string) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetChild", result, id)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns the identifier and the type for each child in an app object. If the child contains extra properties in qInfos , these properties are returned.
-//
-// Full dynamic properties are optional and are returned if they exist in the definition of the object.
-// Stability: locked
-func (obj *GenericObject) GetChildInfos(ctx context.Context) ([]*NxInfo, error) {
-	result := &struct {
-		Infos []*NxInfo `json:"qInfos"`
-	}{}
-	err := obj.RPC(ctx, "GetChildInfos", result)
-	return result.Infos, err
-}
-
-// Returns the identifier and the type for each child in an app object. If the child contains extra properties in qInfos , these properties are returned.
-//
-// Full dynamic properties are optional and are returned if they exist in the definition of the object.
-// Stability: locked
-func (obj *GenericObject) GetChildInfosRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Infos json.RawMessage `json:"qInfos"`
-	}{}
-	err := obj.RPC(ctx, "GetChildInfos", result)
-	return result.Infos, err
-}
-
-// Returns the identifier, the type and the properties of the object.
-// If the object contains some soft properties, the soft properties are returned.
-// If the object is linked to another object, the properties of the linking object are returned.
-// Stability: locked
-func (obj *GenericObject) GetEffectiveProperties(ctx context.Context) (*GenericObjectProperties, error) {
-	result := &struct {
-		Prop *GenericObjectProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetEffectiveProperties", result)
-	return result.Prop, err
-}
-
-// Returns the identifier, the type and the properties of the object.
-// If the object contains some soft properties, the soft properties are returned.
-// If the object is linked to another object, the properties of the linking object are returned.
-// Stability: locked
-func (obj *GenericObject) GetEffectivePropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetEffectiveProperties", result)
-	return result.Prop, err
-}
-
-// Gets the properties of:
-//
-// • A generic object.
-//
-// • The children of the generic object.
-//
-// • The bookmarks/embedded snapshots of the generic object.
-// Stability: locked
-func (obj *GenericObject) GetFullPropertyTree(ctx context.Context) (*GenericObjectEntry, error) {
-	result := &struct {
-		PropEntry *GenericObjectEntry `json:"qPropEntry"`
-	}{}
-	err := obj.RPC(ctx, "GetFullPropertyTree", result)
-	return result.PropEntry, err
-}
-
-// Gets the properties of:
-//
-// • A generic object.
-//
-// • The children of the generic object.
-//
-// • The bookmarks/embedded snapshots of the generic object.
-// Stability: locked
-func (obj *GenericObject) GetFullPropertyTreeRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		PropEntry json.RawMessage `json:"qPropEntry"`
-	}{}
-	err := obj.RPC(ctx, "GetFullPropertyTree", result)
-	return result.PropEntry, err
-}
-
-// This method supports data binning.
-// When a generic object with two or three measures and one dimension contains a lot of data, groups of points (for example, cells) can be rendered instead of points.
-// A zone of interest can be refined (for zooming in) up to a maximum refinement level (set in the qQueryLevel parameter) or coarsened (for zoom out).
-// The grid of cells is adaptive (not static), meaning that it adapts to different length scales.
-// The GetHyperCubeBinnedData method gives information about the adaptive grid and the values of the generic object.
-// The number of points in a cell and the coordinates (expressed in the measure range) of each cell are returned.
-// Dimension values and measure values are rendered at point level (highest detailed level).
-// The generic object should contain two or three measures and one dimension. When the refinement is high, the first two measures are represented on the x-axis and on the y-axis, while the third measure is visualized as color or point size.
-//
-// Adaptive Grid:
-//
-// More details about the properties of the adaptive grid are given in this paragraph.
-// When the refinement is not the highest (cells are rendered), information about the adaptive grid is returned through several arrays.
-// The first array contains the following properties:
-//
-//	+-------------+--------------------------------+----------------------------+
-//	|    NAME     |          DESCRIPTION           |            TYPE            |
-//	+-------------+--------------------------------+----------------------------+
-//	| qNum        | Maximum number of points that  | String                     |
-//	|             | a cell can contain.            |                            |
-//	| qElemNumber | Is set to 0.                   | Boolean                    |
-//	| qState      | The default value is L.        | One of:   * L for Locked   |
-//	|             |                                |  * S for Selected  *       |
-//	|             |                                | O for Optional  * D        |
-//	|             |                                | for Deselected  * A        |
-//	|             |                                | for Alternative  * X       |
-//	|             |                                | for eXcluded  * XS for     |
-//	|             |                                | eXcluded Selected  * XL    |
-//	|             |                                | for eXcluded Locked        |
-//	+-------------+--------------------------------+----------------------------+
-//
-// The next arrays give the coordinates of each cell in the page.
-// Each array contains the following properties:
-//
-//	+-------------+--------------------------------+--------------------------------+
-//	|    NAME     |          DESCRIPTION           |              TYPE              |
-//	+-------------+--------------------------------+--------------------------------+
-//	| qText       | Coordinates of a cell in       | String                         |
-//	|             | the measure range.  “qText”:   |                                |
-//	|             | “[[<left>, <top>, <right>,     |                                |
-//	|             | <bottom>], [<left>, <top>,     |                                |
-//	|             | <right>, <bottom>], ....       |                                |
-//	|             | [<left>, <top>, <right>,       |                                |
-//	|             | <bottom>]]  Where:  < left     |                                |
-//	|             | >, < top >, < right > and <    |                                |
-//	|             | bottom > are the coordinates   |                                |
-//	|             | of the cell in the measure     |                                |
-//	|             | range.                         |                                |
-//	| qNum        | Number of points in the cell.  | Double precision floating      |
-//	|             |                                | point                          |
-//	| qElemNumber | Unique identifier for each     | Integer                        |
-//	|             | cell, calculated by the engine |                                |
-//	|             | during the construction of     |                                |
-//	|             | the grid.  This element number |                                |
-//	|             | is not stored in the database  |                                |
-//	|             | and can have a positive or a   |                                |
-//	|             | negative value.                |                                |
-//	| qState      | The default value is L.        | One of:   * L for Locked       |
-//	|             |                                |  * S for Selected  *           |
-//	|             |                                | O for Optional  * D            |
-//	|             |                                | for Deselected  * A            |
-//	|             |                                | for Alternative  * X           |
-//	|             |                                | for eXcluded  * XS for         |
-//	|             |                                | eXcluded Selected  * XL        |
-//	|             |                                | for eXcluded Locked            |
-//	+-------------+--------------------------------+--------------------------------+
-//
-// Cells are represented as rectangles.
-//
-// Dimension values and measures values:
-//
-// More details about the properties, when dimension and measure values are returned, are given in this paragraph.
-// When the refinement is high, points are rendered (not cells) and dimension and measure values for each cell are returned.
-// The first array is empty because no information on the adaptive grid is needed.
-// The next arrays bring information about the dimension and the measure values.
-//
-//	+-------------+--------------------------------+--------------------------------+
-//	|    NAME     |          DESCRIPTION           |              TYPE              |
-//	+-------------+--------------------------------+--------------------------------+
-//	| qText       | Text value of the dimension or | String                         |
-//	|             | the measure.                   |                                |
-//	| qNum        | Numerical value of the         | Double precision floating      |
-//	|             | dimension or the measure.  Is  | point                          |
-//	|             | set to 0 if the value is only  |                                |
-//	|             | text.                          |                                |
-//	| qElemNumber | Unique identifier for each     | Integer                        |
-//	|             | cell, calculated by the engine |                                |
-//	|             | during the construction of     |                                |
-//	|             | the grid.  This element number |                                |
-//	|             | is not stored in the database  |                                |
-//	|             | and can have a positive or a   |                                |
-//	|             | negative value.                |                                |
-//	| qState      | The default value is L.        | One of:   * L for Locked       |
-//	|             |                                |  * S for Selected  *           |
-//	|             |                                | O for Optional  * D            |
-//	|             |                                | for Deselected  * A            |
-//	|             |                                | for Alternative  * X           |
-//	|             |                                | for eXcluded  * XS for         |
-//	|             |                                | eXcluded Selected  * XL        |
-//	|             |                                | for eXcluded Locked            |
-//	+-------------+--------------------------------+--------------------------------+
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages           -   Array of pages to retrieve.
-// Since the generic object contains two measures and one dimension, qWidth should be set to 3.
-// If the value of a measure is Null, the value cannot be rendered. Therefore, the number of elements rendered in a page can be less than the number defined in the property qHeight .
-//
-// ◾ viewport        -   Defines the canvas and the zoom level.
-// This parameter is not yet used and is optional.
-//
-// ◾ dataRanges      -   Range of the data to render.
-// This range applies to the measure values.
-// The lowest and highest values of a measure can be retrieved by using the GetLayout method (in /qHyperCube/qMeasureInfo ).
-//
-// ◾ maxNbrCells     -   Maximum number of cells in the grid.
-//
-// ◾ queryLevel      -   Level of details. The higher the level, the more detailed information you get (zoom-in).
-// When the number of points to render falls below a certain threshold, the values are no longer rendered as cells but as points.
-// The query level should be no greater than 20.
-//
-// ◾ binningMethod   -   Selects the algorithm.
-// The default value is 0.
-// One of:
-//
-// • 0: Adaptive grid
-//
-// • 1: Hexagonal grid
-//
-// • 2: Uniform grid
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeBinnedData(ctx context.Context, path string, pages []*NxPage, viewport *NxViewPort, dataRanges []*NxDataAreaPage, maxNbrCells int, queryLevel int, binningMethod int) ([]*NxDataPage, error) {
-	result := &struct {
-		DataPages []*NxDataPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeBinnedData", result, path, pages, viewport, dataRanges, maxNbrCells, queryLevel, binningMethod)
-	return result.DataPages, err
-}
-
-// This method supports data binning.
-// When a generic object with two or three measures and one dimension contains a lot of data, groups of points (for example, cells) can be rendered instead of points.
-// A zone of interest can be refined (for zooming in) up to a maximum refinement level (set in the qQueryLevel parameter) or coarsened (for zoom out).
-// The grid of cells is adaptive (not static), meaning that it adapts to different length scales.
-// The GetHyperCubeBinnedData method gives information about the adaptive grid and the values of the generic object.
-// The number of points in a cell and the coordinates (expressed in the measure range) of each cell are returned.
-// Dimension values and measure values are rendered at point level (highest detailed level).
-// The generic object should contain two or three measures and one dimension. When the refinement is high, the first two measures are represented on the x-axis and on the y-axis, while the third measure is visualized as color or point size.
-//
-// Adaptive Grid:
-//
-// More details about the properties of the adaptive grid are given in this paragraph.
-// When the refinement is not the highest (cells are rendered), information about the adaptive grid is returned through several arrays.
-// The first array contains the following properties:
-//
-//	+-------------+--------------------------------+----------------------------+
-//	|    NAME     |          DESCRIPTION           |            TYPE            |
-//	+-------------+--------------------------------+----------------------------+
-//	| qNum        | Maximum number of points that  | String                     |
-//	|             | a cell can contain.            |                            |
-//	| qElemNumber | Is set to 0.                   | Boolean                    |
-//	| qState      | The default value is L.        | One of:   * L for Locked   |
-//	|             |                                |  * S for Selected  *       |
-//	|             |                                | O for Optional  * D        |
-//	|             |                                | for Deselected  * A        |
-//	|             |                                | for Alternative  * X       |
-//	|             |                                | for eXcluded  * XS for     |
-//	|             |                                | eXcluded Selected  * XL    |
-//	|             |                                | for eXcluded Locked        |
-//	+-------------+--------------------------------+----------------------------+
-//
-// The next arrays give the coordinates of each cell in the page.
-// Each array contains the following properties:
-//
-//	+-------------+--------------------------------+--------------------------------+
-//	|    NAME     |          DESCRIPTION           |              TYPE              |
-//	+-------------+--------------------------------+--------------------------------+
-//	| qText       | Coordinates of a cell in       | String                         |
-//	|             | the measure range.  “qText”:   |                                |
-//	|             | “[[<left>, <top>, <right>,     |                                |
-//	|             | <bottom>], [<left>, <top>,     |                                |
-//	|             | <right>, <bottom>], ....       |                                |
-//	|             | [<left>, <top>, <right>,       |                                |
-//	|             | <bottom>]]  Where:  < left     |                                |
-//	|             | >, < top >, < right > and <    |                                |
-//	|             | bottom > are the coordinates   |                                |
-//	|             | of the cell in the measure     |                                |
-//	|             | range.                         |                                |
-//	| qNum        | Number of points in the cell.  | Double precision floating      |
-//	|             |                                | point                          |
-//	| qElemNumber | Unique identifier for each     | Integer                        |
-//	|             | cell, calculated by the engine |                                |
-//	|             | during the construction of     |                                |
-//	|             | the grid.  This element number |                                |
-//	|             | is not stored in the database  |                                |
-//	|             | and can have a positive or a   |                                |
-//	|             | negative value.                |                                |
-//	| qState      | The default value is L.        | One of:   * L for Locked       |
-//	|             |                                |  * S for Selected  *           |
-//	|             |                                | O for Optional  * D            |
-//	|             |                                | for Deselected  * A            |
-//	|             |                                | for Alternative  * X           |
-//	|             |                                | for eXcluded  * XS for         |
-//	|             |                                | eXcluded Selected  * XL        |
-//	|             |                                | for eXcluded Locked            |
-//	+-------------+--------------------------------+--------------------------------+
-//
-// Cells are represented as rectangles.
-//
-// Dimension values and measures values:
-//
-// More details about the properties, when dimension and measure values are returned, are given in this paragraph.
-// When the refinement is high, points are rendered (not cells) and dimension and measure values for each cell are returned.
-// The first array is empty because no information on the adaptive grid is needed.
-// The next arrays bring information about the dimension and the measure values.
-//
-//	+-------------+--------------------------------+--------------------------------+
-//	|    NAME     |          DESCRIPTION           |              TYPE              |
-//	+-------------+--------------------------------+--------------------------------+
-//	| qText       | Text value of the dimension or | String                         |
-//	|             | the measure.                   |                                |
-//	| qNum        | Numerical value of the         | Double precision floating      |
-//	|             | dimension or the measure.  Is  | point                          |
-//	|             | set to 0 if the value is only  |                                |
-//	|             | text.                          |                                |
-//	| qElemNumber | Unique identifier for each     | Integer                        |
-//	|             | cell, calculated by the engine |                                |
-//	|             | during the construction of     |                                |
-//	|             | the grid.  This element number |                                |
-//	|             | is not stored in the database  |                                |
-//	|             | and can have a positive or a   |                                |
-//	|             | negative value.                |                                |
-//	| qState      | The default value is L.        | One of:   * L for Locked       |
-//	|             |                                |  * S for Selected  *           |
-//	|             |                                | O for Optional  * D            |
-//	|             |                                | for Deselected  * A            |
-//	|             |                                | for Alternative  * X           |
-//	|             |                                | for eXcluded  * XS for         |
-//	|             |                                | eXcluded Selected  * XL        |
-//	|             |                                | for eXcluded Locked            |
-//	+-------------+--------------------------------+--------------------------------+
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages           -   Array of pages to retrieve.
-// Since the generic object contains two measures and one dimension, qWidth should be set to 3.
-// If the value of a measure is Null, the value cannot be rendered. Therefore, the number of elements rendered in a page can be less than the number defined in the property qHeight .
-//
-// ◾ viewport        -   Defines the canvas and the zoom level.
-// This parameter is not yet used and is optional.
-//
-// ◾ dataRanges      -   Range of the data to render.
-// This range applies to the measure values.
-// The lowest and highest values of a measure can be retrieved by using the GetLayout method (in /qHyperCube/qMeasureInfo ).
-//
-// ◾ maxNbrCells     -   Maximum number of cells in the grid.
-//
-// ◾ queryLevel      -   Level of details. The higher the level, the more detailed information you get (zoom-in).
-// When the number of points to render falls below a certain threshold, the values are no longer rendered as cells but as points.
-// The query level should be no greater than 20.
-//
-// ◾ binningMethod   -   Selects the algorithm.
-// The default value is 0.
-// One of:
-//
-// • 0: Adaptive grid
-//
-// • 1: Hexagonal grid
-//
-// • 2: Uniform grid
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeBinnedDataRaw(ctx context.Context, path string, pages any, viewport any, dataRanges any, maxNbrCells int, queryLevel int, binningMethod int) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeBinnedData", result, path, pages, viewport, dataRanges, maxNbrCells, queryLevel, binningMethod)
-	return result.DataPages, err
-}
-
-// Retrieves and packs compressed hypercube and axis data. It is possible to retrieve specific pages of data.
-// Binning is done on the time stamp data as well as the date. This means that you can zoom in to a level of granularity as low as seconds.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ options       -   Defines the data to return.
-//
-// ◾ reverseSort   -   If set to true the returned data pages are reverse sorted.
-// Optional.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeContinuousData(ctx context.Context, path string, options *NxContinuousDataOptions, reverseSort bool) ([]*NxDataPage, *NxAxisData, error) {
-	result := &struct {
-		DataPages []*NxDataPage `json:"qDataPages"`
-		AxisData  *NxAxisData   `json:"qAxisData"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeContinuousData", result, path, options, reverseSort)
-	return result.DataPages, result.AxisData, err
-}
-
-// Retrieves and packs compressed hypercube and axis data. It is possible to retrieve specific pages of data.
-// Binning is done on the time stamp data as well as the date. This means that you can zoom in to a level of granularity as low as seconds.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ options       -   Defines the data to return.
-//
-// ◾ reverseSort   -   If set to true the returned data pages are reverse sorted.
-// Optional.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeContinuousDataRaw(ctx context.Context, path string, options any, reverseSort bool) (json.RawMessage, json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-		AxisData  json.RawMessage `json:"qAxisData"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeContinuousData", result, path, options, reverseSort)
-	return result.DataPages, result.AxisData, err
-}
-
-// Retrieves the calculated data for a chart, a table, or a scatter plot. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_STRAIGHT.
-//
-// A data set is returned.
-//
-// Limitations on the number of cells:
-//
-// The maximum for the combined total number of cells across all pages is:
-//
-// • 10,000 cells. If this limit is exceeded, a calc-pages-too-large error with code 7009 is returned.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages   -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeData(ctx context.Context, path string, pages []*NxPage) ([]*NxDataPage, error) {
-	result := &struct {
-		DataPages []*NxDataPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Retrieves the calculated data for a chart, a table, or a scatter plot. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_STRAIGHT.
-//
-// A data set is returned.
-//
-// Limitations on the number of cells:
-//
-// The maximum for the combined total number of cells across all pages is:
-//
-// • 10,000 cells. If this limit is exceeded, a calc-pages-too-large error with code 7009 is returned.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages   -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeDataRaw(ctx context.Context, path string, pages any) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Retrieves the values of a pivot table. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_PIVOT.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages   -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubePivotData(ctx context.Context, path string, pages []*NxPage) ([]*NxPivotPage, error) {
-	result := &struct {
-		DataPages []*NxPivotPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubePivotData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Retrieves the values of a pivot table. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_PIVOT.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages   -   Array of pages to retrieve.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubePivotDataRaw(ctx context.Context, path string, pages any) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubePivotData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Reduces the data of a bar chart, a line chart or a scatter plot chart and retrieves them.
-// The reduction is dependent on the zoom factor (parameter qZoomFactor ) and on the reduction mode.
-// This method can be used to create mini charts.
-//
-// Bar chart or line chart data reduction:
-//
-// For the data reduction to happen, the following conditions must be fulfilled:
-//
-// • The values cannot fit in the defined page (parameter qPages ).
-//
-// • The zoom factor is not 0 (parameter qZoomFactor ).
-//
-// • The reduction mode must be set to D1.
-//
-// The reduction algorithm keeps the shape of the visualizations and works whatever the number of dimensions in the chart. The global profile of the chart is reduced, and not only a specific dimension. A visualization that has been reduced contains fewer values but its shape is the same. Data of all types can be reduced. Therefore it is hard to relate the values before and after a reduction especially when reducing string values.
-//
-// Example:
-//
-// If you have a chart with 1 million data, and you have set the zoom factor to 5, the GetHyperCubeReducedData method reduces the chart and retrieves 200 000 data.
-//
-// Scatter plot chart data reduction:
-//
-// The reduction mode must be set to C.
-// This reduction mechanism follows the 2D K-Means algorithm. Data are reduced into a number of clusters. Each data is assigned to a specific centroid.
-// The number of centroids can be defined in the parameter qZoomFactor.
-//
-// Scatter plot chart resolution reduction:
-//
-// The reduction mode must be set to S.
-// The resolution is reduced according to the zoom factor (parameter qZoomFactor ).
-//
-// Example:
-//
-// If you have a scatter plot chart and the zoom factor is set to 2, the scatter plot chart resolution is reduced by 4.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages           -   Array of pages.
-//
-// ◾ zoomFactor      -   Defines the zoom factor.
-// If set to -1, the engine decides of the zoom factor.
-//
-// • If the reduction mode is D1 or S , the zoom factor is 2ⁿ. If the zoom factor is 5, the data are reduced by a factor 32.
-//
-// • If the reduction mode is C , the zoom factor defines the number of centroids.
-//
-// ◾ reductionMode   -   Defines the reduction mode.
-//
-// One of:
-//
-// • N or DATA_REDUCTION_NONE
-//
-// • D1 or DATA_REDUCTION_ONEDIM
-//
-// • S or DATA_REDUCTION_SCATTERED
-//
-// • C or DATA_REDUCTION_CLUSTERED
-//
-// • ST or DATA_REDUCTION_STACKED
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeReducedData(ctx context.Context, path string, pages []*NxPage, zoomFactor int, reductionMode string) ([]*NxDataPage, error) {
-	result := &struct {
-		DataPages []*NxDataPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeReducedData", result, path, pages, zoomFactor, reductionMode)
-	return result.DataPages, err
-}
-
-// Reduces the data of a bar chart, a line chart or a scatter plot chart and retrieves them.
-// The reduction is dependent on the zoom factor (parameter qZoomFactor ) and on the reduction mode.
-// This method can be used to create mini charts.
-//
-// Bar chart or line chart data reduction:
-//
-// For the data reduction to happen, the following conditions must be fulfilled:
-//
-// • The values cannot fit in the defined page (parameter qPages ).
-//
-// • The zoom factor is not 0 (parameter qZoomFactor ).
-//
-// • The reduction mode must be set to D1.
-//
-// The reduction algorithm keeps the shape of the visualizations and works whatever the number of dimensions in the chart. The global profile of the chart is reduced, and not only a specific dimension. A visualization that has been reduced contains fewer values but its shape is the same. Data of all types can be reduced. Therefore it is hard to relate the values before and after a reduction especially when reducing string values.
-//
-// Example:
-//
-// If you have a chart with 1 million data, and you have set the zoom factor to 5, the GetHyperCubeReducedData method reduces the chart and retrieves 200 000 data.
-//
-// Scatter plot chart data reduction:
-//
-// The reduction mode must be set to C.
-// This reduction mechanism follows the 2D K-Means algorithm. Data are reduced into a number of clusters. Each data is assigned to a specific centroid.
-// The number of centroids can be defined in the parameter qZoomFactor.
-//
-// Scatter plot chart resolution reduction:
-//
-// The reduction mode must be set to S.
-// The resolution is reduced according to the zoom factor (parameter qZoomFactor ).
-//
-// Example:
-//
-// If you have a scatter plot chart and the zoom factor is set to 2, the scatter plot chart resolution is reduced by 4.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages           -   Array of pages.
-//
-// ◾ zoomFactor      -   Defines the zoom factor.
-// If set to -1, the engine decides of the zoom factor.
-//
-// • If the reduction mode is D1 or S , the zoom factor is 2ⁿ. If the zoom factor is 5, the data are reduced by a factor 32.
-//
-// • If the reduction mode is C , the zoom factor defines the number of centroids.
-//
-// ◾ reductionMode   -   Defines the reduction mode.
-//
-// One of:
-//
-// • N or DATA_REDUCTION_NONE
-//
-// • D1 or DATA_REDUCTION_ONEDIM
-//
-// • S or DATA_REDUCTION_SCATTERED
-//
-// • C or DATA_REDUCTION_CLUSTERED
-//
-// • ST or DATA_REDUCTION_STACKED
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeReducedDataRaw(ctx context.Context, path string, pages any, zoomFactor int, reductionMode string) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeReducedData", result, path, pages, zoomFactor, reductionMode)
-	return result.DataPages, err
-}
-
-// Retrieves the values of a stacked pivot table. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_PIVOT_STACK.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages         -   Array of pages to retrieve.
-//
-// ◾ maxNbrCells   -   Maximum number of cells at outer level.
-// The default value is 10 000.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeStackData(ctx context.Context, path string, pages []*NxPage, maxNbrCells int) ([]*NxStackPage, error) {
-	result := &struct {
-		DataPages []*NxStackPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeStackData", result, path, pages, maxNbrCells)
-	return result.DataPages, err
-}
-
-// Retrieves the values of a stacked pivot table. It is possible to retrieve specific pages of data.
-// This method works for a hypercube in DATA_MODE_PIVOT_STACK.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ pages         -   Array of pages to retrieve.
-//
-// ◾ maxNbrCells   -   Maximum number of cells at outer level.
-// The default value is 10 000.
-//
-// Stability: locked
-func (obj *GenericObject) GetHyperCubeStackDataRaw(ctx context.Context, path string, pages any, maxNbrCells int) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeStackData", result, path, pages, maxNbrCells)
-	return result.DataPages, err
-}
-
-// Retrieves data for nodes in a tree structure. It is possible to retrieve specific pages of data.
-// This method works for a treedata object or a hypercube in DATA_MODE_TREE.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object to be selected.
-//
-// ◾ nodeOptions   -   Specifies all the paging filters needed to define the tree to be fetched. If left out the complete tree is returned.
-//
-// Stability: stable
-func (obj *GenericObject) GetHyperCubeTreeData(ctx context.Context, path string, nodeOptions *NxTreeDataOption) ([]*NxTreeNode, error) {
-	result := &struct {
-		Nodes []*NxTreeNode `json:"qNodes"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeTreeData", result, path, nodeOptions)
-	return result.Nodes, err
-}
-
-// Retrieves data for nodes in a tree structure. It is possible to retrieve specific pages of data.
-// This method works for a treedata object or a hypercube in DATA_MODE_TREE.
-//
-// Parameters:
-//
-// ◾ path          -   Path to the definition of the object to be selected.
-//
-// ◾ nodeOptions   -   Specifies all the paging filters needed to define the tree to be fetched. If left out the complete tree is returned.
-//
-// Stability: stable
-func (obj *GenericObject) GetHyperCubeTreeDataRaw(ctx context.Context, path string, nodeOptions any) (json.RawMessage, error) {
-	result := &struct {
-		Nodes json.RawMessage `json:"qNodes"`
-	}{}
-	err := obj.RPC(ctx, "GetHyperCubeTreeData", result, path, nodeOptions)
-	return result.Nodes, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericObject) GetInfo(ctx context.Context) (*NxInfo, error) {
-	result := &struct {
-		Info *NxInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericObject) GetInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated. A GetLayout call on a generic object, returns up to one level down in the hierarchy.
-//
-// Example::
-//
-// _A_ is a generic object and is the parent of the objects B and C. B is the parent of the objects D and E.
-// A GetLayout call on A returns information on the objects A, B and C.
-// A GetLayout call on B returns information on the objects B, D and E.
-// A  GetLayout call on C returns information on the object C.
-//
-// In addition to the parameters displayed above, the GetLayout method can return other properties according to what is defined in the generic object.
-// For example, if qHyperCubeDef is defined in the generic object, the GetLayout method returns the properties described in HyperCube.
-// Stability: locked
-func (obj *GenericObject) GetLayout(ctx context.Context) (*GenericObjectLayout, error) {
-	result := &struct {
-		Layout *GenericObjectLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated. A GetLayout call on a generic object, returns up to one level down in the hierarchy.
-//
-// Example::
-//
-// _A_ is a generic object and is the parent of the objects B and C. B is the parent of the objects D and E.
-// A GetLayout call on A returns information on the objects A, B and C.
-// A GetLayout call on B returns information on the objects B, D and E.
-// A  GetLayout call on C returns information on the object C.
-//
-// In addition to the parameters displayed above, the GetLayout method can return other properties according to what is defined in the generic object.
-// For example, if qHyperCubeDef is defined in the generic object, the GetLayout method returns the properties described in HyperCube.
-// Stability: locked
-func (obj *GenericObject) GetLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericObject) GetLinkedObjects(ctx context.Context) ([]*NxLinkedObjectInfo, error) {
-	result := &struct {
-		Items []*NxLinkedObjectInfo `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Lists the linked objects to a generic object, a dimension or a measure.
-// Stability: locked
-func (obj *GenericObject) GetLinkedObjectsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Items json.RawMessage `json:"qItems"`
-	}{}
-	err := obj.RPC(ctx, "GetLinkedObjects", result)
-	return result.Items, err
-}
-
-// Retrieves the values of a list object.
-// A data set is returned.
-//
-// Limitations on the number of cells:
-//
-// The maximum for the combined total number of cells across all pages is:
-//
-// • 10,000 cells. If this limit is exceeded, a calc-pages-too-large error with code 7009 is returned.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ pages   -   Array of pages you are interested in.
-//
-// Stability: locked
-func (obj *GenericObject) GetListObjectData(ctx context.Context, path string, pages []*NxPage) ([]*NxDataPage, error) {
-	result := &struct {
-		DataPages []*NxDataPage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetListObjectData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Retrieves the values of a list object.
-// A data set is returned.
-//
-// Limitations on the number of cells:
-//
-// The maximum for the combined total number of cells across all pages is:
-//
-// • 10,000 cells. If this limit is exceeded, a calc-pages-too-large error with code 7009 is returned.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ pages   -   Array of pages you are interested in.
-//
-// Stability: locked
-func (obj *GenericObject) GetListObjectDataRaw(ctx context.Context, path string, pages any) (json.RawMessage, error) {
-	result := &struct {
-		DataPages json.RawMessage `json:"qDataPages"`
-	}{}
-	err := obj.RPC(ctx, "GetListObjectData", result, path, pages)
-	return result.DataPages, err
-}
-
-// Returns the type of the object and the corresponding handle to the parent object in the hiearchy.
-// Stability: locked
-func (obj *GenericObject) GetParent(ctx context.Context) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetParent", result)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns the identifier, the type and the properties of the object.
-// Because it is not mandatory to set all properties when you define an object, the GetProperties method may show properties that were not set. In that case, default values are given.
-// If the object contains some soft properties, the soft properties are not returned by the GetProperties method. Use the GetEffectiveProperties method instead.
-// If the object is linked to another object, the properties of the linking object are not returned by the GetProperties method. Use the GetEffectiveProperties method instead.
-// The properties depends on the generic object type, see [properties](genericobject-layout.html).
-//
-// If the member delta is set to true in the request object, only the delta is retrieved.
-// Stability: locked
-func (obj *GenericObject) GetProperties(ctx context.Context) (*GenericObjectProperties, error) {
-	result := &struct {
-		Prop *GenericObjectProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Returns the identifier, the type and the properties of the object.
-// Because it is not mandatory to set all properties when you define an object, the GetProperties method may show properties that were not set. In that case, default values are given.
-// If the object contains some soft properties, the soft properties are not returned by the GetProperties method. Use the GetEffectiveProperties method instead.
-// If the object is linked to another object, the properties of the linking object are not returned by the GetProperties method. Use the GetEffectiveProperties method instead.
-// The properties depends on the generic object type, see [properties](genericobject-layout.html).
-//
-// If the member delta is set to true in the request object, only the delta is retrieved.
-// Stability: locked
-func (obj *GenericObject) GetPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Returns the type of the object and the corresponding handle.
-// Stability: locked
-func (obj *GenericObject) GetSnapshotObject(ctx context.Context) (*GenericObject, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetSnapshotObject", result)
-	if err != nil {
-		return nil, err
-	}
-	return &GenericObject{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Locks the selected values of a generic object.
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the object.
-// For example, /qListObjectDef .
-//
-// ◾ colIndices   -   Dimension numbers or dimension indexes where the lock should apply.
-// Dimension numbers/indexes start from 0.
-// If this parameter is not set, the selected values in all dimensions are locked.
-//
-// Stability: locked
-func (obj *GenericObject) Lock(ctx context.Context, path string, colIndices []int) error {
-	err := obj.RPC(ctx, "Lock", nil, path, colIndices)
-	return err
-}
-
-// Makes multiple range selections in measures.
-// This method applies to hypercubes. For example, bar charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges                    -   Ranges of selections.
-//
-// ◾ orMode                    -   Applies to hypercubes with multiple measures.
-// If set to true, it means that at least one of the measures must be in the range of selections for the group of measures to be selected.
-// If set to false, it means that all measures must be in the range of selections for the group of measures to be selected.
-// The default value is false.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) MultiRangeSelectHyperCubeValues(ctx context.Context, path string, ranges []*NxMultiRangeSelectInfo, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "MultiRangeSelectHyperCubeValues", result, path, ranges, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// Makes multiple range selections in measures.
-// This method applies to hypercubes. For example, bar charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges                    -   Ranges of selections.
-//
-// ◾ orMode                    -   Applies to hypercubes with multiple measures.
-// If set to true, it means that at least one of the measures must be in the range of selections for the group of measures to be selected.
-// If set to false, it means that all measures must be in the range of selections for the group of measures to be selected.
-// The default value is false.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) MultiRangeSelectHyperCubeValuesRaw(ctx context.Context, path string, ranges any, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "MultiRangeSelectHyperCubeValues", result, path, ranges, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// Stability: stable
-func (obj *GenericObject) MultiRangeSelectTreeDataValues(ctx context.Context, path string, ranges []*NxTreeMultiRangeSelectInfo, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "MultiRangeSelectTreeDataValues", result, path, ranges, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// Stability: stable
-func (obj *GenericObject) MultiRangeSelectTreeDataValuesRaw(ctx context.Context, path string, ranges any, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "MultiRangeSelectTreeDataValues", result, path, ranges, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// Publishes a generic object.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericObject) Publish(ctx context.Context) error {
-	err := obj.RPC(ctx, "Publish", nil)
-	return err
-}
-
-// Makes range selections in measures.
-// This method applies to hypercubes. For example, bar charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges                    -   Ranges of selections.
-//
-// ◾ columnsToSelect           -   Indicates which dimensions to select.
-// The dimensions numbering starts at 0 (first dimension is 0).
-// If the array is empty, all dimensions are selected.
-//
-// ◾ orMode                    -   Applies to hypercubes with multiple measures.
-// If set to true, it means that at least one of the measures must be in the range of selections for the group of measures to be selected.
-// If set to false, it means that all measures must be in the range of selections for the group of measures to be selected.
-// The default value is false.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) RangeSelectHyperCubeValues(ctx context.Context, path string, ranges []*NxRangeSelectInfo, columnsToSelect []int, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "RangeSelectHyperCubeValues", result, path, ranges, columnsToSelect, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// Makes range selections in measures.
-// This method applies to hypercubes. For example, bar charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges                    -   Ranges of selections.
-//
-// ◾ columnsToSelect           -   Indicates which dimensions to select.
-// The dimensions numbering starts at 0 (first dimension is 0).
-// If the array is empty, all dimensions are selected.
-//
-// ◾ orMode                    -   Applies to hypercubes with multiple measures.
-// If set to true, it means that at least one of the measures must be in the range of selections for the group of measures to be selected.
-// If set to false, it means that all measures must be in the range of selections for the group of measures to be selected.
-// The default value is false.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) RangeSelectHyperCubeValuesRaw(ctx context.Context, path string, ranges any, columnsToSelect []int, orMode bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "RangeSelectHyperCubeValues", result, path, ranges, columnsToSelect, orMode, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// You can use the RemoveGroup method with any object that contains an object grouping definition.
-// This method allows you to remove a group of objects directly.
-// This action only removes the group, not any of its members.
-//
-// Parameters:
-//
-// ◾ path      -   Path to the definition of the object to be selected.
-// For example /qNxGroupDef .
-//
-// ◾ groupId   -   Name of the group to be removed.
-// May not be an empty string.
-//
-// Stability: experimental
-func (obj *GenericObject) RemoveGroup(ctx context.Context, path string, groupId string) error {
-	err := obj.RPC(ctx, "RemoveGroup", nil, path, groupId)
-	return err
-}
-
-// You can use the RemoveGroupMembers method with any object that contains an object grouping definition.
-// This method allows you to remove one or more members from an existing group of objects directly.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example /qNxGroupDef .
-//
-// ◾ members         -   Array of IDs for the objects and/or subgroups to remove from the group.
-//
-// ◾ targetGroupId   -   Name of the group the Members will be removed from (if not the called object).
-//
-// Stability: experimental
-func (obj *GenericObject) RemoveGroupMembers(ctx context.Context, path string, members []string, targetGroupId string) error {
-	err := obj.RPC(ctx, "RemoveGroupMembers", nil, path, members, targetGroupId)
-	return err
-}
-
-// Resets all selections made in selection mode.
-// Stability: locked
-func (obj *GenericObject) ResetMadeSelections(ctx context.Context) error {
-	err := obj.RPC(ctx, "ResetMadeSelections", nil)
-	return err
-}
-
-// Searches for a string in a list object.
-// This method applies to list objects (objects with one dimension).
-// The search results can be displayed using the GetLayout Method.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ path    -   Path to the definition of the list object.
-// For example, /qListObjectDef .
-//
-// ◾ match   -   Search string.
-// Wild card characters are allowed. The search is not case sensitive.
-// Examples:
-//
-// • `P*U*`: retrieves only values that start with P and contain U
-//
-// • `P U S`: retrieves values that start with P, U or S
-//
-// Stability: locked
-func (obj *GenericObject) SearchListObjectFor(ctx context.Context, path string, match string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SearchListObjectFor", result, path, match)
-	return result.Success, err
-}
-
-// Makes selections in multiple dimensions and measures.
-// This method applies to hypercubes, such as bar charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ rowIndices                -   Array of row indexes to select, starting from 0.
-// If the array is empty [ ] , all rows are selected.
-//
-// ◾ colIndices                -   Indexes of the columns to select, starting from 0.
-// A column corresponds to a dimension in the order they are added to the hypercube.
-// If a column is hidden it is ignored, qColIndex n refers to the n:th visible column (starting from zero).
-// Example:
-// If the hypercube has two dimensions:
-//
-// • [0] selects the first column (i.e the first dimension).
-//
-// • [1] selects the second column (i.e the second dimension).
-//
-// If the array is empty [ ] , all columns are selected.
-//
-// ◾ softLock                  -   Set to true to ignore locks; in that case, locked fields can be selected.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectHyperCubeCells(ctx context.Context, path string, rowIndices []int, colIndices []int, softLock bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectHyperCubeCells", result, path, rowIndices, colIndices, softLock, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// The following is returned in the output:
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges     -   Selects ranges in a hypercube in (Ranges[N].Min,Ranges[N].Max) intervals.
-// If either Ranges[N].MinInclEq or Ranges[N].MaxInclEq, or both flags are set to true then Min and Max values will be selected.
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectHyperCubeContinuousRange(ctx context.Context, path string, ranges []*NxContinuousRangeSelectInfo, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectHyperCubeContinuousRange", result, path, ranges, softLock)
-	return result.Success, err
-}
-
-// The following is returned in the output:
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges     -   Selects ranges in a hypercube in (Ranges[N].Min,Ranges[N].Max) intervals.
-// If either Ranges[N].MinInclEq or Ranges[N].MaxInclEq, or both flags are set to true then Min and Max values will be selected.
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectHyperCubeContinuousRangeRaw(ctx context.Context, path string, ranges any, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectHyperCubeContinuousRange", result, path, ranges, softLock)
-	return result.Success, err
-}
-
-// Selects some values in one dimension.
-// The values are identified by their element numbers.
-// This method applies to charts, tables and scatter plots.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ dimNo        -   Dimension number or index to select.
-// Dimension numbers/index start from 0.
-//
-// ◾ values       -   Element numbers of the field to select.
-// You can select multiple elements; the separator is the comma.
-//
-// ◾ toggleMode   -   Set to true to toggle.
-//
-// Stability: locked
-func (obj *GenericObject) SelectHyperCubeValues(ctx context.Context, path string, dimNo int, values []int, toggleMode bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectHyperCubeValues", result, path, dimNo, values, toggleMode)
-	return result.Success, err
-}
-
-// Selects all values of a field.
-// This method applies to list objects (objects with one dimension).
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectAll(ctx context.Context, path string, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectAll", result, path, softLock)
-	return result.Success, err
-}
-
-// Selects all alternative values in a specific field.
-// This method applies to list objects (objects with one dimension).
-// If a field contains at least one selected value, the values that are neither selected nor excluded are alternatives values.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectAlternative(ctx context.Context, path string, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectAlternative", result, path, softLock)
-	return result.Success, err
-}
-
-// The following is returned in the output:
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges     -   Selects ranges in a hypercube in (Ranges[N].Min,Ranges[N].Max) intervals.
-// If either Ranges[N].MinInclEq or Ranges[N].MaxInclEq, or both flags are set to true then Min and Max values will be selected.
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectContinuousRange(ctx context.Context, path string, ranges []*Range, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectContinuousRange", result, path, ranges, softLock)
-	return result.Success, err
-}
-
-// The following is returned in the output:
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ ranges     -   Selects ranges in a hypercube in (Ranges[N].Min,Ranges[N].Max) intervals.
-// If either Ranges[N].MinInclEq or Ranges[N].MaxInclEq, or both flags are set to true then Min and Max values will be selected.
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectContinuousRangeRaw(ctx context.Context, path string, ranges any, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectContinuousRange", result, path, ranges, softLock)
-	return result.Success, err
-}
-
-// Inverts the current selections in a specific field.
-// This method applies to list objects (objects with one dimension).
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectExcluded(ctx context.Context, path string, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectExcluded", result, path, softLock)
-	return result.Success, err
-}
-
-// Selects all possible values of a list object.
-// This method applies to list objects (objects with one dimension).
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ softLock   -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectPossible(ctx context.Context, path string, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectPossible", result, path, softLock)
-	return result.Success, err
-}
-
-// Makes single selections in dimensions.
-// This method applies to list objects only.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the object to be selected.
-// For example, /qListObjectDef .
-//
-// ◾ values       -   Element numbers to select.
-// You can select multiple values; the separator is the comma.
-//
-// ◾ toggleMode   -   Set to true to toggle.
-//
-// ◾ softLock     -   Set to true to ignore locks; in that case, locked fields can be selected.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectListObjectValues(ctx context.Context, path string, values []int, toggleMode bool, softLock bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectListObjectValues", result, path, values, toggleMode, softLock)
-	return result.Success, err
-}
-
-// This method only applies to hypercubes that are not represented as straight tables. The parameter qMode in HyperCubeDef must be set either to P  or K .
-//
-// Pivot table:
-//
-// Makes selections in the top or left dimension cells of a pivot table or in the data matrix. Only expanded dimensions can be selected.
-//
-// Stacked table:
-//
-// Makes selections in the left dimension cells of a stacked table or in the data matrix.
-// There is no top dimensions in a stacked table. A stacked table can only contain one measure.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ selections                -   Information about the selections to perform.
-//
-// ◾ softLock                  -   Set to true to ignore locks; in that case, locked fields can be selected.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectPivotCells(ctx context.Context, path string, selections []*NxSelectionCell, softLock bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectPivotCells", result, path, selections, softLock, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// This method only applies to hypercubes that are not represented as straight tables. The parameter qMode in HyperCubeDef must be set either to P  or K .
-//
-// Pivot table:
-//
-// Makes selections in the top or left dimension cells of a pivot table or in the data matrix. Only expanded dimensions can be selected.
-//
-// Stacked table:
-//
-// Makes selections in the left dimension cells of a stacked table or in the data matrix.
-// There is no top dimensions in a stacked table. A stacked table can only contain one measure.
-//
-// The member Change returns the handles of the objects that are updated following the selections.
-// _qSuccess_ is set to true if the selections are successful and is set to false in the following cases:
-//
-// • The object contains some invalid fields (fields that are not in the data model).
-//
-// • The selection applies to a locked field.
-//
-// • A range selection is performed and the parameter OneAndOnlyOne is set to true in the definition of the object.
-//
-// Parameters:
-//
-// ◾ path                      -   Path to the definition of the object.
-// For example, /qHyperCubeDef .
-//
-// ◾ selections                -   Information about the selections to perform.
-//
-// ◾ softLock                  -   Set to true to ignore locks; in that case, locked fields can be selected.
-//
-// ◾ deselectOnlyOneSelected   -   Set this parameter to true to unselect the last single selected value. There must be only one selected value in the field.
-// The default value is false.
-//
-// Stability: locked
-func (obj *GenericObject) SelectPivotCellsRaw(ctx context.Context, path string, selections any, softLock bool, deselectOnlyOneSelected bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "SelectPivotCells", result, path, selections, softLock, deselectOnlyOneSelected)
-	return result.Success, err
-}
-
-// You can use the SetActiveField method with any object that contains a cyclic group as a dimension.
-// This method allows you to jump to a specific field in a cyclic dimension. If NewIndex is out-of-bounds of the dimension's fields then no action is taken.
-// A hypercube will avoid field collisions with its other dimensions when setting the active field in this manner. If there are any collisions then no action is performed.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ dimNo      -   Dimension number or index starting from 0.
-// The default value is 0.
-//
-// ◾ newIndex   -   Index of the field to jump to.
-//
-// Stability: experimental
-func (obj *GenericObject) SetActiveField(ctx context.Context, path string, dimNo int, newIndex int) error {
-	err := obj.RPC(ctx, "SetActiveField", nil, path, dimNo, newIndex)
-	return err
-}
-
-// Sets the order of the children in a generic object.
-// To change the order of the children in a generic object, the identifiers of all the children must be included in the list of the identifiers (in qIds ).
-//
-// Parameters:
-//
-// ◾ ids   -   List of the children identifiers.
-//
-// Stability: locked
-func (obj *GenericObject) SetChildArrayOrder(ctx context.Context, ids []string) error {
-	err := obj.RPC(ctx, "SetChildArrayOrder", nil, ids)
-	return err
-}
-
-// Sets the properties of:
-//
-// • A generic object.
-//
-// • The children of the generic object.
-//
-// • The bookmarks/embedded snapshots of the generic object.
-//
-// If the SetFullPropertyTree method is asked to set some properties to a child that does not exist, it creates the child.
-// The type of an object cannot be updated.
-//
-// Parameters:
-//
-// ◾ propEntry   -   Information about the generic object entry.
-//
-// Stability: locked
-func (obj *GenericObject) SetFullPropertyTree(ctx context.Context, propEntry *GenericObjectEntry) error {
-	err := obj.RPC(ctx, "SetFullPropertyTree", nil, propEntry)
-	return err
-}
-
-// Sets the properties of:
-//
-// • A generic object.
-//
-// • The children of the generic object.
-//
-// • The bookmarks/embedded snapshots of the generic object.
-//
-// If the SetFullPropertyTree method is asked to set some properties to a child that does not exist, it creates the child.
-// The type of an object cannot be updated.
-//
-// Parameters:
-//
-// ◾ propEntry   -   Information about the generic object entry.
-//
-// Stability: locked
-func (obj *GenericObject) SetFullPropertyTreeRaw(ctx context.Context, propEntry any) error {
-	err := obj.RPC(ctx, "SetFullPropertyTree", nil, propEntry)
-	return err
-}
-
-// You can use the SetGroupLabel method with any object that contains an object grouping definition.
-// This method allows you to change the label of an existing group of objects directly.
-//
-// Parameters:
-//
-// ◾ path            -   Path to the definition of the object to be selected.
-// For example /qNxGroupDef .
-//
-// ◾ newLabel        -   New label for the group.
-// A label starting with an '=' will be evaluated as an expression.
-//
-// ◾ targetGroupId   -   Id of the group whose label will be set (if not the called object).
-//
-// Stability: experimental
-func (obj *GenericObject) SetGroupLabel(ctx context.Context, path string, newLabel string, targetGroupId string) error {
-	err := obj.RPC(ctx, "SetGroupLabel", nil, path, newLabel, targetGroupId)
-	return err
-}
-
-// Sets some properties for a generic object.
-// The properties depends on the generic object type, see [properties](genericobject-property.html).
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the generic object.
-//
-// Stability: locked
-func (obj *GenericObject) SetProperties(ctx context.Context, prop *GenericObjectProperties) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets some properties for a generic object.
-// The properties depends on the generic object type, see [properties](genericobject-property.html).
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the generic object.
-//
-// Stability: locked
-func (obj *GenericObject) SetPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// You can use the StepCycle method with any object that contains a cyclic group as a dimension.
-// This method allows you to move between different fields in a cyclic dimension.
-// A hypercube will avoid field collisions with its other dimensions when cycling in this manner. If all other fields cause collisions then no cycling is performed.
-//
-// Parameters:
-//
-// ◾ path       -   Path to the definition of the object to be selected.
-// For example, /qHyperCubeDef .
-//
-// ◾ dimNo      -   Dimension number or index starting from 0.
-// The default value is 0.
-//
-// ◾ nbrSteps   -   Number of steps you want to cycle.
-// Positive values cycle forwards while negative values cycle backwards. A value of 0 leads to no action being taken.
-//
-// Stability: experimental
-func (obj *GenericObject) StepCycle(ctx context.Context, path string, dimNo int, nbrSteps int) error {
-	err := obj.RPC(ctx, "StepCycle", nil, path, dimNo, nbrSteps)
-	return err
-}
-
-// Removes the generic object from the list of approved objects
-// This operation is possible only in Qlik Sense Enterprise.
-// Stability: locked
-func (obj *GenericObject) UnApprove(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnApprove", nil)
-	return err
-}
-
-// Unpublishes a generic object.
-// This operation is not applicable for Qlik Sense Desktop.
-// Stability: locked
-func (obj *GenericObject) UnPublish(ctx context.Context) error {
-	err := obj.RPC(ctx, "UnPublish", nil)
-	return err
-}
-
-// Unlocks the selected values of a generic object if the target (or handle ) is a generic object
-//
-// Parameters:
-//
-// ◾ path         -   Path to the definition of the object.
-// For example, /qListObjectDef .
-//
-// ◾ colIndices   -   Dimension numbers/indexes where the unlock should apply.
-// Dimension numbers/indexes start from 0.
-// If this parameter is not set, the locked values in all dimensions are unlocked.
-//
-// Stability: locked
-func (obj *GenericObject) Unlock(ctx context.Context, path string, colIndices []int) error {
-	err := obj.RPC(ctx, "Unlock", nil, path, colIndices)
-	return err
-}
-
-type GenericVariable struct {
-	*RemoteObject
-}
-
-// Applies a patch to the properties of a variable. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericVariable) ApplyPatches(ctx context.Context, patches []*NxPatch) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Applies a patch to the properties of a variable. Allows an update to some of the properties. It should not be possible to patch "/qInfo/qId",
-// and it will be forbidden in the near future.
-// Applying a patch takes less time than resetting all the properties.
-//
-// Parameters:
-//
-// ◾ patches   -   Array of patches.
-//
-// Stability: locked
-func (obj *GenericVariable) ApplyPatchesRaw(ctx context.Context, patches any) error {
-	err := obj.RPC(ctx, "ApplyPatches", nil, patches)
-	return err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericVariable) GetInfo(ctx context.Context) (*NxInfo, error) {
-	result := &struct {
-		Info *NxInfo `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Returns the type and identifier of the object.
-// Stability: locked
-func (obj *GenericVariable) GetInfoRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Info json.RawMessage `json:"qInfo"`
-	}{}
-	err := obj.RPC(ctx, "GetInfo", result)
-	return result.Info, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated.
-// Stability: locked
-func (obj *GenericVariable) GetLayout(ctx context.Context) (*GenericVariableLayout, error) {
-	result := &struct {
-		Layout *GenericVariableLayout `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Evaluates an object and displays its properties including the dynamic properties.
-// If the member delta is set to true in the request object, only the delta is evaluated.
-// Stability: locked
-func (obj *GenericVariable) GetLayoutRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Layout json.RawMessage `json:"qLayout"`
-	}{}
-	err := obj.RPC(ctx, "GetLayout", result)
-	return result.Layout, err
-}
-
-// Shows the properties of an object.
-// If the member delta is set to true in the request, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericVariable) GetProperties(ctx context.Context) (*GenericVariableProperties, error) {
-	result := &struct {
-		Prop *GenericVariableProperties `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Shows the properties of an object.
-// If the member delta is set to true in the request, only the delta is retrieved.
-//
-// The following is always returned in the output:
-// Stability: locked
-func (obj *GenericVariable) GetPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Prop json.RawMessage `json:"qProp"`
-	}{}
-	err := obj.RPC(ctx, "GetProperties", result)
-	return result.Prop, err
-}
-
-// Returns the raw value of a variable.
-// Stability: locked
-func (obj *GenericVariable) GetRawContent(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetRawContent", result)
-	return result.Return, err
-}
-
-// Sets the value of a dual variable.
-// These changes are not persistent. They only last the duration of the engine session.
-//
-// Parameters:
-//
-// ◾ text   -   String representation of a dual value. Set this parameter to "", if the string representation is to be Null.
-//
-// ◾ num    -   Numeric representation of a dual value.
-//
-// Stability: locked
-func (obj *GenericVariable) SetDualValue(ctx context.Context, text string, num Float64) error {
-	err := obj.RPC(ctx, "SetDualValue", nil, text, num)
-	return err
-}
-
-// Sets a numerical value to a variable.
-// These changes are not persistent. They only last the duration of the engine session.
-//
-// Parameters:
-//
-// ◾ val   -   Value of the variable.
-//
-// Stability: locked
-func (obj *GenericVariable) SetNumValue(ctx context.Context, val Float64) error {
-	err := obj.RPC(ctx, "SetNumValue", nil, val)
-	return err
-}
-
-// Sets some properties for a variable.
-// The identifier of a variable cannot be modified.
-// You cannot update the properties of a script-defined variable using the SetProperties method.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the variable.
-//
-// Stability: locked
-func (obj *GenericVariable) SetProperties(ctx context.Context, prop *GenericVariableProperties) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets some properties for a variable.
-// The identifier of a variable cannot be modified.
-// You cannot update the properties of a script-defined variable using the SetProperties method.
-//
-// Parameters:
-//
-// ◾ prop   -   Information about the variable.
-//
-// Stability: locked
-func (obj *GenericVariable) SetPropertiesRaw(ctx context.Context, prop any) error {
-	err := obj.RPC(ctx, "SetProperties", nil, prop)
-	return err
-}
-
-// Sets a string value to a variable.
-// These changes are not persistent. They only last the duration of the engine session.
-//
-// Parameters:
-//
-// ◾ val   -   Value of the variable. The string can contain an expression.
-//
-// Stability: locked
-func (obj *GenericVariable) SetStringValue(ctx context.Context, val string) error {
-	err := obj.RPC(ctx, "SetStringValue", nil, val)
-	return err
-}
-
-type Global struct {
-	*RemoteObject
-}
-
-// Sets an abort flag on all pending and ongoing requests in the current engine session.
-//
-// • If an abort flag is set on a pending request, the request is aborted.
-//
-// • If an abort flag is set on an ongoing request, the engine checks to see if it is possible to abort the request.
-// Stability: locked
-func (obj *Global) AbortAll(ctx context.Context) error {
-	err := obj.RPC(ctx, "AbortAll", nil)
-	return err
-}
-
-// Sets an abort flag on a specific request in the current engine session.
-//
-// • If an abort flag is set on a pending request, the request is aborted.
-//
-// • If an abort flag is set on an ongoing request, the engine checks to see if it is possible to abort the request.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of request to abort.
-//
-// Stability: locked
-func (obj *Global) AbortRequest(ctx context.Context, requestId int) error {
-	err := obj.RPC(ctx, "AbortRequest", nil, requestId)
-	return err
-}
-
-// Indicates whether or not a user is able to create an app.
-// Stability: locked
-func (obj *Global) AllowCreateApp(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "AllowCreateApp", result)
-	return result.Return, err
-}
-
-// Cancels an ongoing reload. The reload of the app is stopped. The indexation can be canceled and true is still the return value of the reload task.
-//
-// Parameters:
-//
-// ◾ reason   -   Reason for why the reload was cancelled. This will be echoed back to the user in the script log.
-//
-// Stability: locked
-func (obj *Global) CancelReload(ctx context.Context, reason string) error {
-	err := obj.RPC(ctx, "CancelReload", nil, reason)
-	return err
-}
-
-// Cancels an ongoing request. The request is stopped.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the request to stop.
-//
-// Stability: locked
-func (obj *Global) CancelRequest(ctx context.Context, requestId int) error {
-	err := obj.RPC(ctx, "CancelRequest", nil, requestId)
-	return err
-}
-
-// Configures the engine's behavior during a reload.
-// The ConfigureReload method should be run before the DoReload method.
-//
-// Parameters:
-//
-// ◾ cancelOnScriptError   -   If set to true, the script execution is halted on error.
-// Otherwise, the engine continues the script execution.
-// This parameter is relevant only if the variable ErrorMode is set to 1.
-//
-// ◾ useErrorData          -   If set to true, any script execution error is returned in qErrorData by the GetProgress method.
-//
-// ◾ interactOnError       -   If set to true, the script execution is halted on error and the engine is waiting for an interaction to be performed. If the result from the interaction is 1 (_qDef.qResult_ is 1), the engine continues the script execution otherwise the execution is halted.
-// This parameter is relevant only if the variable ErrorMode is set to 1 and the script is run in debug mode (_qDebug_ is set to true when calling the DoReload method).
-//
-// Stability: locked
-func (obj *Global) ConfigureReload(ctx context.Context, cancelOnScriptError bool, useErrorData bool, interactOnError bool) error {
-	err := obj.RPC(ctx, "ConfigureReload", nil, cancelOnScriptError, useErrorData, interactOnError)
-	return err
-}
-
-// Copies an app that is in the Qlik Sense repository.
-// The engine copies the app into an app entity that was previously created by the repository.
-// This operation is possible only in Qlik Sense Enterprise.
-//
-// Parameters:
-//
-// ◾ targetAppId   -   Identifier (GUID) of the app entity in the Qlik Sense repository.
-// The app entity must have been previously created by the Qlik Sense Repository Service (QRS) API.
-//
-// ◾ srcAppId      -   Identifier (GUID) of the source app in the Qlik Sense repository.
-//
-// ◾ ids           -   Array of QRS identifiers.
-// The list of all objects in the app to be copied must be given. This list must contain the GUIDs of all these objects.
-// If the list of the QRS identifiers is empty, the CopyApp method copies all objects to the target app.
-// Script-defined variables are automatically copied when copying an app. To be able to copy variables not created via script, the GUID of each variable must be provided in the list of QRS identifiers.
-// To get the QRS identifiers of the objects in an app, you can use the QRS API. The GET method (from the QRS API) returns the identifiers of the objects in the app.
-// The following example returns the QRS identifiers of all the objects in a specified app:
-// GET /qrs/app/9c3f8634-6191-4a34-a114-a39102058d13
-// Where
-// _9c3f8634-6191-4a34-a114-a39102058d13_ is the identifier of the app.
-//
-// Stability: locked
-func (obj *Global) CopyApp(ctx context.Context, targetAppId string, srcAppId string, ids []string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "CopyApp", result, targetAppId, srcAppId, ids)
-	return result.Success, err
-}
-
-// Creates an app.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+----------------------------------------+---------------------------------+
-//	|           AUDIT ACTIVITY LOG           |       SYSTEM SERVICE LOG        |
-//	+----------------------------------------+---------------------------------+
-//	| <MachineName>_AuditActivity_Engine.txt | <MachineName>_ServiceEngine.txt |
-//	| in Qlik Sense Enterprise               | in Qlik Sense Enterprise        |
-//	| <MachineName>_AuditActivity_Engine.log | <MachineName>_ServiceEngine.log |
-//	| in Qlik Sense Desktop                  | in Qlik Sense Desktop           |
-//	+----------------------------------------+---------------------------------+
-//
-// Where to find the log files:
-//
-// The location of the log files depends on whether you have installed Qlik Sense Enterprise or Qlik Sense Desktop.
-//
-//	+-------------------------------------+----------------------------------------+
-//	|        QLIK SENSE ENTERPRISE        |           QLIK SENSE DESKTOP           |
-//	+-------------------------------------+----------------------------------------+
-//	| %ProgramData%/Qlik/Sense/Log/Engine | %UserProfile%/Documents/Qlik/Sense/Log |
-//	+-------------------------------------+----------------------------------------+
-//
-// Parameters:
-//
-// ◾ appName                      -   Name of the app.
-//
-// ◾ localizedScriptMainSection   -   Name of the first section in the script editor.
-// The default value is Main.
-//
-// ◾ locale                       -   Set custom locale for the app instead of system default.
-//
-// Stability: locked
-func (obj *Global) CreateApp(ctx context.Context, appName string, localizedScriptMainSection string, locale string) (bool, string, error) {
-	result := &struct {
-		Success bool   `json:"qSuccess"`
-		AppId   string `json:"qAppId"`
-	}{}
-	err := obj.RPC(ctx, "CreateApp", result, appName, localizedScriptMainSection, locale)
-	return result.Success, result.AppId, err
-}
-
-// Creates an app and opens an engine session.
-// This operation is possible only in Qlik Sense Desktop.
-//
-// Parameters:
-//
-// ◾ docName                      -   Name of the app.
-//
-// ◾ userName                     -   Name of the user.
-//
-// ◾ password                     -   Password of the user.
-//
-// ◾ serial                       -   Current Qlik Sense serial number.
-//
-// ◾ localizedScriptMainSection   -   Name of the first section in the script editor.
-// The default value is Main.
-//
-// Stability: locked
-func (obj *Global) CreateDocEx(ctx context.Context, docName string, userName string, password string, serial string, localizedScriptMainSection string) (*Doc, error) {
-	result := &struct {
-		DocId  string           `json:"qDocId"`
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateDocEx", result, docName, userName, password, serial, localizedScriptMainSection)
-	if err != nil {
-		return nil, err
-	}
-	result.Return.GenericId = result.DocId
-	return &Doc{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates an empty session app.
-// The following applies:
-//
-// • The name of a session app cannot be chosen. The engine automatically assigns a unique identifier to the session app.
-//
-// • A session app is not persisted and cannot be saved. Everything created during a session app is non-persisted; for example: objects, data connections.
-// Stability: locked
-func (obj *Global) CreateSessionApp(ctx context.Context) (*Doc, error) {
-	result := &struct {
-		SessionAppId string           `json:"qSessionAppId"`
-		Return       *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionApp", result)
-	if err != nil {
-		return nil, err
-	}
-	result.Return.GenericId = result.SessionAppId
-	return &Doc{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Creates a session app from a source app.
-// The following applies:
-//
-// • The objects in the source app are copied into the session app.
-//
-// • There is no data in the session app, unless it is reloaded.
-//
-// • The script of the session app can be edited and reloaded.
-//
-// • The name of a session app cannot be chosen. The engine automatically assigns a unique identifier to the session app.
-//
-// • A session app is not persisted and cannot be saved. Everything created during a session app is non-persisted; for example: objects, data connections.
-//
-// Parameters:
-//
-// ◾ srcAppId   -   App identifier of the source app.
-// It corresponds to qAppId returned by the CreateApp method when creating the source app.
-//
-// Stability: locked
-func (obj *Global) CreateSessionAppFromApp(ctx context.Context, srcAppId string) (*Doc, error) {
-	result := &struct {
-		SessionAppId string           `json:"qSessionAppId"`
-		Return       *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "CreateSessionAppFromApp", result, srcAppId)
-	if err != nil {
-		return nil, err
-	}
-	result.Return.GenericId = result.SessionAppId
-	return &Doc{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Deletes an app from the Qlik Sense repository or from the file system.
-//
-// Qlik Sense Enterprise:
-//
-// In addition to being removed from the repository, the app is removed from the directory as well:
-//
-// •<installation_directory>\Qlik\Sense\Apps*
-// The default installation directory is ProgramData.
-//
-// Qlik Sense Desktop:
-//
-// The app is deleted from the directory %userprofile%\Documents\Qlik\ \Apps.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+--------------------------------------+--------------------------------+
-//	|          AUDIT ACTIVITY LOG          |       SYSTEM SERVICE LOG       |
-//	+--------------------------------------+--------------------------------+
-//	| <MachineName>AuditActivityEngine.txt | <MachineName>ServiceEngine.txt |
-//	| in Qlik Sense Enterprise             | in Qlik Sense Enterprise       |
-//	| <MachineName>AuditActivityEngine.log | <MachineName>ServiceEngine.log |
-//	| in Qlik Sense Desktop                | in Qlik Sense Desktop          |
-//	+--------------------------------------+--------------------------------+
-//
-// Where to find the log files:
-//
-// The location of the log files depends on whether you have installed Qlik Sense Enterprise or Qlik Sense Desktop.
-//
-//	+-------------------------------------+----------------------------------------+
-//	|        QLIK SENSE ENTERPRISE        |           QLIK SENSE DESKTOP           |
-//	+-------------------------------------+----------------------------------------+
-//	| %ProgramData%/Qlik/Sense/Log/Engine | %UserProfile%/Documents/Qlik/Sense/Log |
-//	+-------------------------------------+----------------------------------------+
-//
-// Parameters:
-//
-// ◾ appId   -   Identifier of the app to delete.
-// In Qlik Sense Enterprise, the identifier of the app is a GUID in the Qlik Sense repository.
-// In Qlik Sense Desktop, the identifier of the app is the name of the app, as defined in the apps folder %userprofile%\Documents\Qlik\Sense\Apps.
-//
-// Stability: locked
-func (obj *Global) DeleteApp(ctx context.Context, appId string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "DeleteApp", result, appId)
-	return result.Success, err
-}
-
-// Returns the version number of the Qlik engine component.
-// Stability: locked
-func (obj *Global) EngineVersion(ctx context.Context) (*NxEngineVersion, error) {
-	result := &struct {
-		Version *NxEngineVersion `json:"qVersion"`
-	}{}
-	err := obj.RPC(ctx, "EngineVersion", result)
-	return result.Version, err
-}
-
-// Returns the version number of the Qlik engine component.
-// Stability: locked
-func (obj *Global) EngineVersionRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Version json.RawMessage `json:"qVersion"`
-	}{}
-	err := obj.RPC(ctx, "EngineVersion", result)
-	return result.Version, err
-}
-
-// Exports an app from the Qlik Sense repository to the file system.
-// This operation is possible only in Qlik Sense Enterprise.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Logs:
-//
-// When this method is called, audit activity logs are produced to track the user activity.
-// In the case of errors, both audit activity logs and system services logs are produced.
-// The log files are named as follows:
-//
-//	+-----------------------------------------+-----------------------------------+
-//	|           AUDIT ACTIVITY LOG            |        SYSTEM SERVICE LOG         |
-//	+-----------------------------------------+-----------------------------------+
-//	| *<MachineName>_AuditActivityEngine.txt* | *<MachineName>_ServiceEngine.txt* |
-//	+-----------------------------------------+-----------------------------------+
-//
-// The log files are located in:
-// _%ProgramData%/Qlik/Sense/Log/Engine_
-//
-// Parameters:
-//
-// ◾ targetPath   -   Path and name of the target app.
-//
-// ◾ srcAppId     -   Identifier of the source app. The identifier is a GUID from the Qlik Sense repository.
-//
-// ◾ ids          -   Array of identifiers.
-// The list of all the objects in the app to be exported must be given. This list must contain the GUIDs of all these objects.
-//
-// ◾ noData       -   Set this parameter to true if the data should be omitted in the exported app.
-//
-// Stability: locked
-func (obj *Global) ExportApp(ctx context.Context, targetPath string, srcAppId string, ids []string, noData bool) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "ExportApp", result, targetPath, srcAppId, ids, noData)
-	return result.Success, err
-}
-
-// Returns the handle of the current app.
-// Stability: locked
-func (obj *Global) GetActiveDoc(ctx context.Context) (*Doc, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetActiveDoc", result)
-	if err != nil {
-		return nil, err
-	}
-	return &Doc{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Retrieves the meta data of an app.
-//
-// Parameters:
-//
-// ◾ appID   -   Identifier of the app, as returned by the CreateApp method.
-// One of:
-//
-// • Path and name of the app (Qlik Sense Desktop)
-//
-// • GUID (Qlik Sense Enterprise)
-//
-// Stability: locked
-func (obj *Global) GetAppEntry(ctx context.Context, appID string) (*AppEntry, error) {
-	result := &struct {
-		Entry *AppEntry `json:"qEntry"`
-	}{}
-	err := obj.RPC(ctx, "GetAppEntry", result, appID)
-	return result.Entry, err
-}
-
-// Retrieves the meta data of an app.
-//
-// Parameters:
-//
-// ◾ appID   -   Identifier of the app, as returned by the CreateApp method.
-// One of:
-//
-// • Path and name of the app (Qlik Sense Desktop)
-//
-// • GUID (Qlik Sense Enterprise)
-//
-// Stability: locked
-func (obj *Global) GetAppEntryRaw(ctx context.Context, appID string) (json.RawMessage, error) {
-	result := &struct {
-		Entry json.RawMessage `json:"qEntry"`
-	}{}
-	err := obj.RPC(ctx, "GetAppEntry", result, appID)
-	return result.Entry, err
-}
-
-// Retrieves information about the authenticated user.
-// Stability: locked
-func (obj *Global) GetAuthenticatedUser(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetAuthenticatedUser", result)
-	return result.Return, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language. The BNF rules define the syntax for the script statements and the script or chart functions.
-// In the Qlik engine BNF grammar, a token is a string of one or more characters that is significant as a group. For example, a token could be a function name, a number, a letter, a parenthesis, and so on.
-//
-// Parameters:
-//
-// ◾ bnfType   -   Returns a set of rules defining the syntax for:
-//
-// • The script statements and the script functions if qBnfType is set to S.
-//
-// • The chart functions if qBnfType is set to E.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Deprecated: Use the _GetBaseBNF_ method instead
-// Stability: locked
-func (obj *Global) GetBNF(ctx context.Context, bnfType string) ([]*BNFDef, error) {
-	result := &struct {
-		BnfDefs []*BNFDef `json:"qBnfDefs"`
-	}{}
-	err := obj.RPC(ctx, "GetBNF", result, bnfType)
-	return result.BnfDefs, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language. The BNF rules define the syntax for the script statements and the script or chart functions.
-// In the Qlik engine BNF grammar, a token is a string of one or more characters that is significant as a group. For example, a token could be a function name, a number, a letter, a parenthesis, and so on.
-//
-// Parameters:
-//
-// ◾ bnfType   -   Returns a set of rules defining the syntax for:
-//
-// • The script statements and the script functions if qBnfType is set to S.
-//
-// • The chart functions if qBnfType is set to E.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Deprecated: Use the _GetBaseBNF_ method instead
-// Stability: locked
-func (obj *Global) GetBNFRaw(ctx context.Context, bnfType string) (json.RawMessage, error) {
-	result := &struct {
-		BnfDefs json.RawMessage `json:"qBnfDefs"`
-	}{}
-	err := obj.RPC(ctx, "GetBNF", result, bnfType)
-	return result.BnfDefs, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language, as well as a string hash calculated from that grammar. The BNF rules define the syntax for the script statements and the script or chart functions. If the hash changes between subsequent calls to this method, this indicates that the BNF has changed.
-// In the Qlik engine grammars, a token is a string of one or more characters that is significant as a group. For example, a token could be a function name, a number, a letter, a parenthesis, and so on.
-//
-// Parameters:
-//
-// ◾ bnfType   -   The type of grammar to return:
-//
-// • The script statements and the script functions if qBnfType is set to S.
-//
-// • The chart functions if qBnfType is set to E.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Stability: locked
-func (obj *Global) GetBaseBNF(ctx context.Context, bnfType string) ([]*BNFDef, string, error) {
-	result := &struct {
-		BnfDefs []*BNFDef `json:"qBnfDefs"`
-		BnfHash string    `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetBaseBNF", result, bnfType)
-	return result.BnfDefs, result.BnfHash, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language, as well as a string hash calculated from that grammar. The BNF rules define the syntax for the script statements and the script or chart functions. If the hash changes between subsequent calls to this method, this indicates that the BNF has changed.
-// In the Qlik engine grammars, a token is a string of one or more characters that is significant as a group. For example, a token could be a function name, a number, a letter, a parenthesis, and so on.
-//
-// Parameters:
-//
-// ◾ bnfType   -   The type of grammar to return:
-//
-// • The script statements and the script functions if qBnfType is set to S.
-//
-// • The chart functions if qBnfType is set to E.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Stability: locked
-func (obj *Global) GetBaseBNFRaw(ctx context.Context, bnfType string) (json.RawMessage, string, error) {
-	result := &struct {
-		BnfDefs json.RawMessage `json:"qBnfDefs"`
-		BnfHash string          `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetBaseBNF", result, bnfType)
-	return result.BnfDefs, result.BnfHash, err
-}
-
-// Gets a string hash calculated from the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language. If the hash changes between subsequent calls to this method, this indicates that the BNF grammar has changed.
-//
-// Parameters:
-//
-// ◾ bnfType   -   The type of grammar to return:
-//
-// • The script statements and the script functions if qBnfType is set to S.
-//
-// • The chart functions if qBnfType is set to E.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Stability: locked
-func (obj *Global) GetBaseBNFHash(ctx context.Context, bnfType string) (string, error) {
-	result := &struct {
-		BnfHash string `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetBaseBNFHash", result, bnfType)
-	return result.BnfHash, err
-}
-
-// Gets the current Backus-Naur Form (BNF) grammar of the Qlik engine scripting language, as well as a string hash calculated from that grammar. The BNF rules define the syntax for the script statements and the script or chart functions. If the hash changes between subsequent calls to this method, this indicates that the BNF has changed.
-// In the Qlik engine grammars, a token is a string of one or more characters that is significant as a group. For example, a token could be a function name, a number, a letter, a parenthesis, and so on.
-//
-// Parameters:
-//
-// ◾ bnfType   -   The type of grammar to return:
-//
-// • S: returns the script statements and the script functions.
-//
-// • E: returns the chart functions.
-//
-// One of:
-//
-// • S or SCRIPT_TEXT_SCRIPT
-//
-// • E or SCRIPT_TEXT_EXPRESSION
-//
-// Stability: locked
-func (obj *Global) GetBaseBNFString(ctx context.Context, bnfType string) (string, string, error) {
-	result := &struct {
-		BnfStr  string `json:"qBnfStr"`
-		BnfHash string `json:"qBnfHash"`
-	}{}
-	err := obj.RPC(ctx, "GetBaseBNFString", result, bnfType)
-	return result.BnfStr, result.BnfHash, err
-}
-
-// List the custom connectors available in the system.
-//
-// Parameters:
-//
-// ◾ reloadList   -   Sets if the list of custom connectors should be reloaded or not.
-// If set to false, only the connectors that were returned the previous time are returned. If new connectors have been added since the last call to the GetCustomConnectors method was made, the new connectors are not returned.
-// If set to true, the GetCustomConnectors method looks for new connectors in the file system.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Global) GetCustomConnectors(ctx context.Context, reloadList bool) ([]*CustomConnector, error) {
-	result := &struct {
-		Connectors []*CustomConnector `json:"qConnectors"`
-	}{}
-	err := obj.RPC(ctx, "GetCustomConnectors", result, reloadList)
-	return result.Connectors, err
-}
-
-// List the custom connectors available in the system.
-//
-// Parameters:
-//
-// ◾ reloadList   -   Sets if the list of custom connectors should be reloaded or not.
-// If set to false, only the connectors that were returned the previous time are returned. If new connectors have been added since the last call to the GetCustomConnectors method was made, the new connectors are not returned.
-// If set to true, the GetCustomConnectors method looks for new connectors in the file system.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Global) GetCustomConnectorsRaw(ctx context.Context, reloadList bool) (json.RawMessage, error) {
-	result := &struct {
-		Connectors json.RawMessage `json:"qConnectors"`
-	}{}
-	err := obj.RPC(ctx, "GetCustomConnectors", result, reloadList)
-	return result.Connectors, err
-}
-
-// Lists the databases in a ODBC, OLEDB or CUSTOM data source.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Global) GetDatabasesFromConnectionString(ctx context.Context, connection *Connection) ([]*Database, error) {
-	result := &struct {
-		Databases []*Database `json:"qDatabases"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabasesFromConnectionString", result, connection)
-	return result.Databases, err
-}
-
-// Lists the databases in a ODBC, OLEDB or CUSTOM data source.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Global) GetDatabasesFromConnectionStringRaw(ctx context.Context, connection any) (json.RawMessage, error) {
-	result := &struct {
-		Databases json.RawMessage `json:"qDatabases"`
-	}{}
-	err := obj.RPC(ctx, "GetDatabasesFromConnectionString", result, connection)
-	return result.Databases, err
-}
-
-// Returns the folder where the apps are stored.
-// This method applies only if running Qlik Sense Desktop.
-// Stability: locked
-func (obj *Global) GetDefaultAppFolder(ctx context.Context) (string, error) {
-	result := &struct {
-		Path string `json:"qPath"`
-	}{}
-	err := obj.RPC(ctx, "GetDefaultAppFolder", result)
-	return result.Path, err
-}
-
-// Returns the list of apps.
-//
-// In Qlik Sense Enterprise::
-//
-// The list is generated by the QRS. The GetDocList method only returns documents the current user is allowed to access.
-//
-// In Qlik Sense Desktop::
-//
-// The apps are located in C:\Users\<user name>\Documents\Qlik\Sense\Apps.
-// Stability: locked
-func (obj *Global) GetDocList(ctx context.Context) ([]*DocListEntry, error) {
-	result := &struct {
-		DocList []*DocListEntry `json:"qDocList"`
-	}{}
-	err := obj.RPC(ctx, "GetDocList", result)
-	return result.DocList, err
-}
-
-// Returns the list of apps.
-//
-// In Qlik Sense Enterprise::
-//
-// The list is generated by the QRS. The GetDocList method only returns documents the current user is allowed to access.
-//
-// In Qlik Sense Desktop::
-//
-// The apps are located in C:\Users\<user name>\Documents\Qlik\Sense\Apps.
-// Stability: locked
-func (obj *Global) GetDocListRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		DocList json.RawMessage `json:"qDocList"`
-	}{}
-	err := obj.RPC(ctx, "GetDocList", result)
-	return result.DocList, err
-}
-
-// Returns the files and folders located at a specified path.
-//
-// Parameters:
-//
-// ◾ path   -   Absolute or relative path.
-// Relative paths are relative to the default Apps folder.
-//
-// In Qlik Sense Enterprise::
-//
-// The list is generated by the QRS. The GetDocList method only returns documents the current user is allowed to access.
-//
-// In Qlik Sense Desktop::
-//
-// The apps are located in C:\Users\<user name>\Documents\Qlik\Sense\Apps.
-//
-// Stability: locked
-func (obj *Global) GetFolderItemsForPath(ctx context.Context, path string) ([]*FolderItem, error) {
-	result := &struct {
-		FolderItems []*FolderItem `json:"qFolderItems"`
-	}{}
-	err := obj.RPC(ctx, "GetFolderItemsForPath", result, path)
-	return result.FolderItems, err
-}
-
-// Returns the files and folders located at a specified path.
-//
-// Parameters:
-//
-// ◾ path   -   Absolute or relative path.
-// Relative paths are relative to the default Apps folder.
-//
-// In Qlik Sense Enterprise::
-//
-// The list is generated by the QRS. The GetDocList method only returns documents the current user is allowed to access.
-//
-// In Qlik Sense Desktop::
-//
-// The apps are located in C:\Users\<user name>\Documents\Qlik\Sense\Apps.
-//
-// Stability: locked
-func (obj *Global) GetFolderItemsForPathRaw(ctx context.Context, path string) (json.RawMessage, error) {
-	result := &struct {
-		FolderItems json.RawMessage `json:"qFolderItems"`
-	}{}
-	err := obj.RPC(ctx, "GetFolderItemsForPath", result, path)
-	return result.FolderItems, err
-}
-
-// Gets the list of all the script functions.
-//
-// Parameters:
-//
-// ◾ group   -   Name of the group.
-// Default is all groups.
-//
-// One of:
-//
-// • ALL or FUNC_GROUP_ALL
-//
-// • U or FUNC_GROUP_UNKNOWN
-//
-// • NONE or FUNC_GROUP_NONE
-//
-// • AGGR or FUNC_GROUP_AGGR
-//
-// • NUM or FUNC_GROUP_NUMERIC
-//
-// • RNG or FUNC_GROUP_RANGE
-//
-// • EXP or FUNC_GROUP_EXPONENTIAL_AND_LOGARITHMIC
-//
-// • TRIG or FUNC_GROUP_TRIGONOMETRIC_AND_HYPERBOLIC
-//
-// • FIN or FUNC_GROUP_FINANCIAL
-//
-// • MATH or FUNC_GROUP_MATH_CONSTANT_AND_PARAM_FREE
-//
-// • COUNT or FUNC_GROUP_COUNTER
-//
-// • STR or FUNC_GROUP_STRING
-//
-// • MAPP or FUNC_GROUP_MAPPING
-//
-// • RCRD or FUNC_GROUP_INTER_RECORD
-//
-// • CND or FUNC_GROUP_CONDITIONAL
-//
-// • LOG or FUNC_GROUP_LOGICAL
-//
-// • NULL or FUNC_GROUP_NULL
-//
-// • SYS or FUNC_GROUP_SYSTEM
-//
-// • FILE or FUNC_GROUP_FILE
-//
-// • TBL or FUNC_GROUP_TABLE
-//
-// • DATE or FUNC_GROUP_DATE_AND_TIME
-//
-// • NUMI or FUNC_GROUP_NUMBER_INTERPRET
-//
-// • FRMT or FUNC_GROUP_FORMATTING
-//
-// • CLR or FUNC_GROUP_COLOR
-//
-// • RNK or FUNC_GROUP_RANKING
-//
-// • GEO or FUNC_GROUP_GEO
-//
-// • EXT or FUNC_GROUP_EXTERNAL
-//
-// • PROB or FUNC_GROUP_PROBABILITY
-//
-// • ARRAY or FUNC_GROUP_ARRAY
-//
-// • LEG or FUNC_GROUP_LEGACY
-//
-// • DB or FUNC_GROUP_DB_NATIVE
-//
-// • WINDOW or FUNC_GROUP_WINDOW
-//
-// Stability: locked
-func (obj *Global) GetFunctions(ctx context.Context, group string) ([]*Function, error) {
-	result := &struct {
-		Functions []*Function `json:"qFunctions"`
-	}{}
-	err := obj.RPC(ctx, "GetFunctions", result, group)
-	return result.Functions, err
-}
-
-// Gets the list of all the script functions.
-//
-// Parameters:
-//
-// ◾ group   -   Name of the group.
-// Default is all groups.
-//
-// One of:
-//
-// • ALL or FUNC_GROUP_ALL
-//
-// • U or FUNC_GROUP_UNKNOWN
-//
-// • NONE or FUNC_GROUP_NONE
-//
-// • AGGR or FUNC_GROUP_AGGR
-//
-// • NUM or FUNC_GROUP_NUMERIC
-//
-// • RNG or FUNC_GROUP_RANGE
-//
-// • EXP or FUNC_GROUP_EXPONENTIAL_AND_LOGARITHMIC
-//
-// • TRIG or FUNC_GROUP_TRIGONOMETRIC_AND_HYPERBOLIC
-//
-// • FIN or FUNC_GROUP_FINANCIAL
-//
-// • MATH or FUNC_GROUP_MATH_CONSTANT_AND_PARAM_FREE
-//
-// • COUNT or FUNC_GROUP_COUNTER
-//
-// • STR or FUNC_GROUP_STRING
-//
-// • MAPP or FUNC_GROUP_MAPPING
-//
-// • RCRD or FUNC_GROUP_INTER_RECORD
-//
-// • CND or FUNC_GROUP_CONDITIONAL
-//
-// • LOG or FUNC_GROUP_LOGICAL
-//
-// • NULL or FUNC_GROUP_NULL
-//
-// • SYS or FUNC_GROUP_SYSTEM
-//
-// • FILE or FUNC_GROUP_FILE
-//
-// • TBL or FUNC_GROUP_TABLE
-//
-// • DATE or FUNC_GROUP_DATE_AND_TIME
-//
-// • NUMI or FUNC_GROUP_NUMBER_INTERPRET
-//
-// • FRMT or FUNC_GROUP_FORMATTING
-//
-// • CLR or FUNC_GROUP_COLOR
-//
-// • RNK or FUNC_GROUP_RANKING
-//
-// • GEO or FUNC_GROUP_GEO
-//
-// • EXT or FUNC_GROUP_EXTERNAL
-//
-// • PROB or FUNC_GROUP_PROBABILITY
-//
-// • ARRAY or FUNC_GROUP_ARRAY
-//
-// • LEG or FUNC_GROUP_LEGACY
-//
-// • DB or FUNC_GROUP_DB_NATIVE
-//
-// • WINDOW or FUNC_GROUP_WINDOW
-//
-// Stability: locked
-func (obj *Global) GetFunctionsRaw(ctx context.Context, group string) (json.RawMessage, error) {
-	result := &struct {
-		Functions json.RawMessage `json:"qFunctions"`
-	}{}
-	err := obj.RPC(ctx, "GetFunctions", result, group)
-	return result.Functions, err
-}
-
-// Retrieves information on the user interaction that is requested by the engine.
-// Engine can request user interactions only during script reload and when the reload is performed in debug mode ( qDebug is set to true when using the DoReload method ).
-// When running reload in debug mode, the engine pauses the script execution to receive data about user interaction. The engine can pause:
-//
-// • Before executing a new script statement.
-//
-// • When an error occurs while executing the script.
-//
-// • When the script execution is finished.
-//
-// To know if the engine is paused and waits for a response to an interaction request, the GetProgress method should be used. The engine waits for a response if the property qUserInteractionWanted is set to true in the response of the GetProgress request.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the request.
-// Corresponds to the identifier of the DoReload request.
-//
-// Stability: locked
-func (obj *Global) GetInteract(ctx context.Context, requestId int) (*InteractDef, error) {
-	result := &struct {
-		Def    *InteractDef `json:"qDef"`
-		Return bool         `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetInteract", result, requestId)
-	return result.Def, err
-}
-
-// Retrieves information on the user interaction that is requested by the engine.
-// Engine can request user interactions only during script reload and when the reload is performed in debug mode ( qDebug is set to true when using the DoReload method ).
-// When running reload in debug mode, the engine pauses the script execution to receive data about user interaction. The engine can pause:
-//
-// • Before executing a new script statement.
-//
-// • When an error occurs while executing the script.
-//
-// • When the script execution is finished.
-//
-// To know if the engine is paused and waits for a response to an interaction request, the GetProgress method should be used. The engine waits for a response if the property qUserInteractionWanted is set to true in the response of the GetProgress request.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the request.
-// Corresponds to the identifier of the DoReload request.
-//
-// Stability: locked
-func (obj *Global) GetInteractRaw(ctx context.Context, requestId int) (json.RawMessage, error) {
-	result := &struct {
-		Def    json.RawMessage `json:"qDef"`
-		Return bool            `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetInteract", result, requestId)
-	return result.Def, err
-}
-
-// Lists the logical drives in the system.
-// This method applies only if running Qlik Sense Desktop.
-// Stability: locked
-func (obj *Global) GetLogicalDriveStrings(ctx context.Context) ([]*DriveInfo, error) {
-	result := &struct {
-		Drives []*DriveInfo `json:"qDrives"`
-	}{}
-	err := obj.RPC(ctx, "GetLogicalDriveStrings", result)
-	return result.Drives, err
-}
-
-// Lists the logical drives in the system.
-// This method applies only if running Qlik Sense Desktop.
-// Stability: locked
-func (obj *Global) GetLogicalDriveStringsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Drives json.RawMessage `json:"qDrives"`
-	}{}
-	err := obj.RPC(ctx, "GetLogicalDriveStrings", result)
-	return result.Drives, err
-}
-
-// Returns the list of the ODBC connectors that are installed in the system.
-// Stability: locked
-func (obj *Global) GetOdbcDsns(ctx context.Context) ([]*OdbcDsn, error) {
-	result := &struct {
-		OdbcDsns []*OdbcDsn `json:"qOdbcDsns"`
-	}{}
-	err := obj.RPC(ctx, "GetOdbcDsns", result)
-	return result.OdbcDsns, err
-}
-
-// Returns the list of the ODBC connectors that are installed in the system.
-// Stability: locked
-func (obj *Global) GetOdbcDsnsRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		OdbcDsns json.RawMessage `json:"qOdbcDsns"`
-	}{}
-	err := obj.RPC(ctx, "GetOdbcDsns", result)
-	return result.OdbcDsns, err
-}
-
-// Returns the list of the OLEDB providers installed on the system.
-// Stability: locked
-func (obj *Global) GetOleDbProviders(ctx context.Context) ([]*OleDbProvider, error) {
-	result := &struct {
-		OleDbProviders []*OleDbProvider `json:"qOleDbProviders"`
-	}{}
-	err := obj.RPC(ctx, "GetOleDbProviders", result)
-	return result.OleDbProviders, err
-}
-
-// Returns the list of the OLEDB providers installed on the system.
-// Stability: locked
-func (obj *Global) GetOleDbProvidersRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		OleDbProviders json.RawMessage `json:"qOleDbProviders"`
-	}{}
-	err := obj.RPC(ctx, "GetOleDbProviders", result)
-	return result.OleDbProviders, err
-}
-
-// Gives information about the progress of the DoReload and DoSave calls.
-// For more information on DoReload and DoSave, see the DoReload Method and DoSave Method.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the DoReload or DoSave request or 0.
-// Complete information is returned if the identifier of the request is given.
-// If the identifier is 0, less information is given. Progress messages and error messages are returned but information like when the request started and finished is not returned.
-//
-// Stability: locked
-func (obj *Global) GetProgress(ctx context.Context, requestId int) (*ProgressData, error) {
-	result := &struct {
-		ProgressData *ProgressData `json:"qProgressData"`
-	}{}
-	err := obj.RPC(ctx, "GetProgress", result, requestId)
-	return result.ProgressData, err
-}
-
-// Gives information about the progress of the DoReload and DoSave calls.
-// For more information on DoReload and DoSave, see the DoReload Method and DoSave Method.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the DoReload or DoSave request or 0.
-// Complete information is returned if the identifier of the request is given.
-// If the identifier is 0, less information is given. Progress messages and error messages are returned but information like when the request started and finished is not returned.
-//
-// Stability: locked
-func (obj *Global) GetProgressRaw(ctx context.Context, requestId int) (json.RawMessage, error) {
-	result := &struct {
-		ProgressData json.RawMessage `json:"qProgressData"`
-	}{}
-	err := obj.RPC(ctx, "GetProgress", result, requestId)
-	return result.ProgressData, err
-}
-
-// Lists the streams.
-// Deprecated: Use general purpose endpoint in [QRS API: GET qrs/stream/](/Subsystems/RepositoryServiceAPI/Content/Sense_RepositoryServiceAPI/RepositoryServiceAPI-Get.htm) instead.
-// Stability: locked
-func (obj *Global) GetStreamList(ctx context.Context) ([]*NxStreamListEntry, error) {
-	result := &struct {
-		StreamList []*NxStreamListEntry `json:"qStreamList"`
-	}{}
-	err := obj.RPC(ctx, "GetStreamList", result)
-	return result.StreamList, err
-}
-
-// Lists the streams.
-// Deprecated: Use general purpose endpoint in [QRS API: GET qrs/stream/](/Subsystems/RepositoryServiceAPI/Content/Sense_RepositoryServiceAPI/RepositoryServiceAPI-Get.htm) instead.
-// Stability: locked
-func (obj *Global) GetStreamListRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		StreamList json.RawMessage `json:"qStreamList"`
-	}{}
-	err := obj.RPC(ctx, "GetStreamList", result)
-	return result.StreamList, err
-}
-
-// Lists the supported code pages.
-// Stability: locked
-func (obj *Global) GetSupportedCodePages(ctx context.Context) ([]*CodePage, error) {
-	result := &struct {
-		CodePages []*CodePage `json:"qCodePages"`
-	}{}
-	err := obj.RPC(ctx, "GetSupportedCodePages", result)
-	return result.CodePages, err
-}
-
-// Lists the supported code pages.
-// Stability: locked
-func (obj *Global) GetSupportedCodePagesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		CodePages json.RawMessage `json:"qCodePages"`
-	}{}
-	err := obj.RPC(ctx, "GetSupportedCodePages", result)
-	return result.CodePages, err
-}
-
-// Returns the unique identifier of the endpoint for the current user in the current app.
-// This unique identifier can be used for logging purposes.
-// Stability: locked
-func (obj *Global) GetUniqueID(ctx context.Context) (string, error) {
-	result := &struct {
-		UniqueID string `json:"qUniqueID"`
-	}{}
-	err := obj.RPC(ctx, "GetUniqueID", result)
-	return result.UniqueID, err
-}
-
-// Informs the engine that a user interaction (which was earlier requested by the engine) was performed and indicates to the engine what to do next.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the request.
-// Corresponds to the identifier of the DoReload request.
-//
-// ◾ def         -   User response to the current interaction.
-//
-// Stability: locked
-func (obj *Global) InteractDone(ctx context.Context, requestId int, def *InteractDef) error {
-	err := obj.RPC(ctx, "InteractDone", nil, requestId, def)
-	return err
-}
-
-// Informs the engine that a user interaction (which was earlier requested by the engine) was performed and indicates to the engine what to do next.
-//
-// Parameters:
-//
-// ◾ requestId   -   Identifier of the request.
-// Corresponds to the identifier of the DoReload request.
-//
-// ◾ def         -   User response to the current interaction.
-//
-// Stability: locked
-func (obj *Global) InteractDoneRaw(ctx context.Context, requestId int, def any) error {
-	err := obj.RPC(ctx, "InteractDone", nil, requestId, def)
-	return err
-}
-
-// Indicates whether the user is working in Qlik Sense Desktop.
-// Stability: locked
-func (obj *Global) IsDesktopMode(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "IsDesktopMode", result)
-	return result.Return, err
-}
-
-// Indicates whether or not the user is working in personal mode (Qlik Sense Desktop).
-// Deprecated: Use _IsDesktopMode_ method instead
-// Stability: locked
-func (obj *Global) IsPersonalMode(ctx context.Context) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "IsPersonalMode", result)
-	return result.Return, err
-}
-
-// Checks if a connection string is valid.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Global) IsValidConnectionString(ctx context.Context, connection *Connection) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "IsValidConnectionString", result, connection)
-	return result.Return, err
-}
-
-// Checks if a connection string is valid.
-//
-// Parameters:
-//
-// ◾ connection   -   Information about the connection.
-//
-// Stability: locked
-func (obj *Global) IsValidConnectionStringRaw(ctx context.Context, connection any) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "IsValidConnectionString", result, connection)
-	return result.Return, err
-}
-
-// Returns the name of the operating system.
-// Stability: locked
-func (obj *Global) OSName(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "OSName", result)
-	return result.Return, err
-}
-
-// Returns the version number of the operating system.
-// Stability: locked
-func (obj *Global) OSVersion(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "OSVersion", result)
-	return result.Return, err
-}
-
-// Opens an app and checks if the app needs to be migrated (if the app is deprecated).
-// The OpenDoc method compares the version of the app with the version of Qlik Sense and migrates the app to the current version of Qlik Sense if necessary. Once the migration is done, the app is opened.
-// If no migration is needed, the app is opened immediately.
-// The following applies:
-//
-// • The app version is lower than 0.95: no migration is done. Apps older than the version 0.95 are not supported.
-//
-// • The app version is at least 0.95 and less than the Qlik Sense version: the app is migrated and then opened.
-//
-// • Qlik Sense and the app have the same version: the app is opened, no migration is needed.
-//
-// If the app is read-only, the app migration cannot occur. An error message is sent.
-//
-// Backups:
-//
-// In Qlik Sense Desktop, apps are automatically backed up before a migration.
-// The backup files are located in %userprofile%\Documents\Qlik\Sense\AppsBackup\<Qlik Sense Desktop version>.
-// In Qlik Sense Enterprise, no automatic back up is run. The back up should be done manually.
-//
-// Parameters:
-//
-// ◾ docName    -   The GUID (in Qlik Sense Enterprise) or Name (in Qlik Sense Desktop) of the app to retrieve.
-//
-// ◾ userName   -   Name of the user that opens the app.
-//
-// ◾ password   -   Password of the user.
-//
-// ◾ serial     -   Current Qlik Sense serial number.
-//
-// ◾ noData     -   Set this parameter to true to be able to open an app without loading its data.
-// When this parameter is set to true, the objects in the app are present but contain no data. The script can be edited and reloaded.
-// The default value is false.
-//
-// Stability: locked
-func (obj *Global) OpenDoc(ctx context.Context, docName string, userName string, password string, serial string, noData bool) (*Doc, error) {
-	result := &struct {
-		Return *ObjectInterface `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "OpenDoc", result, docName, userName, password, serial, noData)
-	if err != nil {
-		return nil, err
-	}
-	return &Doc{obj.GetRemoteObject(result.Return)}, err
-}
-
-// Returns the Qlik Sense version number.
-// Deprecated: Use _EngineVersion_ method instead
-// Stability: locked
-func (obj *Global) ProductVersion(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "ProductVersion", result)
-	return result.Return, err
-}
-
-// Publishes an app to the supplied stream.
-//
-// Parameters:
-//
-// ◾ appId      -   The Id of the app to publish.
-//
-// ◾ name       -   The name of the app to publish.
-//
-// ◾ streamId   -   The stream Id of the app to publish.
-//
-// Stability: locked
-func (obj *Global) PublishApp(ctx context.Context, appId string, name string, streamId string) error {
-	err := obj.RPC(ctx, "PublishApp", nil, appId, name, streamId)
-	return err
-}
-
-// Returns the Qlik product name.
-// Stability: locked
-func (obj *Global) QTProduct(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "QTProduct", result)
-	return result.Return, err
-}
-
-// Returns the Qlik Sense version number.
-// Deprecated: Use the _EngineVersion_ method instead
-// Stability: locked
-func (obj *Global) QvVersion(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "QvVersion", result)
-	return result.Return, err
-}
-
-// Reloads the list of extensions.
-// Stability: locked
-func (obj *Global) ReloadExtensionList(ctx context.Context) error {
-	err := obj.RPC(ctx, "ReloadExtensionList", nil)
-	return err
-}
-
-// Replaces objects of a target app with the objects from a source app.
-// The list of objects in the app to be replaced must be defined in qIds.
-// The data model of the app cannot be updated.
-// This operation is possible only in Qlik Sense Enterprise.
-//
-// The operation is successful if qSuccess is set to true.
-//
-// Parameters:
-//
-// ◾ targetAppId   -   Identifier (GUID) of the target app.
-// The target app is the app to be replaced.
-//
-// ◾ srcAppID      -   Identifier (GUID) of the source app.
-// The objects in the source app will replace the objects in the target app.
-//
-// ◾ ids           -   QRS identifiers (GUID) of the objects in the target app to be replaced. Only QRS-approved GUIDs are applicable.
-// An object that is QRS-approved, is for example an object that has been published (for example, not private anymore).
-// If an object is private, it should not be included in this list.
-// If the array of identifiers contains objects that are not present in the source app, the objects related to these identifiers are removed from the target app.
-// If qIds is empty, no objects are deleted in the target app.
-//
-// Stability: locked
-func (obj *Global) ReplaceAppFromID(ctx context.Context, targetAppId string, srcAppID string, ids []string) (bool, error) {
-	result := &struct {
-		Success bool `json:"qSuccess"`
-	}{}
-	err := obj.RPC(ctx, "ReplaceAppFromID", result, targetAppId, srcAppID, ids)
-	return result.Success, err
-}
-
-// Save a copy of an app with a different name.
-// Can be used to save a session app as an ordinary app.
-//
-// Parameters:
-//
-// ◾ newAppName   -   <Name of the saved app>
-//
-// Deprecated: Use Document _SaveAs_ method instead
-// Stability: locked
-func (obj *Global) SaveAs(ctx context.Context, newAppName string) (string, error) {
-	result := &struct {
-		NewAppId string `json:"qNewAppId"`
-	}{}
-	err := obj.RPC(ctx, "SaveAs", result, newAppName)
-	return result.NewAppId, err
-}
-
-// Shuts down the Qlik engine.
-// This operation is possible only in Qlik Sense Desktop.
-// Stability: locked
-func (obj *Global) ShutdownProcess(ctx context.Context) error {
-	err := obj.RPC(ctx, "ShutdownProcess", nil)
-	return err
-}
-
-type Variable struct {
-	*RemoteObject
-}
-
-// Sets the value of a dual variable overriding any input constraints.
-//
-// Parameters:
-//
-// ◾ s   -   String representation of a dual value.
-// Set this parameter to "", if the string representation is to be Null.
-//
-// ◾ d   -   Numeric representation of a dual value.
-//
-// Deprecated: Use _GenericVariable::SetProperties_ method instead
-// Stability: locked
-func (obj *Variable) ForceContent(ctx context.Context, s string, d Float64) error {
-	err := obj.RPC(ctx, "ForceContent", nil, s, d)
-	return err
-}
-
-// Returns the calculated value of a variable.
-// Deprecated: Use _GenericVariable::GetProperties_ method instead
-// Stability: locked
-func (obj *Variable) GetContent(ctx context.Context) (*AlfaNumString, error) {
-	result := &struct {
-		Content *AlfaNumString `json:"qContent"`
-	}{}
-	err := obj.RPC(ctx, "GetContent", result)
-	return result.Content, err
-}
-
-// Returns the calculated value of a variable.
-// Deprecated: Use _GenericVariable::GetProperties_ method instead
-// Stability: locked
-func (obj *Variable) GetContentRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Content json.RawMessage `json:"qContent"`
-	}{}
-	err := obj.RPC(ctx, "GetContent", result)
-	return result.Content, err
-}
-
-// Gets the properties of a variable.
-// Deprecated: Use _GetProperties_ method instead
-// Stability: locked
-func (obj *Variable) GetNxProperties(ctx context.Context) (*NxVariableProperties, error) {
-	result := &struct {
-		Properties *NxVariableProperties `json:"qProperties"`
-	}{}
-	err := obj.RPC(ctx, "GetNxProperties", result)
-	return result.Properties, err
-}
-
-// Gets the properties of a variable.
-// Deprecated: Use _GetProperties_ method instead
-// Stability: locked
-func (obj *Variable) GetNxPropertiesRaw(ctx context.Context) (json.RawMessage, error) {
-	result := &struct {
-		Properties json.RawMessage `json:"qProperties"`
-	}{}
-	err := obj.RPC(ctx, "GetNxProperties", result)
-	return result.Properties, err
-}
-
-// Returns the raw value of a variable.
-// Deprecated: Use _GenericVariable::GetProperties_ method instead
-// Stability: locked
-func (obj *Variable) GetRawContent(ctx context.Context) (string, error) {
-	result := &struct {
-		Return string `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "GetRawContent", result)
-	return result.Return, err
-}
-
-// Sets a value to a variable.
-//
-// Parameters:
-//
-// ◾ content     -   Value of the variable.
-//
-// ◾ updateMRU   -   If set to true, the value is added to the Most Recently Used (MRU) list.
-//
-// Deprecated: Use _GenericVariable::SetProperties_ method instead
-// Stability: locked
-func (obj *Variable) SetContent(ctx context.Context, content string, updateMRU bool) (bool, error) {
-	result := &struct {
-		Return bool `json:"qReturn"`
-	}{}
-	err := obj.RPC(ctx, "SetContent", result, content, updateMRU)
-	return result.Return, err
-}
-
-// Sets some properties to a variable.
-//
-// Parameters:
-//
-// ◾ properties   -   Information about the properties of the variable
-//
-// Deprecated: Use _SetProperties_ method instead
-// Stability: locked
-func (obj *Variable) SetNxProperties(ctx context.Context, properties *NxVariableProperties) error {
-	err := obj.RPC(ctx, "SetNxProperties", nil, properties)
-	return err
-}
-
-// Sets some properties to a variable.
-//
-// Parameters:
-//
-// ◾ properties   -   Information about the properties of the variable
-//
-// Deprecated: Use _SetProperties_ method instead
-// Stability: locked
-func (obj *Variable) SetNxPropertiesRaw(ctx context.Context, properties any) error {
-	err := obj.RPC(ctx, "SetNxProperties", nil, properties)
-	return err
+// ReadOnlyMethods lists the methods, keyed by object type and method name, that do not modify any state in Qlik Associative Engine.
+// The list is derived from the schema and is used as the default allowlist by NewResponseCache.
+var ReadOnlyMethods = map[string]bool{
+	"Doc.Evaluate":                             true,
+	"Doc.EvaluateEx":                           true,
+	"Doc.GetAllInfos":                          true,
+	"Doc.GetAppLayout":                         true,
+	"Doc.GetAppProperties":                     true,
+	"Doc.GetAssociationScores":                 true,
+	"Doc.GetBookmarks":                         true,
+	"Doc.GetContentLibraries":                  true,
+	"Doc.GetExpressionBNF":                     true,
+	"Doc.GetExpressionBNFHash":                 true,
+	"Doc.GetFieldAndColumnSamples":             true,
+	"Doc.GetFieldDescription":                  true,
+	"Doc.GetFieldOnTheFlyByName":               true,
+	"Doc.GetFieldsFromExpression":              true,
+	"Doc.GetFieldsResourceIds":                 true,
+	"Doc.GetGroupStates":                       true,
+	"Doc.GetLibraryContent":                    true,
+	"Doc.GetLocaleInfo":                        true,
+	"Doc.GetLooselyCoupledVector":              true,
+	"Doc.GetMatchingFields":                    true,
+	"Doc.GetMediaList":                         true,
+	"Doc.GetObjects":                           true,
+	"Doc.GetScriptEx":                          true,
+	"Doc.GetScriptMeta":                        true,
+	"Doc.GetSetAnalysis":                       true,
+	"Doc.GetTableData":                         true,
+	"Doc.GetTableProfileData":                  true,
+	"Doc.GetTablesAndKeys":                     true,
+	"Doc.GetVariables":                         true,
+	"Doc.GetViewDlgSaveInfo":                   true,
+	"Field.GetAndMode":                         true,
+	"Field.GetCardinal":                        true,
+	"Field.GetNxProperties":                    true,
+	"GenericBookmark.GetFieldValues":           true,
+	"GenericBookmark.GetFieldValuesEx":         true,
+	"GenericBookmark.GetInfo":                  true,
+	"GenericBookmark.GetLayout":                true,
+	"GenericBookmark.GetProperties":            true,
+	"GenericDimension.GetActiveField":          true,
+	"GenericDimension.GetDimension":            true,
+	"GenericDimension.GetInfo":                 true,
+	"GenericDimension.GetLayout":               true,
+	"GenericDimension.GetLinkedObjects":        true,
+	"GenericDimension.GetProperties":           true,
+	"GenericMeasure.GetInfo":                   true,
+	"GenericMeasure.GetLayout":                 true,
+	"GenericMeasure.GetLinkedObjects":          true,
+	"GenericMeasure.GetMeasure":                true,
+	"GenericMeasure.GetProperties":             true,
+	"GenericObject.GetChildInfos":              true,
+	"GenericObject.GetEffectiveProperties":     true,
+	"GenericObject.GetFullPropertyTree":        true,
+	"GenericObject.GetHyperCubeBinnedData":     true,
+	"GenericObject.GetHyperCubeContinuousData": true,
+	"GenericObject.GetHyperCubeData":           true,
+	"GenericObject.GetHyperCubePivotData":      true,
+	"GenericObject.GetHyperCubeReducedData":    true,
+	"GenericObject.GetHyperCubeStackData":      true,
+	"GenericObject.GetHyperCubeTreeData":       true,
+	"GenericObject.GetInfo":                    true,
+	"GenericObject.GetLayout":                  true,
+	"GenericObject.GetLinkedObjects":           true,
+	"GenericObject.GetListObjectData":          true,
+	"GenericObject.GetProperties":              true,
+	"GenericVariable.GetInfo":                  true,
+	"GenericVariable.GetLayout":                true,
+	"GenericVariable.GetProperties":            true,
+	"GenericVariable.GetRawContent":            true,
+	"Variable.GetContent":                      true,
+	"Variable.GetNxProperties":                 true,
+	"Variable.GetRawContent":                   true,
+}
+
+// SchemaMethods lists all methods of the schema, keyed by object type and method name. It is registered with
+// RegisterEngineSchema to tell which methods an engine of this version has.
+var SchemaMethods = map[string]bool{
+	"Doc.AbortModal":                                true,
+	"Doc.AddAlternateState":                         true,
+	"Doc.AddFieldFromExpression":                    true,
+	"Doc.AddSessionAlternateState":                  true,
+	"Doc.ApplyAndVerifyBookmark":                    true,
+	"Doc.ApplyBookmark":                             true,
+	"Doc.ApplyGroupStates":                          true,
+	"Doc.ApplyTemporaryBookmark":                    true,
+	"Doc.Back":                                      true,
+	"Doc.BackCount":                                 true,
+	"Doc.ChangeSessionAppOwner":                     true,
+	"Doc.ChangeSessionAppSpace":                     true,
+	"Doc.CheckExpression":                           true,
+	"Doc.CheckNumberOrExpression":                   true,
+	"Doc.CheckScriptSyntax":                         true,
+	"Doc.ClearAll":                                  true,
+	"Doc.ClearAllSoftPatches":                       true,
+	"Doc.ClearUndoBuffer":                           true,
+	"Doc.CloneBookmark":                             true,
+	"Doc.CloneDimension":                            true,
+	"Doc.CloneMeasure":                              true,
+	"Doc.CloneObject":                               true,
+	"Doc.CommitDraft":                               true,
+	"Doc.CommitScript":                              true,
+	"Doc.CreateBookmark":                            true,
+	"Doc.CreateBookmarkEx":                          true,
+	"Doc.CreateConnection":                          true,
+	"Doc.CreateDimension":                           true,
+	"Doc.CreateDraft":                               true,
+	"Doc.CreateMeasure":                             true,
+	"Doc.CreateObject":                              true,
+	"Doc.CreateSessionObject":                       true,
+	"Doc.CreateSessionVariable":                     true,
+	"Doc.CreateTemporaryBookmark":                   true,
+	"Doc.CreateVariable":                            true,
+	"Doc.CreateVariableEx":                          true,
+	"Doc.DeleteConnection":                          true,
+	"Doc.DestroyBookmark":                           true,
+	"Doc.DestroyDimension":                          true,
+	"Doc.DestroyDraft":                              true,
+	"Doc.DestroyMeasure":                            true,
+	"Doc.DestroyObject":                             true,
+	"Doc.DestroySessionObject":                      true,
+	"Doc.DestroySessionVariable":                    true,
+	"Doc.DestroySessionVariableById":                true,
+	"Doc.DestroySessionVariableByName":              true,
+	"Doc.DestroyVariableById":                       true,
+	"Doc.DestroyVariableByName":                     true,
+	"Doc.DoReload":                                  true,
+	"Doc.DoReloadEx":                                true,
+	"Doc.DoSave":                                    true,
+	"Doc.Evaluate":                                  true,
+	"Doc.EvaluateEx":                                true,
+	"Doc.ExpandExpression":                          true,
+	"Doc.ExportReducedData":                         true,
+	"Doc.FindMatchingFields":                        true,
+	"Doc.Forward":                                   true,
+	"Doc.ForwardCount":                              true,
+	"Doc.GetAllInfos":                               true,
+	"Doc.GetAppLayout":                              true,
+	"Doc.GetAppProperties":                          true,
+	"Doc.GetAssociationScores":                      true,
+	"Doc.GetBookmark":                               true,
+	"Doc.GetBookmarks":                              true,
+	"Doc.GetConnection":                             true,
+	"Doc.GetConnections":                            true,
+	"Doc.GetContentLibraries":                       true,
+	"Doc.GetDatabaseInfo":                           true,
+	"Doc.GetDatabaseOwners":                         true,
+	"Doc.GetDatabaseTableFields":                    true,
+	"Doc.GetDatabaseTablePreview":                   true,
+	"Doc.GetDatabaseTables":                         true,
+	"Doc.GetDatabases":                              true,
+	"Doc.GetDimension":                              true,
+	"Doc.GetEmptyScript":                            true,
+	"Doc.GetExpressionBNF":                          true,
+	"Doc.GetExpressionBNFHash":                      true,
+	"Doc.GetFavoriteVariables":                      true,
+	"Doc.GetField":                                  true,
+	"Doc.GetFieldAndColumnSamples":                  true,
+	"Doc.GetFieldDescription":                       true,
+	"Doc.GetFieldOnTheFlyByName":                    true,
+	"Doc.GetFieldsFromExpression":                   true,
+	"Doc.GetFieldsResourceIds":                      true,
+	"Doc.GetFileTableFields":                        true,
+	"Doc.GetFileTablePreview":                       true,
+	"Doc.GetFileTables":                             true,
+	"Doc.GetFileTablesEx":                           true,
+	"Doc.GetFolderItemsForConnection":               true,
+	"Doc.GetGroupStates":                            true,
+	"Doc.GetIncludeFileContent":                     true,
+	"Doc.GetLibraryContent":                         true,
+	"Doc.GetLineage":                                true,
+	"Doc.GetLocaleInfo":                             true,
+	"Doc.GetLooselyCoupledVector":                   true,
+	"Doc.GetMatchingFields":                         true,
+	"Doc.GetMeasure":                                true,
+	"Doc.GetMeasureWithLabel":                       true,
+	"Doc.GetMediaList":                              true,
+	"Doc.GetObject":                                 true,
+	"Doc.GetObjects":                                true,
+	"Doc.GetOrCreateObject":                         true,
+	"Doc.GetScript":                                 true,
+	"Doc.GetScriptBreakpoints":                      true,
+	"Doc.GetScriptEx":                               true,
+	"Doc.GetScriptMeta":                             true,
+	"Doc.GetSetAnalysis":                            true,
+	"Doc.GetTableData":                              true,
+	"Doc.GetTableProfileData":                       true,
+	"Doc.GetTablesAndKeys":                          true,
+	"Doc.GetTextMacros":                             true,
+	"Doc.GetVariable":                               true,
+	"Doc.GetVariableById":                           true,
+	"Doc.GetVariableByName":                         true,
+	"Doc.GetVariables":                              true,
+	"Doc.GetViewDlgSaveInfo":                        true,
+	"Doc.GuessFileType":                             true,
+	"Doc.LockAll":                                   true,
+	"Doc.ModifyConnection":                          true,
+	"Doc.Publish":                                   true,
+	"Doc.Redo":                                      true,
+	"Doc.RemoveAlternateState":                      true,
+	"Doc.RemoveSessionAlternateState":               true,
+	"Doc.RemoveVariable":                            true,
+	"Doc.ReplaceBookmark":                           true,
+	"Doc.RestoreTempSelectionState":                 true,
+	"Doc.Resume":                                    true,
+	"Doc.SaveAs":                                    true,
+	"Doc.SaveObjects":                               true,
+	"Doc.Scramble":                                  true,
+	"Doc.SearchAssociations":                        true,
+	"Doc.SearchObjects":                             true,
+	"Doc.SearchResults":                             true,
+	"Doc.SearchSuggest":                             true,
+	"Doc.SearchValues":                              true,
+	"Doc.SelectAssociations":                        true,
+	"Doc.SendGenericCommandToCustomConnector":       true,
+	"Doc.SetAppProperties":                          true,
+	"Doc.SetFavoriteVariables":                      true,
+	"Doc.SetFetchLimit":                             true,
+	"Doc.SetLooselyCoupledVector":                   true,
+	"Doc.SetProhibitBinaryLoad":                     true,
+	"Doc.SetScript":                                 true,
+	"Doc.SetScriptBreakpoints":                      true,
+	"Doc.SetViewDlgSaveInfo":                        true,
+	"Doc.StoreTempSelectionState":                   true,
+	"Doc.TransformApp":                              true,
+	"Doc.Undo":                                      true,
+	"Doc.UnlockAll":                                 true,
+	"Field.Clear":                                   true,
+	"Field.ClearAllButThis":                         true,
+	"Field.GetAndMode":                              true,
+	"Field.GetCardinal":                             true,
+	"Field.GetNxProperties":                         true,
+	"Field.Lock":                                    true,
+	"Field.LowLevelSelect":                          true,
+	"Field.Select":                                  true,
+	"Field.SelectAll":                               true,
+	"Field.SelectAlternative":                       true,
+	"Field.SelectExcluded":                          true,
+	"Field.SelectPossible":                          true,
+	"Field.SelectValues":                            true,
+	"Field.SetAndMode":                              true,
+	"Field.SetNxProperties":                         true,
+	"Field.ToggleSelect":                            true,
+	"Field.Unlock":                                  true,
+	"GenericBookmark.Apply":                         true,
+	"GenericBookmark.ApplyAndVerify":                true,
+	"GenericBookmark.ApplyPatches":                  true,
+	"GenericBookmark.Approve":                       true,
+	"GenericBookmark.GetFieldValues":                true,
+	"GenericBookmark.GetFieldValuesEx":              true,
+	"GenericBookmark.GetInfo":                       true,
+	"GenericBookmark.GetLayout":                     true,
+	"GenericBookmark.GetProperties":                 true,
+	"GenericBookmark.Publish":                       true,
+	"GenericBookmark.SetProperties":                 true,
+	"GenericBookmark.UnApprove":                     true,
+	"GenericBookmark.UnPublish":                     true,
+	"GenericDimension.ApplyPatches":                 true,
+	"GenericDimension.Approve":                      true,
+	"GenericDimension.GetActiveField":               true,
+	"GenericDimension.GetDimension":                 true,
+	"GenericDimension.GetInfo":                      true,
+	"GenericDimension.GetLayout":                    true,
+	"GenericDimension.GetLinkedObjects":             true,
+	"GenericDimension.GetProperties":                true,
+	"GenericDimension.Publish":                      true,
+	"GenericDimension.SetActiveField":               true,
+	"GenericDimension.SetProperties":                true,
+	"GenericDimension.StepCycle":                    true,
+	"GenericDimension.UnApprove":                    true,
+	"GenericDimension.UnPublish":                    true,
+	"GenericMeasure.ApplyPatches":                   true,
+	"GenericMeasure.Approve":                        true,
+	"GenericMeasure.GetInfo":                        true,
+	"GenericMeasure.GetLayout":                      true,
+	"GenericMeasure.GetLinkedObjects":               true,
+	"GenericMeasure.GetMeasure":                     true,
+	"GenericMeasure.GetProperties":                  true,
+	"GenericMeasure.Publish":                        true,
+	"GenericMeasure.SetProperties":                  true,
+	"GenericMeasure.UnApprove":                      true,
+	"GenericMeasure.UnPublish":                      true,
+	"GenericObject.AbortListObjectSearch":           true,
+	"GenericObject.AcceptListObjectSearch":          true,
+	"GenericObject.AddGroupMembers":                 true,
+	"GenericObject.ApplyPatches":                    true,
+	"GenericObject.Approve":                         true,
+	"GenericObject.BeginSelections":                 true,
+	"GenericObject.ClearSelections":                 true,
+	"GenericObject.ClearSoftPatches":                true,
+	"GenericObject.CollapseLeft":                    true,
+	"GenericObject.CollapseTop":                     true,
+	"GenericObject.CopyFrom":                        true,
+	"GenericObject.CreateChild":                     true,
+	"GenericObject.CreateGroup":                     true,
+	"GenericObject.DestroyAllChildren":              true,
+	"GenericObject.DestroyChild":                    true,
+	"GenericObject.DrillUp":                         true,
+	"GenericObject.EmbedSnapshotObject":             true,
+	"GenericObject.EndSelections":                   true,
+	"GenericObject.ExpandLeft":                      true,
+	"GenericObject.ExpandTop":                       true,
+	"GenericObject.ExportData":                      true,
+	"GenericObject.GetChild":                        true,
+	"GenericObject.GetChildInfos":                   true,
+	"GenericObject.GetEffectiveProperties":          true,
+	"GenericObject.GetFullPropertyTree":             true,
+	"GenericObject.GetHyperCubeBinnedData":          true,
+	"GenericObject.GetHyperCubeContinuousData":      true,
+	"GenericObject.GetHyperCubeData":                true,
+	"GenericObject.GetHyperCubePivotData":           true,
+	"GenericObject.GetHyperCubeReducedData":         true,
+	"GenericObject.GetHyperCubeStackData":           true,
+	"GenericObject.GetHyperCubeTreeData":            true,
+	"GenericObject.GetInfo":                         true,
+	"GenericObject.GetLayout":                       true,
+	"GenericObject.GetLinkedObjects":                true,
+	"GenericObject.GetListObjectData":               true,
+	"GenericObject.GetParent":                       true,
+	"GenericObject.GetProperties":                   true,
+	"GenericObject.GetSnapshotObject":               true,
+	"GenericObject.Lock":                            true,
+	"GenericObject.MultiRangeSelectHyperCubeValues": true,
+	"GenericObject.MultiRangeSelectTreeDataValues":  true,
+	"GenericObject.Publish":                         true,
+	"GenericObject.RangeSelectHyperCubeValues":      true,
+	"GenericObject.RemoveGroup":                     true,
+	"GenericObject.RemoveGroupMembers":              true,
+	"GenericObject.ResetMadeSelections":             true,
+	"GenericObject.SearchListObjectFor":             true,
+	"GenericObject.SelectHyperCubeCells":            true,
+	"GenericObject.SelectHyperCubeContinuousRange":  true,
+	"GenericObject.SelectHyperCubeValues":           true,
+	"GenericObject.SelectListObjectAll":             true,
+	"GenericObject.SelectListObjectAlternative":     true,
+	"GenericObject.SelectListObjectContinuousRange": true,
+	"GenericObject.SelectListObjectExcluded":        true,
+	"GenericObject.SelectListObjectPossible":        true,
+	"GenericObject.SelectListObjectValues":          true,
+	"GenericObject.SelectPivotCells":                true,
+	"GenericObject.SetActiveField":                  true,
+	"GenericObject.SetChildArrayOrder":              true,
+	"GenericObject.SetFullPropertyTree":             true,
+	"GenericObject.SetGroupLabel":                   true,
+	"GenericObject.SetProperties":                   true,
+	"GenericObject.StepCycle":                       true,
+	"GenericObject.UnApprove":                       true,
+	"GenericObject.UnPublish":                       true,
+	"GenericObject.Unlock":                          true,
+	"GenericVariable.ApplyPatches":                  true,
+	"GenericVariable.GetInfo":                       true,
+	"GenericVariable.GetLayout":                     true,
+	"GenericVariable.GetProperties":                 true,
+	"GenericVariable.GetRawContent":                 true,
+	"GenericVariable.SetDualValue":                  true,
+	"GenericVariable.SetNumValue":                   true,
+	"GenericVariable.SetProperties":                 true,
+	"GenericVariable.SetStringValue":                true,
+	"Global.AbortAll":                               true,
+	"Global.AbortRequest":                           true,
+	"Global.AllowCreateApp":                         true,
+	"Global.CancelReload":                           true,
+	"Global.CancelRequest":                          true,
+	"Global.ConfigureReload":                        true,
+	"Global.CopyApp":                                true,
+	"Global.CreateApp":                              true,
+	"Global.CreateDocEx":                            true,
+	"Global.CreateSessionApp":                       true,
+	"Global.CreateSessionAppFromApp":                true,
+	"Global.DeleteApp":                              true,
+	"Global.EngineVersion":                          true,
+	"Global.ExportApp":                              true,
+	"Global.GetActiveDoc":                           true,
+	"Global.GetAppEntry":                            true,
+	"Global.GetAuthenticatedUser":                   true,
+	"Global.GetBNF":                                 true,
+	"Global.GetBaseBNF":                             true,
+	"Global.GetBaseBNFHash":                         true,
+	"Global.GetBaseBNFString":                       true,
+	"Global.GetCustomConnectors":                    true,
+	"Global.GetDatabasesFromConnectionString":       true,
+	"Global.GetDefaultAppFolder":                    true,
+	"Global.GetDocList":                             true,
+	"Global.GetFolderItemsForPath":                  true,
+	"Global.GetFunctions":                           true,
+	"Global.GetInteract":                            true,
+	"Global.GetLogicalDriveStrings":                 true,
+	"Global.GetOdbcDsns":                            true,
+	"Global.GetOleDbProviders":                      true,
+	"Global.GetProgress":                            true,
+	"Global.GetStreamList":                          true,
+	"Global.GetSupportedCodePages":                  true,
+	"Global.GetUniqueID":                            true,
+	"Global.InteractDone":                           true,
+	"Global.IsDesktopMode":                          true,
+	"Global.IsPersonalMode":                         true,
+	"Global.IsValidConnectionString":                true,
+	"Global.OSName":                                 true,
+	"Global.OSVersion":                              true,
+	"Global.OpenDoc":                                true,
+	"Global.ProductVersion":                         true,
+	"Global.PublishApp":                             true,
+	"Global.QTProduct":                              true,
+	"Global.QvVersion":                              true,
+	"Global.ReloadExtensionList":                    true,
+	"Global.ReplaceAppFromID":                       true,
+	"Global.SaveAs":                                 true,
+	"Global.ShutdownProcess":                        true,
+	"Variable.ForceContent":                         true,
+	"Variable.GetContent":                           true,
+	"Variable.GetNxProperties":                      true,
+	"Variable.GetRawContent":                        true,
+	"Variable.SetContent":                           true,
+	"Variable.SetNxProperties":                      true,
+}
+
+func init() {
+	RegisterEngineSchema(QIX_SCHEMA_VERSION, SchemaMethods)
+}
+
+// MethodParams lists the parameters of the methods of the schema, keyed by object type and method name. It is
+// used by NewValidationInterceptor to find missing required parameters.
+var MethodParams = map[string][]ParamSchema{
+	"Doc.AbortModal":                                {{Name: "qAccept", Required: true}},
+	"Doc.AddAlternateState":                         {{Name: "qStateName", Required: true}},
+	"Doc.AddFieldFromExpression":                    {{Name: "qName", Required: true}, {Name: "qExpr", Required: true}},
+	"Doc.AddSessionAlternateState":                  {{Name: "qStateName", Required: true}, {Name: "qSourceStateName", Required: false}},
+	"Doc.ApplyAndVerifyBookmark":                    {{Name: "qId", Required: true}},
+	"Doc.ApplyBookmark":                             {{Name: "qId", Required: true}},
+	"Doc.ApplyGroupStates":                          {{Name: "qGroupStates", Required: true}},
+	"Doc.ApplyTemporaryBookmark":                    {{Name: "qId", Required: true}},
+	"Doc.ChangeSessionAppOwner":                     {{Name: "qNewOwnerId", Required: true}},
+	"Doc.ChangeSessionAppSpace":                     {{Name: "qSpaceId", Required: true}},
+	"Doc.CheckExpression":                           {{Name: "qExpr", Required: true}, {Name: "qLabels", Required: false}},
+	"Doc.CheckNumberOrExpression":                   {{Name: "qExpr", Required: true}},
+	"Doc.ClearAll":                                  {{Name: "qLockedAlso", Required: false}, {Name: "qStateName", Required: false}},
+	"Doc.CloneBookmark":                             {{Name: "qId", Required: true}},
+	"Doc.CloneDimension":                            {{Name: "qId", Required: true}},
+	"Doc.CloneMeasure":                              {{Name: "qId", Required: true}},
+	"Doc.CloneObject":                               {{Name: "qId", Required: true}},
+	"Doc.CommitDraft":                               {{Name: "qId", Required: true}},
+	"Doc.CommitScript":                              {{Name: "qCommitMessage", Required: false}},
+	"Doc.CreateBookmark":                            {{Name: "qProp", Required: true}},
+	"Doc.CreateBookmarkEx":                          {{Name: "qProp", Required: true}, {Name: "qObjectIdsToPatch", Required: false}},
+	"Doc.CreateConnection":                          {{Name: "qConnection", Required: true}},
+	"Doc.CreateDimension":                           {{Name: "qProp", Required: true}},
+	"Doc.CreateDraft":                               {{Name: "qId", Required: true}},
+	"Doc.CreateMeasure":                             {{Name: "qProp", Required: true}},
+	"Doc.CreateObject":                              {{Name: "qProp", Required: true}},
+	"Doc.CreateSessionObject":                       {{Name: "qProp", Required: true}},
+	"Doc.CreateSessionVariable":                     {{Name: "qProp", Required: true}},
+	"Doc.CreateTemporaryBookmark":                   {{Name: "qOptions", Required: true}, {Name: "qObjectIdsToPatch", Required: false}},
+	"Doc.CreateVariable":                            {{Name: "qName", Required: true}},
+	"Doc.CreateVariableEx":                          {{Name: "qProp", Required: true}},
+	"Doc.DeleteConnection":                          {{Name: "qConnectionId", Required: true}},
+	"Doc.DestroyBookmark":                           {{Name: "qId", Required: true}},
+	"Doc.DestroyDimension":                          {{Name: "qId", Required: true}},
+	"Doc.DestroyDraft":                              {{Name: "qId", Required: true}, {Name: "qSourceId", Required: true}},
+	"Doc.DestroyMeasure":                            {{Name: "qId", Required: true}},
+	"Doc.DestroyObject":                             {{Name: "qId", Required: true}},
+	"Doc.DestroySessionObject":                      {{Name: "qId", Required: true}},
+	"Doc.DestroySessionVariable":                    {{Name: "qId", Required: true}},
+	"Doc.DestroySessionVariableById":                {{Name: "qId", Required: true}},
+	"Doc.DestroySessionVariableByName":              {{Name: "qName", Required: true}},
+	"Doc.DestroyVariableById":                       {{Name: "qId", Required: true}},
+	"Doc.DestroyVariableByName":                     {{Name: "qName", Required: true}},
+	"Doc.DoReload":                                  {{Name: "qMode", Required: false}, {Name: "qPartial", Required: false}, {Name: "qDebug", Required: false}},
+	"Doc.DoReloadEx":                                {{Name: "qParams", Required: false}},
+	"Doc.DoSave":                                    {{Name: "qFileName", Required: false}},
+	"Doc.Evaluate":                                  {{Name: "qExpression", Required: true}},
+	"Doc.EvaluateEx":                                {{Name: "qExpression", Required: true}},
+	"Doc.ExpandExpression":                          {{Name: "qExpression", Required: true}},
+	"Doc.ExportReducedData":                         {{Name: "qOptions", Required: false}},
+	"Doc.FindMatchingFields":                        {{Name: "qFieldName", Required: true}, {Name: "qTags", Required: true}},
+	"Doc.GetAssociationScores":                      {{Name: "qTable1", Required: true}, {Name: "qTable2", Required: true}},
+	"Doc.GetBookmark":                               {{Name: "qId", Required: true}},
+	"Doc.GetBookmarks":                              {{Name: "qOptions", Required: true}},
+	"Doc.GetConnection":                             {{Name: "qConnectionId", Required: true}},
+	"Doc.GetDatabaseInfo":                           {{Name: "qConnectionId", Required: true}},
+	"Doc.GetDatabaseOwners":                         {{Name: "qConnectionId", Required: true}, {Name: "qDatabase", Required: false}},
+	"Doc.GetDatabaseTableFields":                    {{Name: "qConnectionId", Required: true}, {Name: "qDatabase", Required: false}, {Name: "qOwner", Required: false}, {Name: "qTable", Required: true}},
+	"Doc.GetDatabaseTablePreview":                   {{Name: "qConnectionId", Required: true}, {Name: "qDatabase", Required: false}, {Name: "qOwner", Required: false}, {Name: "qTable", Required: true}, {Name: "qConditions", Required: false}},
+	"Doc.GetDatabaseTables":                         {{Name: "qConnectionId", Required: true}, {Name: "qDatabase", Required: false}, {Name: "qOwner", Required: false}},
+	"Doc.GetDatabases":                              {{Name: "qConnectionId", Required: true}},
+	"Doc.GetDimension":                              {{Name: "qId", Required: true}},
+	"Doc.GetEmptyScript":                            {{Name: "qLocalizedMainSection", Required: false}},
+	"Doc.GetField":                                  {{Name: "qFieldName", Required: true}, {Name: "qStateName", Required: false}},
+	"Doc.GetFieldAndColumnSamples":                  {{Name: "qFieldsOrColumnsWithWildcards", Required: true}, {Name: "qMaxNumberOfValues", Required: true}, {Name: "qRandSeed", Required: false}},
+	"Doc.GetFieldDescription":                       {{Name: "qFieldName", Required: true}},
+	"Doc.GetFieldOnTheFlyByName":                    {{Name: "qReadableName", Required: true}},
+	"Doc.GetFieldsFromExpression":                   {{Name: "qExpr", Required: true}},
+	"Doc.GetFieldsResourceIds":                      {{Name: "qFieldNames", Required: true}},
+	"Doc.GetFileTableFields":                        {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}, {Name: "qDataFormat", Required: true}, {Name: "qTable", Required: true}},
+	"Doc.GetFileTablePreview":                       {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}, {Name: "qDataFormat", Required: true}, {Name: "qTable", Required: true}},
+	"Doc.GetFileTables":                             {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}, {Name: "qDataFormat", Required: true}},
+	"Doc.GetFileTablesEx":                           {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}, {Name: "qDataFormat", Required: true}},
+	"Doc.GetFolderItemsForConnection":               {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}},
+	"Doc.GetIncludeFileContent":                     {{Name: "qPath", Required: true}},
+	"Doc.GetLibraryContent":                         {{Name: "qName", Required: true}},
+	"Doc.GetMatchingFields":                         {{Name: "qTags", Required: true}, {Name: "qMatchingFieldMode", Required: false}},
+	"Doc.GetMeasure":                                {{Name: "qId", Required: true}},
+	"Doc.GetMeasureWithLabel":                       {{Name: "qLabel", Required: true}},
+	"Doc.GetObject":                                 {{Name: "qId", Required: true}},
+	"Doc.GetObjects":                                {{Name: "qOptions", Required: true}},
+	"Doc.GetOrCreateObject":                         {{Name: "qProp", Required: true}},
+	"Doc.GetSetAnalysis":                            {{Name: "qStateName", Required: false}, {Name: "qBookmarkId", Required: false}},
+	"Doc.GetTableData":                              {{Name: "qOffset", Required: true}, {Name: "qRows", Required: true}, {Name: "qSyntheticMode", Required: true}, {Name: "qTableName", Required: true}},
+	"Doc.GetTableProfileData":                       {{Name: "qTableName", Required: true}},
+	"Doc.GetTablesAndKeys":                          {{Name: "qWindowSize", Required: true}, {Name: "qNullSize", Required: true}, {Name: "qCellHeight", Required: true}, {Name: "qSyntheticMode", Required: true}, {Name: "qIncludeSysVars", Required: true}, {Name: "qIncludeProfiling", Required: false}},
+	"Doc.GetVariable":                               {{Name: "qName", Required: true}},
+	"Doc.GetVariableById":                           {{Name: "qId", Required: true}},
+	"Doc.GetVariableByName":                         {{Name: "qName", Required: true}},
+	"Doc.GetVariables":                              {{Name: "qListDef", Required: true}},
+	"Doc.GuessFileType":                             {{Name: "qConnectionId", Required: true}, {Name: "qRelativePath", Required: false}},
+	"Doc.LockAll":                                   {{Name: "qStateName", Required: false}},
+	"Doc.ModifyConnection":                          {{Name: "qConnectionId", Required: true}, {Name: "qConnection", Required: true}, {Name: "qOverrideCredentials", Required: false}},
+	"Doc.Publish":                                   {{Name: "qStreamId", Required: true}, {Name: "qName", Required: false}},
+	"Doc.RemoveAlternateState":                      {{Name: "qStateName", Required: true}},
+	"Doc.RemoveSessionAlternateState":               {{Name: "qStateName", Required: true}},
+	"Doc.RemoveVariable":                            {{Name: "qName", Required: true}},
+	"Doc.ReplaceBookmark":                           {{Name: "qId", Required: true}, {Name: "qIgnorePatches", Required: false}, {Name: "qObjectIdsToPatch", Required: false}},
+	"Doc.RestoreTempSelectionState":                 {{Name: "qId", Required: true}},
+	"Doc.SaveAs":                                    {{Name: "qNewAppName", Required: true}},
+	"Doc.Scramble":                                  {{Name: "qFieldName", Required: true}},
+	"Doc.SearchAssociations":                        {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}, {Name: "qPage", Required: true}},
+	"Doc.SearchObjects":                             {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}, {Name: "qPage", Required: true}},
+	"Doc.SearchResults":                             {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}, {Name: "qPage", Required: true}},
+	"Doc.SearchSuggest":                             {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}},
+	"Doc.SearchValues":                              {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}, {Name: "qPage", Required: true}},
+	"Doc.SelectAssociations":                        {{Name: "qOptions", Required: true}, {Name: "qTerms", Required: true}, {Name: "qMatchIx", Required: true}, {Name: "qSoftLock", Required: false}},
+	"Doc.SendGenericCommandToCustomConnector":       {{Name: "qProvider", Required: true}, {Name: "qCommand", Required: true}, {Name: "qMethod", Required: true}, {Name: "qParameters", Required: true}, {Name: "qAppendConnection", Required: true}},
+	"Doc.SetAppProperties":                          {{Name: "qProp", Required: true}},
+	"Doc.SetFavoriteVariables":                      {{Name: "qNames", Required: true}},
+	"Doc.SetFetchLimit":                             {{Name: "qLimit", Required: true}},
+	"Doc.SetLooselyCoupledVector":                   {{Name: "qv", Required: true}},
+	"Doc.SetProhibitBinaryLoad":                     {{Name: "qProhibit", Required: true}},
+	"Doc.SetScript":                                 {{Name: "qScript", Required: true}},
+	"Doc.SetScriptBreakpoints":                      {{Name: "qBreakpoints", Required: true}},
+	"Doc.SetViewDlgSaveInfo":                        {{Name: "qInfo", Required: true}},
+	"Doc.StoreTempSelectionState":                   {{Name: "qTTLOfTempState", Required: false}},
+	"Doc.TransformApp":                              {{Name: "qDstParameters", Required: true}},
+	"Doc.UnlockAll":                                 {{Name: "qStateName", Required: false}},
+	"Field.ClearAllButThis":                         {{Name: "qSoftLock", Required: false}},
+	"Field.LowLevelSelect":                          {{Name: "qValues", Required: true}, {Name: "qToggleMode", Required: true}, {Name: "qSoftLock", Required: false}},
+	"Field.Select":                                  {{Name: "qMatch", Required: true}, {Name: "qSoftLock", Required: false}, {Name: "qExcludedValuesMode", Required: false}},
+	"Field.SelectAll":                               {{Name: "qSoftLock", Required: false}},
+	"Field.SelectAlternative":                       {{Name: "qSoftLock", Required: false}},
+	"Field.SelectExcluded":                          {{Name: "qSoftLock", Required: false}},
+	"Field.SelectPossible":                          {{Name: "qSoftLock", Required: false}},
+	"Field.SelectValues":                            {{Name: "qFieldValues", Required: true}, {Name: "qToggleMode", Required: false}, {Name: "qSoftLock", Required: false}},
+	"Field.SetAndMode":                              {{Name: "qAndMode", Required: true}},
+	"Field.SetNxProperties":                         {{Name: "qProperties", Required: true}},
+	"Field.ToggleSelect":                            {{Name: "qMatch", Required: true}, {Name: "qSoftLock", Required: false}, {Name: "qExcludedValuesMode", Required: false}},
+	"GenericBookmark.ApplyPatches":                  {{Name: "qPatches", Required: true}},
+	"GenericBookmark.GetFieldValues":                {{Name: "qField", Required: true}, {Name: "qGetExcludedValues", Required: true}, {Name: "qDataPage", Required: true}},
+	"GenericBookmark.GetFieldValuesEx":              {{Name: "qField", Required: true}, {Name: "qGetExcludedValues", Required: true}, {Name: "qDataPages", Required: true}},
+	"GenericBookmark.SetProperties":                 {{Name: "qProp", Required: true}},
+	"GenericDimension.ApplyPatches":                 {{Name: "qPatches", Required: true}},
+	"GenericDimension.SetActiveField":               {{Name: "qIx", Required: true}},
+	"GenericDimension.SetProperties":                {{Name: "qProp", Required: true}},
+	"GenericDimension.StepCycle":                    {{Name: "qStep", Required: true}},
+	"GenericMeasure.ApplyPatches":                   {{Name: "qPatches", Required: true}},
+	"GenericMeasure.SetProperties":                  {{Name: "qProp", Required: true}},
+	"GenericObject.AbortListObjectSearch":           {{Name: "qPath", Required: true}},
+	"GenericObject.AcceptListObjectSearch":          {{Name: "qPath", Required: true}, {Name: "qToggleMode", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.AddGroupMembers":                 {{Name: "qPath", Required: true}, {Name: "qMembers", Required: true}, {Name: "qTargetGroupId", Required: false}, {Name: "qPosId", Required: false}},
+	"GenericObject.ApplyPatches":                    {{Name: "qPatches", Required: true}, {Name: "qSoftPatch", Required: false}},
+	"GenericObject.BeginSelections":                 {{Name: "qPaths", Required: true}},
+	"GenericObject.ClearSelections":                 {{Name: "qPath", Required: true}, {Name: "qColIndices", Required: false}},
+	"GenericObject.CollapseLeft":                    {{Name: "qPath", Required: true}, {Name: "qRow", Required: true}, {Name: "qCol", Required: true}, {Name: "qAll", Required: true}},
+	"GenericObject.CollapseTop":                     {{Name: "qPath", Required: true}, {Name: "qRow", Required: true}, {Name: "qCol", Required: true}, {Name: "qAll", Required: true}},
+	"GenericObject.CopyFrom":                        {{Name: "qFromId", Required: true}},
+	"GenericObject.CreateChild":                     {{Name: "qProp", Required: true}, {Name: "qPropForThis", Required: false}},
+	"GenericObject.CreateGroup":                     {{Name: "qPath", Required: true}, {Name: "qGroupDef", Required: true}, {Name: "qTargetGroupId", Required: false}},
+	"GenericObject.DestroyAllChildren":              {{Name: "qPropForThis", Required: false}},
+	"GenericObject.DestroyChild":                    {{Name: "qId", Required: true}, {Name: "qPropForThis", Required: false}},
+	"GenericObject.DrillUp":                         {{Name: "qPath", Required: true}, {Name: "qDimNo", Required: true}, {Name: "qNbrSteps", Required: true}},
+	"GenericObject.EmbedSnapshotObject":             {{Name: "qId", Required: true}},
+	"GenericObject.EndSelections":                   {{Name: "qAccept", Required: true}},
+	"GenericObject.ExpandLeft":                      {{Name: "qPath", Required: true}, {Name: "qRow", Required: true}, {Name: "qCol", Required: true}, {Name: "qAll", Required: true}},
+	"GenericObject.ExpandTop":                       {{Name: "qPath", Required: true}, {Name: "qRow", Required: true}, {Name: "qCol", Required: true}, {Name: "qAll", Required: true}},
+	"GenericObject.ExportData":                      {{Name: "qFileType", Required: true}, {Name: "qPath", Required: false}, {Name: "qFileName", Required: false}, {Name: "qExportState", Required: false}, {Name: "qServeOnce", Required: false}},
+	"GenericObject.GetChild":                        {{Name: "qId", Required: true}},
+	"GenericObject.GetHyperCubeBinnedData":          {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}, {Name: "qViewport", Required: true}, {Name: "qDataRanges", Required: true}, {Name: "qMaxNbrCells", Required: true}, {Name: "qQueryLevel", Required: true}, {Name: "qBinningMethod", Required: true}},
+	"GenericObject.GetHyperCubeContinuousData":      {{Name: "qPath", Required: true}, {Name: "qOptions", Required: true}, {Name: "qReverseSort", Required: false}},
+	"GenericObject.GetHyperCubeData":                {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}},
+	"GenericObject.GetHyperCubePivotData":           {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}},
+	"GenericObject.GetHyperCubeReducedData":         {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}, {Name: "qZoomFactor", Required: true}, {Name: "qReductionMode", Required: true}},
+	"GenericObject.GetHyperCubeStackData":           {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}, {Name: "qMaxNbrCells", Required: false}},
+	"GenericObject.GetHyperCubeTreeData":            {{Name: "qPath", Required: true}, {Name: "qNodeOptions", Required: false}},
+	"GenericObject.GetListObjectData":               {{Name: "qPath", Required: true}, {Name: "qPages", Required: true}},
+	"GenericObject.Lock":                            {{Name: "qPath", Required: true}, {Name: "qColIndices", Required: false}},
+	"GenericObject.MultiRangeSelectHyperCubeValues": {{Name: "qPath", Required: true}, {Name: "qRanges", Required: true}, {Name: "qOrMode", Required: false}, {Name: "qDeselectOnlyOneSelected", Required: false}},
+	"GenericObject.MultiRangeSelectTreeDataValues":  {{Name: "qPath", Required: true}, {Name: "qRanges", Required: true}, {Name: "qOrMode", Required: false}, {Name: "qDeselectOnlyOneSelected", Required: false}},
+	"GenericObject.RangeSelectHyperCubeValues":      {{Name: "qPath", Required: true}, {Name: "qRanges", Required: true}, {Name: "qColumnsToSelect", Required: false}, {Name: "qOrMode", Required: false}, {Name: "qDeselectOnlyOneSelected", Required: false}},
+	"GenericObject.RemoveGroup":                     {{Name: "qPath", Required: true}, {Name: "qGroupId", Required: true}},
+	"GenericObject.RemoveGroupMembers":              {{Name: "qPath", Required: true}, {Name: "qMembers", Required: true}, {Name: "qTargetGroupId", Required: false}},
+	"GenericObject.SearchListObjectFor":             {{Name: "qPath", Required: true}, {Name: "qMatch", Required: true}},
+	"GenericObject.SelectHyperCubeCells":            {{Name: "qPath", Required: true}, {Name: "qRowIndices", Required: true}, {Name: "qColIndices", Required: true}, {Name: "qSoftLock", Required: false}, {Name: "qDeselectOnlyOneSelected", Required: false}},
+	"GenericObject.SelectHyperCubeContinuousRange":  {{Name: "qPath", Required: true}, {Name: "qRanges", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectHyperCubeValues":           {{Name: "qPath", Required: true}, {Name: "qDimNo", Required: true}, {Name: "qValues", Required: true}, {Name: "qToggleMode", Required: true}},
+	"GenericObject.SelectListObjectAll":             {{Name: "qPath", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectListObjectAlternative":     {{Name: "qPath", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectListObjectContinuousRange": {{Name: "qPath", Required: true}, {Name: "qRanges", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectListObjectExcluded":        {{Name: "qPath", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectListObjectPossible":        {{Name: "qPath", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectListObjectValues":          {{Name: "qPath", Required: true}, {Name: "qValues", Required: true}, {Name: "qToggleMode", Required: true}, {Name: "qSoftLock", Required: false}},
+	"GenericObject.SelectPivotCells":                {{Name: "qPath", Required: true}, {Name: "qSelections", Required: true}, {Name: "qSoftLock", Required: false}, {Name: "qDeselectOnlyOneSelected", Required: false}},
+	"GenericObject.SetActiveField":                  {{Name: "qPath", Required: true}, {Name: "qDimNo", Required: true}, {Name: "qNewIndex", Required: true}},
+	"GenericObject.SetChildArrayOrder":              {{Name: "qIds", Required: true}},
+	"GenericObject.SetFullPropertyTree":             {{Name: "qPropEntry", Required: true}},
+	"GenericObject.SetGroupLabel":                   {{Name: "qPath", Required: true}, {Name: "qNewLabel", Required: true}, {Name: "qTargetGroupId", Required: false}},
+	"GenericObject.SetProperties":                   {{Name: "qProp", Required: true}},
+	"GenericObject.StepCycle":                       {{Name: "qPath", Required: true}, {Name: "qDimNo", Required: true}, {Name: "qNbrSteps", Required: true}},
+	"GenericObject.Unlock":                          {{Name: "qPath", Required: true}, {Name: "qColIndices", Required: false}},
+	"GenericVariable.ApplyPatches":                  {{Name: "qPatches", Required: true}},
+	"GenericVariable.SetDualValue":                  {{Name: "qText", Required: true}, {Name: "qNum", Required: true}},
+	"GenericVariable.SetNumValue":                   {{Name: "qVal", Required: true}},
+	"GenericVariable.SetProperties":                 {{Name: "qProp", Required: true}},
+	"GenericVariable.SetStringValue":                {{Name: "qVal", Required: true}},
+	"Global.AbortRequest":                           {{Name: "qRequestId", Required: true}},
+	"Global.CancelReload":                           {{Name: "qReason", Required: false}},
+	"Global.CancelRequest":                          {{Name: "qRequestId", Required: true}},
+	"Global.ConfigureReload":                        {{Name: "qCancelOnScriptError", Required: true}, {Name: "qUseErrorData", Required: true}, {Name: "qInteractOnError", Required: true}},
+	"Global.CopyApp":                                {{Name: "qTargetAppId", Required: true}, {Name: "qSrcAppId", Required: true}, {Name: "qIds", Required: true}},
+	"Global.CreateApp":                              {{Name: "qAppName", Required: true}, {Name: "qLocalizedScriptMainSection", Required: false}, {Name: "qLocale", Required: false}},
+	"Global.CreateDocEx":                            {{Name: "qDocName", Required: true}, {Name: "qUserName", Required: false}, {Name: "qPassword", Required: false}, {Name: "qSerial", Required: false}, {Name: "qLocalizedScriptMainSection", Required: false}},
+	"Global.CreateSessionAppFromApp":                {{Name: "qSrcAppId", Required: true}},
+	"Global.DeleteApp":                              {{Name: "qAppId", Required: true}},
+	"Global.ExportApp":                              {{Name: "qTargetPath", Required: true}, {Name: "qSrcAppId", Required: true}, {Name: "qIds", Required: true}, {Name: "qNoData", Required: false}},
+	"Global.GetAppEntry":                            {{Name: "qAppID", Required: true}},
+	"Global.GetBNF":                                 {{Name: "qBnfType", Required: true}},
+	"Global.GetBaseBNF":                             {{Name: "qBnfType", Required: true}},
+	"Global.GetBaseBNFHash":                         {{Name: "qBnfType", Required: true}},
+	"Global.GetBaseBNFString":                       {{Name: "qBnfType", Required: true}},
+	"Global.GetCustomConnectors":                    {{Name: "qReloadList", Required: false}},
+	"Global.GetDatabasesFromConnectionString":       {{Name: "qConnection", Required: true}},
+	"Global.GetFolderItemsForPath":                  {{Name: "qPath", Required: true}},
+	"Global.GetFunctions":                           {{Name: "qGroup", Required: false}},
+	"Global.GetInteract":                            {{Name: "qRequestId", Required: true}},
+	"Global.GetProgress":                            {{Name: "qRequestId", Required: true}},
+	"Global.InteractDone":                           {{Name: "qRequestId", Required: true}, {Name: "qDef", Required: true}},
+	"Global.IsValidConnectionString":                {{Name: "qConnection", Required: true}},
+	"Global.OpenDoc":                                {{Name: "qDocName", Required: true}, {Name: "qUserName", Required: false}, {Name: "qPassword", Required: false}, {Name: "qSerial", Required: false}, {Name: "qNoData", Required: false}},
+	"Global.PublishApp":                             {{Name: "qAppId", Required: true}, {Name: "qName", Required: true}, {Name: "qStreamId", Required: true}},
+	"Global.ReplaceAppFromID":                       {{Name: "qTargetAppId", Required: true}, {Name: "qSrcAppID", Required: true}, {Name: "qIds", Required: true}},
+	"Global.SaveAs":                                 {{Name: "qNewAppName", Required: true}},
+	"Variable.ForceContent":                         {{Name: "qs", Required: true}, {Name: "qd", Required: true}},
+	"Variable.SetContent":                           {{Name: "qContent", Required: true}, {Name: "qUpdateMRU", Required: true}},
+	"Variable.SetNxProperties":                      {{Name: "qProperties", Required: true}},
 }
@@ -0,0 +1,82 @@
+package enigma
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnums(t *testing.T) {
+	assert.True(t, NxSortIndicatorTypeAsc.IsValid())
+	assert.False(t, NxSortIndicatorType("X").IsValid())
+	assert.Equal(t, "A", NxSortIndicatorTypeAsc.String())
+	n, ok := NxLocalizedErrorCodeGenericUnknown.Int()
+	assert.True(t, ok)
+	assert.Equal(t, -1, n)
+
+	// Enums are sent by name and read by name or numeric value
+	bytes, err := json.Marshal(&NxPatch{Op: NxPatchOperationTypeReplace, Path: "/qInfo"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"qOp":"replace","qPath":"/qInfo"}`, string(bytes))
+	patch := &NxPatch{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"qOp":1}`), patch))
+	assert.Equal(t, NxPatchOperationTypeRemove, patch.Op)
+	assert.NoError(t, json.Unmarshal([]byte(`{"qOp":"replace"}`), patch))
+	assert.Equal(t, NxPatchOperationTypeReplace, patch.Op)
+	assert.Error(t, json.Unmarshal([]byte(`{"qOp":7}`), patch))
+
+	// Names unknown to this version are kept
+	var state StateEnumType
+	assert.NoError(t, json.Unmarshal([]byte(`"NEW"`), &state))
+	assert.Equal(t, StateEnumType("NEW"), state)
+	assert.False(t, state.IsValid())
+}
+
+func TestExtraProperties(t *testing.T) {
+	properties := &GenericObjectProperties{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"qInfo":{"qId":"chart","qType":"my-chart"},"color":"red","options":{"legend":true}}`), properties))
+	assert.Equal(t, "chart", properties.Info.Id)
+	assert.Equal(t, map[string]json.RawMessage{"color": json.RawMessage(`"red"`), "options": json.RawMessage(`{"legend":true}`)}, properties.Extra)
+
+	// Extra properties are sent back, the fields take precedence
+	properties.Extra["qInfo"] = json.RawMessage(`{"qId":"other"}`)
+	bytes, err := json.Marshal(properties)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"qInfo":{"qId":"chart","qType":"my-chart"},"color":"red","options":{"legend":true}}`, string(bytes))
+
+	// Without extra properties nothing changes
+	layout := &GenericObjectLayout{}
+	assert.NoError(t, json.Unmarshal([]byte(`{"qInfo":{"qId":"chart"}}`), layout))
+	assert.Nil(t, layout.Extra)
+	bytes, err = json.Marshal(layout)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"qInfo":{"qId":"chart"}}`, string(bytes))
+
+	// Only top level keys count and escaped keys are decoded
+	assert.False(t, hasUnknownProperties([]byte(`{"qInfo":{"color":"}"},"qHyperCube":[{"x":1}]}`), propertiesOfGenericObjectLayout))
+	assert.NoError(t, json.Unmarshal([]byte(`{"qInfo":{"qId":"chart"},"\u0063olor":"red"}`), layout))
+	assert.Equal(t, map[string]json.RawMessage{"color": json.RawMessage(`"red"`)}, layout.Extra)
+}
+
+func BenchmarkExtraPropertiesUnmarshal(b *testing.B) {
+	rows := make([]string, 100)
+	for i := range rows {
+		rows[i] = fmt.Sprintf(`[{"qText":"row %d","qNum":%d,"qElemNumber":%d,"qState":"O"}]`, i, i, i)
+	}
+	layout := `{"qInfo":{"qId":"chart","qType":"table"},"qHyperCube":{"qDataPages":[{"qMatrix":[` + strings.Join(rows, ",") + `]}]}`
+	for name, data := range map[string][]byte{
+		"Known": []byte(layout + "}"),
+		"Extra": []byte(layout + `,"color":"red"}`),
+	} {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := json.Unmarshal(data, &GenericObjectLayout{}); err != nil {
+					b.Error(err)
+				}
+			}
+		})
+	}
+}
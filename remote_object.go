@@ -16,6 +16,15 @@ type (
 		changedChannels map[chan struct{}]bool
 		closedCh        chan struct{}
 	}
+
+	// RemoteObjectAPI holds the methods shared by all remote objects. It is embedded in the generated interfaces,
+	// for instance DocAPI, so that code can depend on them and be tested with fakes or mocks.
+	RemoteObjectAPI interface {
+		ChangedChannel() chan struct{}
+		RemoveChangeChannel(channel chan struct{})
+		Closed() chan struct{}
+		RPC(ctx context.Context, method string, apiResponse interface{}, params ...interface{}) error
+	}
 )
 
 // ChangedChannel returns a channel that will receive changes when the underlying object is invalidated.
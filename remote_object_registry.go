@@ -6,9 +6,13 @@ import (
 
 type (
 	remoteObjectRegistry struct {
-		mutex         sync.Mutex
-		remoteObjects map[int]*RemoteObject
+		mutex           sync.Mutex
+		remoteObjects   map[int]*RemoteObject
+		updateListeners []handleUpdateListener
 	}
+
+	// handleUpdateListener is notified about all change and close lists handled by the registry
+	handleUpdateListener func(changed []int, closed []int)
 )
 
 func (r *remoteObjectRegistry) registerRemoteObject(rpcObject *RemoteObject) {
@@ -50,9 +54,13 @@ func (r *remoteObjectRegistry) handleUpdates(changed []int, closed []int) {
 		closedObjects[i] = r.remoteObjects[handle]
 		delete(r.remoteObjects, handle)
 	}
+	listeners := r.updateListeners
 	r.mutex.Unlock()
 
 	// Signal outside of the mutex to avoid locking multiple locks simultaneously (deadlock risk)
+	for _, listener := range listeners {
+		listener(changed, closed)
+	}
 	for _, x := range changedObjects {
 		if x != nil {
 			x.signalChanged()
@@ -65,6 +73,15 @@ func (r *remoteObjectRegistry) handleUpdates(changed []int, closed []int) {
 	}
 }
 
+func (r *remoteObjectRegistry) addHandleUpdateListener(listener handleUpdateListener) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	// Copy on write so that handleUpdates can iterate over the listeners without holding the lock
+	listeners := make([]handleUpdateListener, len(r.updateListeners), len(r.updateListeners)+1)
+	copy(listeners, r.updateListeners)
+	r.updateListeners = append(listeners, listener)
+}
+
 func (r *remoteObjectRegistry) signalAllObjectsClosed() {
 	r.mutex.Lock()
 	oldRemoteObjects := r.remoteObjects
@@ -0,0 +1,113 @@
+package enigma
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// RequestDeduplicator collapses concurrent invocations with identical object handle, method and params into one request
+	// towards Qlik Associative Engine and hands the response to every waiting caller. A caller that gives up by cancelling its
+	// context only leaves the shared request; the request itself is cancelled when no caller is waiting for it anymore.
+	// Use the Intercept method as an interceptor in the Dialer.
+	RequestDeduplicator struct {
+		methods map[string]bool
+		mutex   sync.Mutex
+		calls   map[requestDeduplicationKey]*sharedInvocation
+	}
+
+	requestDeduplicationKey struct {
+		session *session
+		handle  int
+		call    string
+	}
+
+	sharedInvocation struct {
+		waiters     int
+		cancel      context.CancelFunc
+		done        chan struct{}
+		response    *InvocationResponse
+		changeLists ChangeLists
+	}
+)
+
+// NewRequestDeduplicator creates a RequestDeduplicator for the supplied methods keyed by object type and method name, for instance
+// "GenericObject.GetLayout". If methods is nil the generated ReadOnlyMethods allowlist is used since collapsing calls that modify
+// state would change their meaning.
+func NewRequestDeduplicator(methods map[string]bool) *RequestDeduplicator {
+	if methods == nil {
+		methods = ReadOnlyMethods
+	}
+	return &RequestDeduplicator{methods: methods, calls: make(map[requestDeduplicationKey]*sharedInvocation)}
+}
+
+// Intercept implements the Interceptor function type
+func (d *RequestDeduplicator) Intercept(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+	remoteObject := invocation.RemoteObject
+	if remoteObject == nil || remoteObject.session == nil || remoteObject.ObjectInterface == nil || !d.methods[remoteObject.Type+"."+invocation.Method] {
+		return next(ctx, invocation)
+	}
+	// A reserved request id can only be used by one request
+	if ctx.Value(reservedRequestIDKey{}) != nil {
+		return next(ctx, invocation)
+	}
+	call, err := invocationCacheKey(invocation)
+	if err != nil {
+		return next(ctx, invocation)
+	}
+	key := requestDeduplicationKey{session: remoteObject.session, handle: remoteObject.Handle, call: call}
+
+	d.mutex.Lock()
+	shared := d.calls[key]
+	if shared == nil {
+		shared = d.startSharedInvocation(ctx, key, invocation, next)
+	}
+	shared.waiters++
+	d.mutex.Unlock()
+
+	select {
+	case <-shared.done:
+		if cl := changeListFromContext(ctx); cl != nil && shared.response.Error == nil {
+			cl.Changed = shared.changeLists.Changed
+			cl.Closed = shared.changeLists.Closed
+		}
+		return shared.response
+	case <-ctx.Done():
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+		shared.waiters--
+		if shared.waiters == 0 {
+			if d.calls[key] == shared {
+				delete(d.calls, key)
+			}
+			shared.cancel()
+		}
+		return &InvocationResponse{Error: ctx.Err()}
+	}
+}
+
+// startSharedInvocation sends the request on behalf of all waiters. It must be called with the mutex held.
+func (d *RequestDeduplicator) startSharedInvocation(ctx context.Context, key requestDeduplicationKey, invocation *Invocation, next InterceptorContinuation) *sharedInvocation {
+	// The shared request keeps the context values of the first caller but not its deadline or cancellation.
+	// Change lists are collected separately and handed to every caller, metrics are not collected for shared requests.
+	sharedCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	sharedCtx = context.WithValue(sharedCtx, metricsCollectorID{}, nil)
+	changeLists := &ChangeLists{}
+	sharedCtx = context.WithValue(sharedCtx, ChangeListsKey{}, changeLists)
+
+	shared := &sharedInvocation{cancel: cancel, done: make(chan struct{})}
+	d.calls[key] = shared
+	go func() {
+		defer cancel()
+		response := next(sharedCtx, invocation)
+		d.mutex.Lock()
+		if d.calls[key] == shared {
+			delete(d.calls, key)
+		}
+		shared.response = response
+		shared.changeLists = *changeLists
+		d.mutex.Unlock()
+		close(shared.done)
+	}()
+	return shared
+}
@@ -0,0 +1,115 @@
+package enigma
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForWaiters(d *RequestDeduplicator, waiters int) {
+	for {
+		d.mutex.Lock()
+		count := 0
+		for _, shared := range d.calls {
+			count += shared.waiters
+		}
+		d.mutex.Unlock()
+		if count == waiters {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRequestDeduplicator(t *testing.T) {
+	deduplicator := NewRequestDeduplicator(nil)
+	object := newRemoteObject(newSession(&Dialer{}), &ObjectInterface{Handle: 4, Type: "GenericObject"})
+
+	var calls int32
+	release := make(chan struct{})
+	next := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		ctx.Value(ChangeListsKey{}).(*ChangeLists).Changed = []int{4}
+		return &InvocationResponse{Result: json.RawMessage(`{"qLayout":{}}`), RequestID: 7}
+	}
+
+	var wg sync.WaitGroup
+	responses := make([]*InvocationResponse, 10)
+	changeLists := make([]*ChangeLists, 10)
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			changeLists[i] = &ChangeLists{}
+			ctx := context.WithValue(context.Background(), ChangeListsKey{}, changeLists[i])
+			responses[i] = deduplicator.Intercept(ctx, &Invocation{RemoteObject: object, Method: "GetLayout", Params: []interface{}{}}, next)
+		}(i)
+	}
+	waitForWaiters(deduplicator, 10)
+	close(release)
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls)
+	for i := range responses {
+		assert.Equal(t, 7, responses[i].RequestID)
+		assert.Equal(t, []int{4}, changeLists[i].Changed)
+	}
+	assert.Empty(t, deduplicator.calls)
+}
+
+func TestRequestDeduplicatorCancellation(t *testing.T) {
+	deduplicator := NewRequestDeduplicator(nil)
+	object := newRemoteObject(newSession(&Dialer{}), &ObjectInterface{Handle: 4, Type: "GenericObject"})
+
+	sharedCancelled := make(chan struct{})
+	next := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		<-ctx.Done()
+		close(sharedCancelled)
+		return &InvocationResponse{Error: ctx.Err()}
+	}
+	invoke := func(ctx context.Context, errs chan error) {
+		errs <- deduplicator.Intercept(ctx, &Invocation{RemoteObject: object, Method: "GetLayout"}, next).Error
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go invoke(ctx1, errs)
+	go invoke(ctx2, errs)
+	waitForWaiters(deduplicator, 2)
+
+	// The first waiter leaving must not cancel the shared request
+	cancel1()
+	assert.Equal(t, context.Canceled, <-errs)
+	select {
+	case <-sharedCancelled:
+		assert.Fail(t, "The shared request should still be running")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// When the last waiter leaves the shared request is cancelled
+	cancel2()
+	assert.Equal(t, context.Canceled, <-errs)
+	<-sharedCancelled
+}
+
+func TestRequestDeduplicatorPassThrough(t *testing.T) {
+	deduplicator := NewRequestDeduplicator(nil)
+	object := newRemoteObject(newSession(&Dialer{}), &ObjectInterface{Handle: 4, Type: "GenericObject"})
+	var calls int32
+	next := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		atomic.AddInt32(&calls, 1)
+		return &InvocationResponse{}
+	}
+	deduplicator.Intercept(context.Background(), &Invocation{RemoteObject: object, Method: "SetProperties"}, next)
+	ctx, _ := object.WithReservedRequestID(context.Background())
+	deduplicator.Intercept(ctx, &Invocation{RemoteObject: object, Method: "GetLayout"}, next)
+	assert.EqualValues(t, 2, calls)
+	assert.Empty(t, deduplicator.calls)
+}
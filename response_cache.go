@@ -0,0 +1,185 @@
+package enigma
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+type (
+	// ResponseCache memoizes the results of read-only methods per object handle. Entries for a handle are invalidated as soon
+	// as the handle appears in a change or close list and all entries for a session are dropped when it is disconnected.
+	// A hit never reaches Qlik Associative Engine so it produces no change lists and the metrics of a MetricsCollector
+	// only contain its timestamps, with zero message sizes. Use the Intercept method as an interceptor in the Dialer.
+	ResponseCache struct {
+		methods  map[string]bool
+		mutex    sync.Mutex
+		handles  map[responseCacheHandle]*responseCacheEntries
+		sessions map[*session]bool
+		stats    ResponseCacheStats
+	}
+
+	// ResponseCacheStats contains counters describing how a ResponseCache has been used
+	ResponseCacheStats struct {
+		// Hits is the number of invocations served from the cache
+		Hits int
+		// Misses is the number of cacheable invocations sent to Qlik Associative Engine
+		Misses int
+		// Invalidations is the number of times the entries of a handle were dropped due to a change or close list
+		Invalidations int
+		// Entries is the number of results currently held by the cache
+		Entries int
+	}
+
+	responseCacheHandle struct {
+		session *session
+		handle  int
+	}
+
+	responseCacheEntries struct {
+		// generation is increased on every invalidation so that responses to requests sent before the invalidation are not stored
+		generation uint64
+		results    map[string]json.RawMessage
+	}
+)
+
+// NewResponseCache creates a ResponseCache for the supplied methods keyed by object type and method name, for instance "GenericObject.GetLayout".
+// If readOnlyMethods is nil the generated ReadOnlyMethods allowlist is used.
+func NewResponseCache(readOnlyMethods map[string]bool) *ResponseCache {
+	if readOnlyMethods == nil {
+		readOnlyMethods = ReadOnlyMethods
+	}
+	return &ResponseCache{
+		methods:  readOnlyMethods,
+		handles:  make(map[responseCacheHandle]*responseCacheEntries),
+		sessions: make(map[*session]bool),
+	}
+}
+
+// Intercept implements the Interceptor function type
+func (c *ResponseCache) Intercept(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+	remoteObject := invocation.RemoteObject
+	if remoteObject == nil || remoteObject.session == nil || remoteObject.ObjectInterface == nil || !c.methods[remoteObject.Type+"."+invocation.Method] {
+		return next(ctx, invocation)
+	}
+	key, err := invocationCacheKey(invocation)
+	if err != nil {
+		return next(ctx, invocation)
+	}
+	cacheHandle := responseCacheHandle{session: remoteObject.session, handle: remoteObject.Handle}
+
+	c.mutex.Lock()
+	c.watchSession(remoteObject.session)
+	entries := c.handles[cacheHandle]
+	if entries == nil {
+		entries = &responseCacheEntries{results: make(map[string]json.RawMessage)}
+		c.handles[cacheHandle] = entries
+	}
+	if result, ok := entries.results[key]; ok {
+		c.stats.Hits++
+		c.mutex.Unlock()
+		if metricsCollector := getMetricsCollector(ctx); metricsCollector != nil {
+			now := time.Now()
+			metricsCollector.Lock()
+			metricsCollector.metrics.InvocationRequestTimestamp = now
+			metricsCollector.metrics.SocketWriteTimestamp = now
+			metricsCollector.metrics.SocketReadTimestamp = now
+			metricsCollector.metrics.InvocationResponseTimestamp = now
+			metricsCollector.metrics.RequestMessageSize = 0
+			metricsCollector.metrics.ResponseMessageSize = 0
+			metricsCollector.Unlock()
+		}
+		// Callers may decode into or modify the result so they get a copy
+		return &InvocationResponse{Result: append(json.RawMessage(nil), result...)}
+	}
+	c.stats.Misses++
+	generation := entries.generation
+	c.mutex.Unlock()
+
+	response := next(ctx, invocation)
+	if response.Error != nil {
+		return response
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.handles[cacheHandle] == entries && entries.generation == generation {
+		if _, ok := entries.results[key]; !ok {
+			c.stats.Entries++
+		}
+		entries.results[key] = append(json.RawMessage(nil), response.Result...)
+	}
+	return response
+}
+
+// Stats returns a snapshot of the cache counters
+func (c *ResponseCache) Stats() ResponseCacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.stats
+}
+
+// Purge drops all cached results
+func (c *ResponseCache) Purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, entries := range c.handles {
+		entries.generation++
+	}
+	c.handles = make(map[responseCacheHandle]*responseCacheEntries)
+	c.stats.Entries = 0
+}
+
+// watchSession subscribes to handle updates and disconnection of a session the first time it is seen.
+// It must be called with the mutex held.
+func (c *ResponseCache) watchSession(s *session) {
+	if c.sessions[s] {
+		return
+	}
+	c.sessions[s] = true
+	s.addHandleUpdateListener(func(changed []int, closed []int) {
+		c.invalidate(s, changed)
+		c.invalidate(s, closed)
+	})
+	go func() {
+		<-s.Disconnected()
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		delete(c.sessions, s)
+		for cacheHandle, entries := range c.handles {
+			if cacheHandle.session == s {
+				c.stats.Entries -= len(entries.results)
+				entries.generation++
+				delete(c.handles, cacheHandle)
+			}
+		}
+	}()
+}
+
+func (c *ResponseCache) invalidate(s *session, handles []int) {
+	if len(handles) == 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, handle := range handles {
+		cacheHandle := responseCacheHandle{session: s, handle: handle}
+		if entries := c.handles[cacheHandle]; entries != nil {
+			c.stats.Entries -= len(entries.results)
+			c.stats.Invalidations++
+			entries.generation++
+			delete(c.handles, cacheHandle)
+		}
+	}
+}
+
+// invocationCacheKey creates a key identifying an invocation on a given object by method and canonicalized params
+func invocationCacheKey(invocation *Invocation) (string, error) {
+	params, err := marshal(invocation.params())
+	if err != nil {
+		return "", err
+	}
+	return invocation.Method + string(params), nil
+}
@@ -0,0 +1,108 @@
+package enigma
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseCache(t *testing.T) {
+	ctx := context.Background()
+	cache := NewResponseCache(nil)
+	session := newSession(&Dialer{
+		CreateSocket: func(ctx context.Context, url string, header http.Header) (Socket, error) { return NewMockSocket("") },
+		Interceptors: []Interceptor{cache.Intercept},
+	})
+	session.connect(ctx, "", nil)
+	testSocket := session.GetMockSocket()
+	object := session.getRemoteObject(&ObjectInterface{Handle: 4, Type: "GenericObject"})
+	changedChannel := object.ChangedChannel()
+
+	testSocket.ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"GetLayout","handle":4,"id":1,"params":[]}`,
+		`{"jsonrpc":"2.0","id":1,"result":{"qLayout":{"qInfo":{"qId":"first"}}}}`)
+	testSocket.ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"GetLayout","handle":4,"id":2,"params":[]}`,
+		`{"jsonrpc":"2.0","id":2,"result":{"qLayout":{"qInfo":{"qId":"second"}}}}`)
+
+	layout := &GenericObjectLayout{}
+	result := &struct {
+		Layout *GenericObjectLayout `json:"qLayout"`
+	}{Layout: layout}
+	assert.NoError(t, object.RPC(ctx, "GetLayout", result))
+	assert.Equal(t, "first", result.Layout.Info.Id)
+	assert.NoError(t, object.RPC(ctx, "GetLayout", result))
+	assert.Equal(t, "first", result.Layout.Info.Id)
+	assert.Equal(t, ResponseCacheStats{Hits: 1, Misses: 1, Entries: 1}, cache.Stats())
+
+	// A pushed change list for the handle invalidates the entry
+	testSocket.AddReceivedMessage(`{"jsonrpc":"2.0","change":[4]}`)
+	<-changedChannel
+	assert.NoError(t, object.RPC(ctx, "GetLayout", result))
+	assert.Equal(t, "second", result.Layout.Info.Id)
+	assert.Equal(t, ResponseCacheStats{Hits: 1, Misses: 2, Invalidations: 1, Entries: 1}, cache.Stats())
+
+	// Methods outside of the allowlist always reach the engine
+	testSocket.ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"SetProperties","handle":4,"id":3,"params":[{}]}`,
+		`{"jsonrpc":"2.0","id":3,"result":{}}`)
+	assert.NoError(t, object.RPC(ctx, "SetProperties", nil, map[string]string{}))
+	assert.Equal(t, 2, cache.Stats().Misses)
+
+	cache.Purge()
+	assert.Equal(t, 0, cache.Stats().Entries)
+
+	session.DisconnectFromServer()
+}
+
+func TestResponseCacheHitIsACopyWithMetrics(t *testing.T) {
+	ctx := context.Background()
+	cache := NewResponseCache(nil)
+	session := newSession(&Dialer{
+		CreateSocket: func(ctx context.Context, url string, header http.Header) (Socket, error) { return NewMockSocket("") },
+	})
+	session.connect(ctx, "", nil)
+	defer session.DisconnectFromServer()
+	object := session.getRemoteObject(&ObjectInterface{Handle: 4, Type: "GenericObject"})
+	invocation := &Invocation{RemoteObject: object, Method: "GetLayout"}
+	next := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		return &InvocationResponse{Result: []byte(`{"qLayout":{}}`)}
+	}
+
+	first := cache.Intercept(ctx, invocation, next)
+	first.Result[2] = 'X'
+	metricsCtx, collector := WithMetricsCollector(ctx)
+	hit := cache.Intercept(metricsCtx, invocation, next)
+	assert.Equal(t, `{"qLayout":{}}`, string(hit.Result))
+	hit.Result[2] = 'X'
+	assert.Equal(t, `{"qLayout":{}}`, string(cache.Intercept(ctx, invocation, next).Result))
+	assert.Equal(t, 2, cache.Stats().Hits)
+
+	metrics := collector.Metrics()
+	assert.False(t, metrics.InvocationRequestTimestamp.IsZero())
+	assert.Equal(t, 0, metrics.ResponseMessageSize)
+}
+
+func TestResponseCacheConcurrentMisses(t *testing.T) {
+	ctx := context.Background()
+	cache := NewResponseCache(nil)
+	session := newSession(&Dialer{
+		CreateSocket: func(ctx context.Context, url string, header http.Header) (Socket, error) { return NewMockSocket("") },
+	})
+	session.connect(ctx, "", nil)
+	defer session.DisconnectFromServer()
+	object := session.getRemoteObject(&ObjectInterface{Handle: 4, Type: "GenericObject"})
+	invocation := &Invocation{RemoteObject: object, Method: "GetLayout"}
+	result := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		return &InvocationResponse{Result: []byte(`{"qLayout":{}}`)}
+	}
+
+	// The second miss for the key completes while the first one is in flight
+	cache.Intercept(ctx, invocation, func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		cache.Intercept(ctx, invocation, result)
+		return result(ctx, invocation)
+	})
+	assert.Equal(t, ResponseCacheStats{Misses: 2, Entries: 1}, cache.Stats())
+}
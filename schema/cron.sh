@@ -32,13 +32,13 @@ fi
 # Generate enigma-go based on latest published Qlik Associative Engine image
 . ./schema/generate.sh
 
-# If there are changes to qix_generated.go then open a pull request
+# If there are changes to the generated files then open a pull request
 cd ..
-local_changes=$(git ls-files qix_generated.go -m)
+local_changes=$(git status --porcelain -- 'qix_generated*.go' enigmamock)
 
 if [ ! -z "$local_changes" ]; then
   git checkout -b $branch_name
-  git add qix_generated.go
+  git add -A -- 'qix_generated*.go' enigmamock
   git commit -m "Automated: New API based on $ENGINE_VERSION"
   git push -u origin $branch_name
   curl -u none:$GH_TOKEN https://api.github.com/repos/qlik-oss/enigma-go/pulls --request POST --data "{
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// schemaChange is a difference between two versions of a schema. Breaking changes are those that make code
+// compiled against the generated API of the old version fail to compile against the new one.
+type schemaChange struct {
+	breaking    bool
+	item        string
+	description string
+}
+
+// runDiff implements the diff command: diff [-companion <schema-companion.json>] <old spec.json> <new spec.json>. The
+// companion gives the frozen prefixes of the enum constant names.
+func runDiff(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	companionFilePath := flags.String("companion", "", "")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 2 {
+		return errors.New("usage: go run ./schema diff [-companion <file path to schema-companion.json>] <file path to old spec.json> <file path to new spec.json>")
+	}
+	if *companionFilePath != "" {
+		enumConstPrefixes = loadSchemaCompanion(*companionFilePath).EnumConstantPrefixes
+	}
+	oldSchema, err := loadSchemaFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	newSchema, err := loadSchemaFile(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Changes from Qlik Associative Engine %s to %s\n\n", oldSchema.Info.Version, newSchema.Info.Version)
+	printChanges(out, diffSchemas(oldSchema, newSchema))
+	return nil
+}
+
+// printChanges prints the changes as Markdown lists, breaking changes first
+func printChanges(out io.Writer, changes []schemaChange) {
+	for _, breaking := range []bool{true, false} {
+		if breaking {
+			fmt.Fprintln(out, "Breaking changes:")
+		} else {
+			fmt.Fprintln(out, "Non-breaking changes:")
+		}
+		count := 0
+		for _, change := range changes {
+			if change.breaking == breaking {
+				fmt.Fprintf(out, "- %s: %s\n", change.item, change.description)
+				count++
+			}
+		}
+		if count == 0 {
+			fmt.Fprintln(out, "- none")
+		}
+		if breaking {
+			fmt.Fprintln(out, "")
+		}
+	}
+}
+
+// diffSchemas compares the methods and types of two schemas, the changes are sorted by item
+func diffSchemas(oldSchema *OpenRpcFile, newSchema *OpenRpcFile) []schemaChange {
+	var changes []schemaChange
+	add := func(breaking bool, item string, format string, args ...any) {
+		changes = append(changes, schemaChange{breaking, item, fmt.Sprintf(format, args...)})
+	}
+
+	oldMethods := map[string]*OpenRpcMethod{}
+	for _, method := range oldSchema.Methods {
+		oldMethods[method.Name] = method
+	}
+	newMethods := map[string]*OpenRpcMethod{}
+	for _, method := range newSchema.Methods {
+		newMethods[method.Name] = method
+	}
+	for _, name := range sortedNames(oldMethods, newMethods) {
+		oldMethod, newMethod := oldMethods[name], newMethods[name]
+		switch {
+		case newMethod == nil:
+			add(true, name, "method removed")
+		case oldMethod == nil:
+			add(false, name, "method added")
+		default:
+			diffExtensions(add, name, oldMethod.QlikExtensions, newMethod.QlikExtensions)
+			diffParams(add, name, oldMethod.Parameters, newMethod.Parameters)
+			// Every result field is a return value of the generated method
+			diffProperties(add, name, "result field", true, resultProperties(oldMethod), resultProperties(newMethod))
+		}
+	}
+
+	oldTypes, newTypes := map[string]*Type{}, map[string]*Type{}
+	if oldSchema.Components != nil {
+		oldTypes = oldSchema.Components.Schemas
+	}
+	if newSchema.Components != nil {
+		newTypes = newSchema.Components.Schemas
+	}
+	for _, name := range sortedNames(oldTypes, newTypes) {
+		oldType, newType := oldTypes[name], newTypes[name]
+		switch {
+		case newType == nil:
+			add(true, name, "type removed")
+		case oldType == nil:
+			add(false, name, "type added")
+		default:
+			diffExtensions(add, name, oldType.QlikExtensions, newType.QlikExtensions)
+			if oldKind, newKind := describeType(oldType), describeType(newType); oldKind != newKind {
+				add(true, name, "type changed from %s to %s", oldKind, newKind)
+				continue
+			}
+			diffEnumValues(add, name, oldType.OneOf, newType.OneOf)
+			diffProperties(add, name, "field", false, propertiesByName(oldType.Properties), propertiesByName(newType.Properties))
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].item < changes[j].item })
+	return changes
+}
+
+func diffExtensions(add func(bool, string, string, ...any), item string, oldExtensions QlikExtensions, newExtensions QlikExtensions) {
+	if oldExtensions.QlikStability != newExtensions.QlikStability {
+		add(false, item, "stability changed from %s to %s", orNone(oldExtensions.QlikStability), orNone(newExtensions.QlikStability))
+	}
+	oldDeprecated := oldExtensions.QlikDeprecated1 || oldExtensions.QlikDeprecated2
+	newDeprecated := newExtensions.QlikDeprecated1 || newExtensions.QlikDeprecated2
+	if !oldDeprecated && newDeprecated {
+		add(false, item, "deprecated")
+	} else if oldDeprecated && !newDeprecated {
+		add(false, item, "no longer deprecated")
+	}
+	if oldExtensions.QlikVisibility != newExtensions.QlikVisibility {
+		add(false, item, "visibility changed from %s to %s", orNone(oldExtensions.QlikVisibility), orNone(newExtensions.QlikVisibility))
+	}
+}
+
+// diffParams compares parameters by position since that is how they are passed to the generated methods
+func diffParams(add func(bool, string, string, ...any), item string, oldParams []*Type, newParams []*Type) {
+	for i := 0; i < len(oldParams) || i < len(newParams); i++ {
+		switch {
+		case i >= len(newParams):
+			add(true, item, "parameter %s removed", oldParams[i].Name)
+		case i >= len(oldParams):
+			add(true, item, "parameter %s added", newParams[i].Name)
+		default:
+			oldParam, newParam := oldParams[i], newParams[i]
+			if oldParam.Name != newParam.Name {
+				add(false, item, "parameter %s renamed to %s", oldParam.Name, newParam.Name)
+			}
+			if oldType, newType := describeType(paramType(oldParam)), describeType(paramType(newParam)); oldType != newType {
+				add(true, item, "parameter %s changed from %s to %s", newParam.Name, oldType, newType)
+			}
+			diffExtensions(add, item+" parameter "+newParam.Name, oldParam.QlikExtensions, newParam.QlikExtensions)
+		}
+	}
+}
+
+// diffProperties compares struct fields or result fields by name. Added fields only break the API when they are
+// return values.
+func diffProperties(add func(bool, string, string, ...any), item string, kind string, addedIsBreaking bool, oldProperties map[string]*Type, newProperties map[string]*Type) {
+	for _, name := range sortedNames(oldProperties, newProperties) {
+		oldProperty, newProperty := oldProperties[name], newProperties[name]
+		switch {
+		case newProperty == nil:
+			add(true, item, "%s %s removed", kind, name)
+		case oldProperty == nil:
+			add(addedIsBreaking, item, "%s %s added", kind, name)
+		default:
+			if oldType, newType := describeType(oldProperty), describeType(newProperty); oldType != newType {
+				add(true, item, "%s %s changed from %s to %s", kind, name, oldType, newType)
+			}
+			diffExtensions(add, item+" "+kind+" "+name, oldProperty.QlikExtensions, newProperty.QlikExtensions)
+		}
+	}
+}
+
+// diffEnumValues compares the options of enums by title, since the titles are sent to the engine, and the names of
+// the generated constants, which change with the descriptions and the prefix they share
+func diffEnumValues(add func(bool, string, string, ...any), item string, oldOptions []*Option, newOptions []*Option) {
+	oldValues, oldNames := enumOptionsByTitle(item, oldOptions)
+	newValues, newNames := enumOptionsByTitle(item, newOptions)
+	for _, title := range sortedNames(oldValues, newValues) {
+		oldValue, newValue := oldValues[title], newValues[title]
+		switch {
+		case newValue == nil:
+			add(true, item, "enum value %s removed", title)
+		case oldValue == nil:
+			add(false, item, "enum value %s added", title)
+		default:
+			if oldNames[title] != newNames[title] {
+				add(true, item, "enum constant %s of value %s renamed to %s", oldNames[title], title, newNames[title])
+			}
+			if oldValue.ConstValue != newValue.ConstValue {
+				add(false, item, "enum value %s changed from %d to %d", title, oldValue.ConstValue, newValue.ConstValue)
+			}
+		}
+	}
+}
+
+// enumOptionsByTitle returns the options of an enum and the names of their generated constants keyed by title
+func enumOptionsByTitle(typeName string, options []*Option) (map[string]*Option, map[string]string) {
+	values := map[string]*Option{}
+	names := map[string]string{}
+	for i, name := range enumConstNames(typeName, options) {
+		values[options[i].Title] = options[i]
+		names[options[i].Title] = name
+	}
+	return values, names
+}
+
+// describeType describes a type of the schema, two types with the same description generate the same Go type
+func describeType(t *Type) string {
+	switch {
+	case t == nil:
+		return "nothing"
+	case t.Ref != "":
+		return strings.Replace(t.Ref, "#/components/schemas/", "", 1)
+	case len(t.OneOf) > 0:
+		return "enum"
+	case t.Type == "array":
+		return "array of " + describeType(t.Items)
+	case t.Format != "":
+		return t.Type + " (" + t.Format + ")"
+	default:
+		return orNone(t.Type)
+	}
+}
+
+func paramType(param *Type) *Type {
+	if param.Schema != nil {
+		return param.Schema
+	}
+	return param
+}
+
+func resultProperties(method *OpenRpcMethod) map[string]*Type {
+	if method.Responses == nil || method.Responses.Schema == nil {
+		return nil
+	}
+	return propertiesByName(method.Responses.Schema.Properties)
+}
+
+func propertiesByName(properties map[OrderAwareKey]*Type) map[string]*Type {
+	result := map[string]*Type{}
+	for key, property := range properties {
+		result[key.Key] = property
+	}
+	return result
+}
+
+// sortedNames returns the keys found in either map in alphabetical order
+func sortedNames[T any](a map[string]T, b map[string]T) []string {
+	names := make([]string, 0, len(a)+len(b))
+	for name := range a {
+		names = append(names, name)
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
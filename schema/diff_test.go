@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDiffSchemas(t *testing.T) {
+	ref := func(name string) *Type { return &Type{Ref: "#/components/schemas/" + name} }
+	result := func(properties map[OrderAwareKey]*Type) *OpenRpcResult {
+		return &OpenRpcResult{Schema: &Type{Type: "object", Properties: properties}}
+	}
+	oldSchema := &OpenRpcFile{
+		Methods: []*OpenRpcMethod{
+			{Name: "Doc.GetObject", Parameters: []*Type{{Name: "qId", Schema: &Type{Type: "string"}}}, Responses: result(map[OrderAwareKey]*Type{{Key: "qReturn"}: ref("ObjectInterface")})},
+			{Name: "Doc.GetField", Parameters: []*Type{{Name: "qFieldName", Schema: &Type{Type: "string"}}}},
+			{Name: "Doc.Removed"},
+		},
+		Components: &OpenRpcComponents{Schemas: map[string]*Type{
+			"Cell":  {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qText"}: {Type: "string"}, {Key: "qNum"}: {Type: "number", Format: "double"}}},
+			"Sort":  {Type: "string", OneOf: []*Option{{Title: "A", ConstValue: 0}, {Title: "D", ConstValue: 1}}},
+			"Names": {Type: "array", Items: &Type{Type: "string"}},
+		}},
+	}
+	newSchema := &OpenRpcFile{
+		Methods: []*OpenRpcMethod{
+			{Name: "Doc.GetObject", QlikExtensions: QlikExtensions{QlikDeprecated1: true}, Parameters: []*Type{{Name: "qObjectId", Schema: &Type{Type: "string"}}}, Responses: result(map[OrderAwareKey]*Type{{Key: "qReturn"}: ref("ObjectInterface"), {Key: "qInfo"}: ref("NxInfo")})},
+			{Name: "Doc.GetField", Parameters: []*Type{{Name: "qFieldName", Schema: &Type{Type: "string"}}, {Name: "qStateName", Schema: &Type{Type: "string"}}}},
+			{Name: "Doc.Added", QlikExtensions: QlikExtensions{QlikStability: "experimental"}},
+		},
+		Components: &OpenRpcComponents{Schemas: map[string]*Type{
+			"Cell":  {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qText"}: {Type: "string"}, {Key: "qNum"}: {Type: "integer", Format: "int32"}, {Key: "qState"}: ref("State")}},
+			"Sort":  {Type: "string", OneOf: []*Option{{Title: "A", ConstValue: 0}, {Title: "N", ConstValue: 1}}},
+			"Names": {Type: "array", Items: ref("Name")},
+		}},
+	}
+	out := &bytes.Buffer{}
+	printChanges(out, diffSchemas(oldSchema, newSchema))
+	expected := `Breaking changes:
+- Cell: field qNum changed from number (double) to integer (int32)
+- Doc.GetField: parameter qStateName added
+- Doc.GetObject: result field qInfo added
+- Doc.Removed: method removed
+- Names: type changed from array of string to array of Name
+- Sort: enum value D removed
+
+Non-breaking changes:
+- Cell: field qState added
+- Doc.Added: method added
+- Doc.GetObject: deprecated
+- Doc.GetObject: parameter qId renamed to qObjectId
+- Sort: enum value N added
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+
+	out.Reset()
+	printChanges(out, diffSchemas(newSchema, newSchema))
+	if expected := "Breaking changes:\n- none\n\nNon-breaking changes:\n- none\n"; out.String() != expected {
+		t.Errorf("expected no changes, got\n%s", out.String())
+	}
+}
+
+func TestDiffEnumConstantNames(t *testing.T) {
+	schema := func(options ...*Option) *OpenRpcFile {
+		return &OpenRpcFile{Components: &OpenRpcComponents{Schemas: map[string]*Type{"NxSortIndicatorType": {Type: "string", OneOf: options}}}}
+	}
+	oldSchema := schema(&Option{Title: "N", Description: "NX_SORT_INDICATE_NONE"}, &Option{Title: "A", Description: "NX_SORT_INDICATE_ASC", ConstValue: 1})
+	newSchema := schema(&Option{Title: "N", Description: "NX_SORT_INDICATE_NONE"}, &Option{Title: "A", Description: "NX_SORT_INDICATE_ASC", ConstValue: 1}, &Option{Title: "C", Description: "SORT_BY_CUSTOM", ConstValue: 2})
+	out := &bytes.Buffer{}
+	printChanges(out, diffSchemas(oldSchema, newSchema))
+	expected := `Breaking changes:
+- NxSortIndicatorType: enum constant NxSortIndicatorTypeAsc of value A renamed to NxSortIndicatorTypeNxSortIndicateAsc
+- NxSortIndicatorType: enum constant NxSortIndicatorTypeNone of value N renamed to NxSortIndicatorTypeNxSortIndicateNone
+
+Non-breaking changes:
+- NxSortIndicatorType: enum value C added
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+
+	// A frozen prefix keeps the names
+	defer func(saved map[string]string) { enumConstPrefixes = saved }(enumConstPrefixes)
+	enumConstPrefixes = map[string]string{"NxSortIndicatorType": "NX_SORT_INDICATE_"}
+	out.Reset()
+	printChanges(out, diffSchemas(oldSchema, newSchema))
+	expected = `Breaking changes:
+- none
+
+Non-breaking changes:
+- NxSortIndicatorType: enum value C added
+`
+	if out.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, out.String())
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// hasExtraProperties tells if a struct type keeps the properties not known to the schema. That is the case for types
+// allowing additional properties and for the properties and layouts of generic objects, where clients such as
+// visualization extensions store properties of their own.
+func hasExtraProperties(defName string, def *Type) bool {
+	if def.Type != "object" {
+		return false
+	}
+	if def.AdditionalProperties != nil {
+		return true
+	}
+	return strings.HasPrefix(defName, "Generic") && (strings.HasSuffix(defName, "Properties") || strings.HasSuffix(defName, "Layout"))
+}
+
+func printExtraField(out io.Writer) {
+	fmt.Fprintln(out, "\t// Extra holds the properties not known to the schema, for instance custom properties of visualization extensions.")
+	fmt.Fprintln(out, "\t// They are kept when unmarshalling and marshalled again together with the other fields.")
+	fmt.Fprintln(out, "\tExtra map[string]json.RawMessage `json:\"-\"`")
+}
+
+func printExtraHelpers(out io.Writer) {
+	fmt.Fprintln(out, "// marshalWithExtra marshals a struct and adds the extra properties not set by its fields")
+	fmt.Fprintln(out, "func marshalWithExtra(value interface{}, extra map[string]json.RawMessage) ([]byte, error) {")
+	fmt.Fprintln(out, "\tdata, err := json.Marshal(value)")
+	fmt.Fprintln(out, "\tif err != nil || len(extra) == 0 {")
+	fmt.Fprintln(out, "\t\treturn data, err")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tproperties := map[string]json.RawMessage{}")
+	fmt.Fprintln(out, "\tif err := json.Unmarshal(data, &properties); err != nil {")
+	fmt.Fprintln(out, "\t\treturn nil, err")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tfor name, value := range extra {")
+	fmt.Fprintln(out, "\t\tif _, ok := properties[name]; !ok {")
+	fmt.Fprintln(out, "\t\t\tproperties[name] = value")
+	fmt.Fprintln(out, "\t\t}")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn json.Marshal(properties)")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "// hasUnknownProperties scans the keys of a JSON object without decoding its values and tells if any of them is not in")
+	fmt.Fprintln(out, "// known. Escaped keys are reported as unknown.")
+	fmt.Fprintln(out, "func hasUnknownProperties(data []byte, known map[string]bool) bool {")
+	fmt.Fprintln(out, "\tdepth := 0")
+	fmt.Fprintln(out, "\texpectKey := false")
+	fmt.Fprintln(out, "\tfor i := 0; i < len(data); i++ {")
+	fmt.Fprintln(out, "\t\tswitch c := data[i]; c {")
+	fmt.Fprintln(out, "\t\tcase '{', '[':")
+	fmt.Fprintln(out, "\t\t\tdepth++")
+	fmt.Fprintln(out, "\t\t\texpectKey = c == '{' && depth == 1")
+	fmt.Fprintln(out, "\t\tcase '}', ']':")
+	fmt.Fprintln(out, "\t\t\tdepth--")
+	fmt.Fprintln(out, "\t\tcase ',':")
+	fmt.Fprintln(out, "\t\t\texpectKey = depth == 1")
+	fmt.Fprintln(out, "\t\tcase '\"':")
+	fmt.Fprintln(out, "\t\t\tstart := i + 1")
+	fmt.Fprintln(out, "\t\t\tescaped := false")
+	fmt.Fprintln(out, "\t\t\tfor i++; i < len(data) && data[i] != '\"'; i++ {")
+	fmt.Fprintln(out, "\t\t\t\tif data[i] == '\\\\' {")
+	fmt.Fprintln(out, "\t\t\t\t\tescaped = true")
+	fmt.Fprintln(out, "\t\t\t\t\ti++")
+	fmt.Fprintln(out, "\t\t\t\t}")
+	fmt.Fprintln(out, "\t\t\t}")
+	fmt.Fprintln(out, "\t\t\tif expectKey {")
+	fmt.Fprintln(out, "\t\t\t\tif escaped || i > len(data) || !known[string(data[start:i])] {")
+	fmt.Fprintln(out, "\t\t\t\t\treturn true")
+	fmt.Fprintln(out, "\t\t\t\t}")
+	fmt.Fprintln(out, "\t\t\t\texpectKey = false")
+	fmt.Fprintln(out, "\t\t\t}")
+	fmt.Fprintln(out, "\t\t}")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn false")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "// unmarshalExtra returns the properties of a JSON object that are not in known, nil if there are none. The object is")
+	fmt.Fprintln(out, "// only decoded a second time when it has unknown properties.")
+	fmt.Fprintln(out, "func unmarshalExtra(data []byte, known map[string]bool) (map[string]json.RawMessage, error) {")
+	fmt.Fprintln(out, "\tif !hasUnknownProperties(data, known) {")
+	fmt.Fprintln(out, "\t\treturn nil, nil")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tproperties := map[string]json.RawMessage{}")
+	fmt.Fprintln(out, "\tif err := json.Unmarshal(data, &properties); err != nil {")
+	fmt.Fprintln(out, "\t\treturn nil, err")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tvar extra map[string]json.RawMessage")
+	fmt.Fprintln(out, "\tfor name, value := range properties {")
+	fmt.Fprintln(out, "\t\tif known[name] {")
+	fmt.Fprintln(out, "\t\t\tcontinue")
+	fmt.Fprintln(out, "\t\t}")
+	fmt.Fprintln(out, "\t\tif extra == nil {")
+	fmt.Fprintln(out, "\t\t\textra = map[string]json.RawMessage{}")
+	fmt.Fprintln(out, "\t\t}")
+	fmt.Fprintln(out, "\t\textra[name] = value")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn extra, nil")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+// printExtraMarshalling prints the JSON marshalling of a type with an Extra field, propertyNames are the names in
+// the schema of the generated fields
+func printExtraMarshalling(out io.Writer, defName string, propertyNames []string) {
+	knownName := "propertiesOf" + defName
+	fmt.Fprintf(out, "var %s = map[string]bool{\n", knownName)
+	for _, name := range propertyNames {
+		fmt.Fprintf(out, "\t\"q%s\": true,\n", strings.TrimPrefix(name, "q"))
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "// MarshalJSON marshals the fields and the Extra properties")
+	fmt.Fprintf(out, "func (v %s) MarshalJSON() ([]byte, error) {\n", defName)
+	fmt.Fprintf(out, "\ttype plain %s\n", defName)
+	fmt.Fprintln(out, "\treturn marshalWithExtra((*plain)(&v), v.Extra)")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "// UnmarshalJSON unmarshals the fields and keeps the other properties in Extra")
+	fmt.Fprintf(out, "func (v *%s) UnmarshalJSON(data []byte) error {\n", defName)
+	fmt.Fprintf(out, "\ttype plain %s\n", defName)
+	fmt.Fprintln(out, "\tif err := json.Unmarshal(data, (*plain)(v)); err != nil {")
+	fmt.Fprintln(out, "\t\treturn err")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tvar err error")
+	fmt.Fprintf(out, "\tv.Extra, err = unmarshalExtra(data, %s)\n", knownName)
+	fmt.Fprintln(out, "\treturn err")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
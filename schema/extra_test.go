@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestExtraProperties(t *testing.T) {
+	for name, expected := range map[string]bool{"GenericObjectLayout": true, "GenericDimensionProperties": true, "NxInfo": false, "GenericObject": false} {
+		if actual := hasExtraProperties(name, &Type{Type: "object"}); actual != expected {
+			t.Errorf("expected %v for %s", expected, name)
+		}
+	}
+	if !hasExtraProperties("Layout", &Type{Type: "object", AdditionalProperties: &AdditionalProperties{}}) {
+		t.Error("types with additional properties should keep extra properties")
+	}
+
+	out := &bytes.Buffer{}
+	printExtraHelpers(out)
+	if !strings.Contains(out.String(), "if !hasUnknownProperties(data, known) {") {
+		t.Errorf("expected unmarshalExtra to skip objects without unknown properties in\n%s", out.String())
+	}
+
+	out = &bytes.Buffer{}
+	printExtraMarshalling(out, "Layout", []string{"qInfo", "qHyperCube"})
+	for _, expected := range []string{"\t\"qHyperCube\": true,", "v.Extra, err = unmarshalExtra(data, propertiesOfLayout)"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %s in\n%s", expected, out.String())
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Categories of schema items that can be left out of the generated code or be placed behind build tags
+const (
+	categoryExperimental = "experimental"
+	categoryStable       = "stable"
+	categoryDeprecated   = "deprecated"
+	categoryPrivate      = "private"
+	// categoryRaw is not found in the schema, it holds the Raw variants of the methods
+	categoryRaw = "raw"
+)
+
+var allCategories = []string{categoryExperimental, categoryStable, categoryDeprecated, categoryPrivate, categoryRaw}
+
+// buildTagPrefix is prepended to a category to form the build tag that leaves out the items in that category,
+// for instance enigma_no_experimental
+const buildTagPrefix = "enigma_no_"
+
+// filterOptions tells which categories of items to leave out and which to place behind build tags
+type filterOptions struct {
+	exclude map[string]bool
+	tagged  map[string]bool
+}
+
+// placement tells where a schema item ends up, either left out or in the file with the build constraint leaving
+// out the given categories. Items in the main file have no categories.
+type placement struct {
+	excluded   bool
+	categories []string
+}
+
+func parseCategories(list string) (map[string]bool, error) {
+	result := map[string]bool{}
+	for _, category := range strings.Split(list, ",") {
+		if category == "" {
+			continue
+		}
+		known := false
+		for _, c := range allCategories {
+			known = known || c == category
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown category %s, use one of %s", category, strings.Join(allCategories, ","))
+		}
+		result[category] = true
+	}
+	return result, nil
+}
+
+func itemCategories(extensions QlikExtensions) []string {
+	var result []string
+	switch extensions.QlikStability {
+	case categoryExperimental, categoryStable:
+		result = append(result, extensions.QlikStability)
+	}
+	if extensions.QlikDeprecated1 || extensions.QlikDeprecated2 {
+		result = append(result, categoryDeprecated)
+	}
+	if extensions.QlikVisibility == categoryPrivate {
+		result = append(result, categoryPrivate)
+	}
+	return result
+}
+
+func (o filterOptions) isExcluded(extensions QlikExtensions) bool {
+	for _, category := range itemCategories(extensions) {
+		if o.exclude[category] {
+			return true
+		}
+	}
+	return false
+}
+
+func (o filterOptions) place(extensions QlikExtensions) placement {
+	if o.isExcluded(extensions) {
+		return placement{excluded: true}
+	}
+	var categories []string
+	for _, category := range itemCategories(extensions) {
+		if o.tagged[category] {
+			categories = append(categories, category)
+		}
+	}
+	sort.Strings(categories)
+	return placement{categories: categories}
+}
+
+// placeRaw places the Raw variant of a method with the placement p
+func (o filterOptions) placeRaw(p placement) placement {
+	if p.excluded || o.exclude[categoryRaw] {
+		return placement{excluded: true}
+	}
+	if !o.tagged[categoryRaw] {
+		return p
+	}
+	categories := append(append([]string{}, p.categories...), categoryRaw)
+	sort.Strings(categories)
+	return placement{categories: categories}
+}
+
+func (p placement) key() string {
+	return strings.Join(p.categories, "_")
+}
+
+// constraint returns the build constraint of the file or, if disabled is set, the negated constraint
+func (p placement) constraint(disabled bool) string {
+	tags := make([]string, len(p.categories))
+	for i, category := range p.categories {
+		tags[i] = buildTagPrefix + category
+	}
+	if disabled {
+		return strings.Join(tags, " || ")
+	}
+	return "!" + strings.Join(tags, " && !")
+}
+
+// intersect keeps the categories found in both placements, an excluded placement is replaced by the other one
+func (p placement) intersect(other placement) placement {
+	if p.excluded {
+		return other
+	}
+	var categories []string
+	for _, category := range p.categories {
+		for _, c := range other.categories {
+			if c == category {
+				categories = append(categories, category)
+			}
+		}
+	}
+	return placement{categories: categories}
+}
+
+func collectRefs(t *Type, refs map[string]bool) {
+	if t == nil {
+		return
+	}
+	if t.Ref != "" {
+		refs[strings.Replace(t.Ref, "#/components/schemas/", "", 1)] = true
+	}
+	collectRefs(t.Items, refs)
+	collectRefs(t.Schema, refs)
+	for _, property := range t.Properties {
+		collectRefs(property, refs)
+	}
+	if t.AdditionalProperties != nil {
+		for _, property := range t.AdditionalProperties.AnyOf {
+			collectRefs(property, refs)
+		}
+	}
+}
+
+// placeTypes places the types of the schema. Types used by an item are moved so that they are available whenever
+// the item is, even if that means keeping a type in a category that should be left out.
+func placeTypes(schema *OpenRpcFile, options filterOptions) map[string]placement {
+	result := map[string]placement{}
+	for name, def := range schema.Components.Schemas {
+		result[name] = options.place(def.QlikExtensions)
+	}
+	typeRefs := map[string]map[string]bool{}
+	for name, def := range schema.Components.Schemas {
+		refs := map[string]bool{}
+		for _, property := range def.Properties {
+			if !options.isExcluded(property.QlikExtensions) {
+				collectRefs(property, refs)
+			}
+		}
+		if def.AdditionalProperties != nil {
+			for _, property := range def.AdditionalProperties.AnyOf {
+				collectRefs(property.Schema, refs)
+			}
+		}
+		collectRefs(def.Items, refs)
+		typeRefs[name] = refs
+	}
+	use := func(user string, p placement, refs map[string]bool) bool {
+		changed := false
+		for name := range refs {
+			current, ok := result[name]
+			if !ok {
+				continue
+			}
+			placed := current.intersect(p)
+			if current.excluded {
+				warn("keeping excluded type " + name + " used by " + user)
+			}
+			if current.excluded || placed.key() != current.key() {
+				result[name] = placed
+				changed = true
+			}
+		}
+		return changed
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, method := range schema.Methods {
+			p := options.place(method.QlikExtensions)
+			if p.excluded {
+				continue
+			}
+			refs := map[string]bool{}
+			for _, param := range method.Parameters {
+				collectRefs(param, refs)
+			}
+			collectRefs(method.Responses.Schema, refs)
+			changed = use(method.Name, p, refs) || changed
+		}
+		for name, refs := range typeRefs {
+			if p := result[name]; !p.excluded {
+				changed = use(name, p, refs) || changed
+			}
+		}
+	}
+	return result
+}
+
+// generatedFiles collects generated code per file. The main file has the empty suffix, the others the suffix
+// of their placement and a build constraint, or the name of their object type if splitObjects is set.
+type generatedFiles struct {
+	bodies       map[string]*bytes.Buffer
+	constraints  map[string]string
+	splitObjects bool
+}
+
+func newGeneratedFiles(splitObjects bool) *generatedFiles {
+	return &generatedFiles{bodies: map[string]*bytes.Buffer{"": {}}, constraints: map[string]string{"": ""}, splitObjects: splitObjects}
+}
+
+func (g *generatedFiles) body(suffix string, constraint string) io.Writer {
+	if g.bodies[suffix] == nil {
+		g.bodies[suffix] = &bytes.Buffer{}
+		g.constraints[suffix] = constraint
+	}
+	return g.bodies[suffix]
+}
+
+// out returns the writer for items with the placement or, if disabled is set, for the declarations replacing
+// them when their build tags are set
+func (g *generatedFiles) out(p placement, disabled bool) io.Writer {
+	suffix := ""
+	if len(p.categories) > 0 {
+		suffix = "_" + p.key()
+		if disabled {
+			suffix += "_disabled"
+		}
+	}
+	return g.body(suffix, p.constraint(disabled))
+}
+
+// objectOut returns the writer for the items of a remote object type with the placement. Items without build
+// constraint get a file per object type, for instance qix_generated_generic_object.go, if splitObjects is set.
+func (g *generatedFiles) objectOut(objectType string, p placement) io.Writer {
+	if !g.splitObjects || len(p.categories) > 0 {
+		return g.out(p, false)
+	}
+	return g.body("_"+toSnakeCase(objectType), "")
+}
+
+// toSnakeCase turns a type name like GenericObject into generic_object
+func toSnakeCase(name string) string {
+	var result strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) && !unicode.IsUpper(rune(name[i-1])) {
+			result.WriteByte('_')
+		}
+		result.WriteRune(unicode.ToLower(r))
+	}
+	return result.String()
+}
+
+// write creates one file per suffix with content next to filePath. The header is called with the build constraint, empty for
+// the main file, and the body so that only the needed imports are printed.
+func (g *generatedFiles) write(filePath string, header func(out io.Writer, constraint string, body string)) error {
+	if err := removeStaleFiles(filePath, g.bodies); err != nil {
+		return err
+	}
+	for suffix, body := range g.bodies {
+		if body.Len() == 0 {
+			continue
+		}
+		fileName := strings.TrimSuffix(filePath, ".go") + suffix + ".go"
+		content := &bytes.Buffer{}
+		header(content, g.constraints[suffix], body.String())
+		body.WriteTo(content)
+		// Unformatted code is written as is to make the error easier to find
+		formatted, formatErr := format.Source(content.Bytes())
+		if formatErr != nil {
+			formatted = content.Bytes()
+		}
+		if err := os.WriteFile(fileName, formatted, 0644); err != nil {
+			return err
+		}
+		if formatErr != nil {
+			return fmt.Errorf("formatting %s: %w", fileName, formatErr)
+		}
+	}
+	return nil
+}
+
+// removeStaleFiles removes files generated next to filePath, or filePath itself, by an earlier run that are no longer
+// generated, for instance when the schema no longer has experimental items
+func removeStaleFiles(filePath string, bodies map[string]*bytes.Buffer) error {
+	base := strings.TrimSuffix(filePath, ".go")
+	candidates, err := filepath.Glob(base + "_*.go")
+	if err != nil {
+		return err
+	}
+	candidates = append(candidates, filePath)
+	for _, candidate := range candidates {
+		if body := bodies[strings.TrimSuffix(strings.TrimPrefix(candidate, base), ".go")]; body != nil && body.Len() > 0 {
+			continue
+		}
+		content, err := os.ReadFile(candidate)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(content, []byte("// Code generated by QIX generator")) {
+			if err := os.Remove(candidate); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// usesPackage tells if the code refers to an exported identifier of the package
+func usesPackage(body string, packageName string) bool {
+	return regexp.MustCompile(`\b` + packageName + `\.[A-Z]`).MatchString(body)
+}
+
+// goImport is an import of a generated file, name is the package name used in the code
+type goImport struct {
+	name string
+	path string
+}
+
+// printImports prints the imports used by the body
+func printImports(out io.Writer, body string, imports ...goImport) {
+	var used []goImport
+	for _, imp := range imports {
+		if usesPackage(body, imp.name) {
+			used = append(used, imp)
+		}
+	}
+	if len(used) == 0 {
+		fmt.Fprintln(out, "")
+		return
+	}
+	fmt.Fprintln(out, "import (")
+	for i, imp := range used {
+		// Separate the standard library from other imports like goimports does
+		if i > 0 && !strings.Contains(used[i-1].path, ".") && strings.Contains(imp.path, ".") {
+			fmt.Fprintln(out, "")
+		}
+		fmt.Fprintf(out, "\t\"%s\"\n", imp.path)
+	}
+	fmt.Fprint(out, ")\n\n")
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPlacement(t *testing.T) {
+	options := filterOptions{exclude: map[string]bool{"private": true}, tagged: map[string]bool{"experimental": true, "deprecated": true}}
+	p := options.place(QlikExtensions{QlikStability: "experimental", QlikDeprecated2: true})
+	if p.excluded || p.key() != "deprecated_experimental" {
+		t.Errorf("unexpected placement %v", p)
+	}
+	if c := p.constraint(false); c != "!enigma_no_deprecated && !enigma_no_experimental" {
+		t.Errorf("unexpected constraint %s", c)
+	}
+	if c := p.constraint(true); c != "enigma_no_deprecated || enigma_no_experimental" {
+		t.Errorf("unexpected disabled constraint %s", c)
+	}
+	if p := options.place(QlikExtensions{QlikStability: "locked"}); p.excluded || p.key() != "" {
+		t.Errorf("locked items belong in the main file, got %v", p)
+	}
+	if p := options.place(QlikExtensions{QlikVisibility: "private", QlikStability: "experimental"}); !p.excluded {
+		t.Errorf("private items should be excluded, got %v", p)
+	}
+	if p := options.placeRaw(options.place(QlikExtensions{QlikStability: "experimental"})); p.key() != "experimental" {
+		t.Errorf("raw methods belong with their typed method unless raw is tagged, got %v", p)
+	}
+	options.tagged["raw"] = true
+	if p := options.placeRaw(options.place(QlikExtensions{QlikStability: "experimental"})); p.key() != "experimental_raw" {
+		t.Errorf("unexpected raw placement %v", p)
+	}
+	options.exclude["raw"] = true
+	if p := options.placeRaw(placement{}); !p.excluded {
+		t.Errorf("raw methods should be excluded, got %v", p)
+	}
+	if _, err := parseCategories("experimental,unknown"); err == nil {
+		t.Error("expected error for unknown category")
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{"Doc": "doc", "GenericObject": "generic_object", "NxURL": "nx_url"} {
+		if actual := toSnakeCase(name); actual != expected {
+			t.Errorf("expected %s for %s, got %s", expected, name, actual)
+		}
+	}
+}
+
+func TestPlaceTypes(t *testing.T) {
+	ref := func(name string) *Type { return &Type{Ref: "#/components/schemas/" + name} }
+	schema := &OpenRpcFile{
+		Components: &OpenRpcComponents{Schemas: map[string]*Type{
+			// Used by a locked type, has to stay in the main file
+			"Shared": {Type: "object", QlikExtensions: QlikExtensions{QlikStability: "experimental"}},
+			"Locked": {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qShared"}: ref("Shared")}},
+			// Only used by an experimental method
+			"Result": {Type: "object", QlikExtensions: QlikExtensions{QlikStability: "experimental"}},
+			// Excluded, and used by an excluded property only
+			"Old":    {Type: "object", QlikExtensions: QlikExtensions{QlikDeprecated1: true}},
+			"Holder": {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qOld"}: {Ref: "#/components/schemas/Old", QlikExtensions: QlikExtensions{QlikDeprecated1: true}}}},
+		}},
+		Methods: []*OpenRpcMethod{{
+			Name:           "Doc.Experiment",
+			QlikExtensions: QlikExtensions{QlikStability: "experimental"},
+			Responses:      &OpenRpcResult{Schema: &Type{Properties: map[OrderAwareKey]*Type{{Key: "qResult"}: ref("Result")}}},
+		}},
+	}
+	placements := placeTypes(schema, filterOptions{exclude: map[string]bool{"deprecated": true}, tagged: map[string]bool{"experimental": true}})
+	actual := fmt.Sprint(placements["Shared"], placements["Locked"], placements["Result"], placements["Old"], placements["Holder"])
+	expected := fmt.Sprint(placement{}, placement{}, placement{categories: []string{"experimental"}}, placement{excluded: true}, placement{})
+	if actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
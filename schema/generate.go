@@ -2,8 +2,11 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
@@ -107,6 +110,12 @@ var keyOrderCounter uint64
 
 var typesMap map[string]string
 
+// Holds the names of the schemas that are enums, i.e. strings with a list of valid options
+var enumsMap map[string]bool
+
+// Holds the prefixes left out of the names of the enum constants, frozen per type in the schema companion
+var enumConstPrefixes map[string]string
+
 // Holds the enigma package to be included before some types like Float64 and RemoteObject if an external spec is used
 var enigmaStandardTypesPrefix string
 
@@ -181,6 +190,9 @@ func refToName(refName string) string {
 		return "json.RawMessage"
 	}
 	name := strings.Replace(refName, "#/components/schemas/", "", 1)
+	if enumsMap[name] {
+		return name
+	}
 	if typesMap[name] == "string" {
 		return "string"
 	}
@@ -215,6 +227,9 @@ func getTypeName(t *Type) string {
 		}
 		return "[]int"
 	case "string":
+		if t.Ref != "" {
+			return refToName(t.Ref)
+		}
 		return "string"
 	case "boolean":
 		return "bool"
@@ -271,6 +286,16 @@ func createTypesMap(schema *OpenRpcFile) map[string]string {
 	return result
 }
 
+func createEnumsMap(schema *OpenRpcFile) map[string]bool {
+	result := map[string]bool{}
+	for id, t := range schema.Components.Schemas {
+		if t.Type == "string" && len(t.OneOf) > 0 {
+			result[id] = true
+		}
+	}
+	return result
+}
+
 func loadSchemaFile(schemaFilePath string) (*OpenRpcFile, error) {
 	file, err := os.Open(schemaFilePath)
 	if err != nil {
@@ -287,26 +312,33 @@ func loadSchemaFile(schemaFilePath string) (*OpenRpcFile, error) {
 		return nil, err
 	}
 	typesMap = createTypesMap(&schema)
+	enumsMap = createEnumsMap(&schema)
 	return &schema, nil
 }
 
-// Read the file that describes what remote object type is created by each method
-func createObjectFunctionToObjectTypeMapping(schemaCompanionFilePath string) map[string]string {
-	var result struct {
-		RemoteObjectReturnTypes map[string]string `json:"remote-object-return-types"`
-	}
+// schemaCompanion holds the information missing from the schema
+type schemaCompanion struct {
+	// RemoteObjectReturnTypes describes what remote object type is created by each method
+	RemoteObjectReturnTypes map[string]string `json:"remote-object-return-types"`
+	// EnumConstantPrefixes holds the prefix of the descriptions left out of the constant names of each enum
+	EnumConstantPrefixes map[string]string `json:"enum-constant-prefixes"`
+}
+
+// Read the file that holds the information missing from the schema
+func loadSchemaCompanion(schemaCompanionFilePath string) *schemaCompanion {
+	result := &schemaCompanion{}
 	file, err := os.ReadFile(schemaCompanionFilePath)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
 
-	err = json.Unmarshal(file, &result)
+	err = json.Unmarshal(file, result)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	return result.RemoteObjectReturnTypes
+	return result
 }
 
 func patchMissingTypeInfo(param *Type, name, methodName string) {
@@ -423,6 +455,9 @@ func toParamName(name string) string {
 }
 
 func nilNameInEarlyReturnAfterError(typeName string) string {
+	if enumsMap[typeName] {
+		return "\"\""
+	}
 	switch typeName {
 	case "string":
 		return "\"\""
@@ -487,51 +522,99 @@ func wrapWithEnigmaStandardTypesPrefix(str string) string {
 	return str
 }
 
-// Generate an ordinary fully typed method
-func printMethod(method *OpenRpcMethod, out *os.File, serviceName string, methodName string, objectFuncToObject map[string]string) {
+type methodParam struct {
+	name     string
+	typeName string
+}
+
+// methodSignature describes a generated method, the leading context parameter and trailing error result excluded
+type methodSignature struct {
+	name    string
+	params  []methodParam
+	results []string
+}
+
+var warned = map[string]bool{}
+
+// warn prints a message once
+func warn(message string) {
+	if !warned[message] {
+		warned[message] = true
+		fmt.Println(message)
+	}
+}
+
+func identity(typeName string) string {
+	return typeName
+}
+
+// getMethodSignature returns the signature of the typed method or, if raw is set, of the raw method
+func getMethodSignature(method *OpenRpcMethod, serviceName string, methodName string, objectFuncToObject map[string]string, raw bool) methodSignature {
 	responseMap := getPropertiesWithoutFilteredNxInfo(method.Responses.Schema.Properties, methodName)
-	sortedResponseKeys := getOriginalOrderSortedKeys(responseMap)
 	actualResponseMap := getPropertiesWithoutRedundantResponses(responseMap, methodName)
 	sortedActualResponseKeys := getOriginalOrderSortedKeys(actualResponseMap)
-	// Generate Description
-	if method.Description != "" {
-		fmt.Fprintln(out, formatComment("", method.Description, method.Parameters))
-	}
-	printExtensionTags(out, "", method.QlikExtensions)
-	fmt.Fprint(out, "func (obj *", serviceName, ") ", methodName, "(ctx context.Context")
 
-	// Generate Parameters
-	for _, param := range method.Parameters {
-		fmt.Fprint(out, ", ", "", toParamName(param.Name), " ", getTypeName(param))
+	signature := methodSignature{name: methodName}
+	if raw {
+		signature.name += "Raw"
 	}
-	fmt.Fprint(out, ") ")
-
-	// Generate Return Types
-	if len(actualResponseMap) > 0 {
-		fmt.Fprint(out, "(")
+	for _, param := range method.Parameters {
+		typeName := getTypeName(param)
+		if raw {
+			typeName = getRawInputTypeName(param)
+		}
+		signature.params = append(signature.params, methodParam{toParamName(param.Name), typeName})
 	}
-
 	for _, responseKey := range sortedActualResponseKeys {
 		responseType := actualResponseMap[responseKey]
 		typeName := getTypeName(responseType)
+		if raw {
+			typeName = getRawOutputTypeName(responseType)
+		}
 		if typeName == "*ObjectInterface" {
 			// Replace the generic ObjectInterface pointer with the right Remote Object API struct
 			objectTypeName := objectFuncToObject[serviceName+"."+methodName]
 			if objectTypeName == "" {
-				fmt.Println("method with unknown return type:" + method.Name)
-				fmt.Fprint(out, "*"+enigmaStandardTypesPrefix+"RemoteObject, ")
+				warn("method with unknown return type:" + method.Name)
+				typeName = "*" + enigmaStandardTypesPrefix + "RemoteObject"
 			} else {
-				fmt.Fprint(out, "*"+objectTypeName, ", ")
+				typeName = "*" + objectTypeName
 			}
-		} else {
-			fmt.Fprint(out, typeName, ", ")
 		}
+		signature.results = append(signature.results, typeName)
+	}
+	return signature
+}
 
+// format prints the method name, parameters and results with the type names passed through qualify
+func (s methodSignature) format(qualify func(string) string) string {
+	result := s.name + "(ctx context.Context"
+	for _, param := range s.params {
+		result += ", " + param.name + " " + qualify(param.typeName)
 	}
-	fmt.Fprint(out, "error")
-	if len(actualResponseMap) > 0 {
-		fmt.Fprint(out, ")")
+	result += ") "
+	if len(s.results) == 0 {
+		return result + "error"
+	}
+	result += "("
+	for _, typeName := range s.results {
+		result += qualify(typeName) + ", "
+	}
+	return result + "error)"
+}
+
+// Generate an ordinary fully typed method
+func printMethod(method *OpenRpcMethod, out io.Writer, serviceName string, methodName string, objectFuncToObject map[string]string) {
+	responseMap := getPropertiesWithoutFilteredNxInfo(method.Responses.Schema.Properties, methodName)
+	sortedResponseKeys := getOriginalOrderSortedKeys(responseMap)
+	actualResponseMap := getPropertiesWithoutRedundantResponses(responseMap, methodName)
+	sortedActualResponseKeys := getOriginalOrderSortedKeys(actualResponseMap)
+	// Generate Description
+	if method.Description != "" {
+		fmt.Fprintln(out, formatComment("", method.Description, method.Parameters))
 	}
+	printExtensionTags(out, "", method.QlikExtensions)
+	fmt.Fprint(out, "func (obj *", serviceName, ") ", getMethodSignature(method, serviceName, methodName, objectFuncToObject, false).format(identity))
 
 	// Generate Start of Function body
 	fmt.Fprintln(out, " {")
@@ -593,7 +676,7 @@ func printMethod(method *OpenRpcMethod, out *os.File, serviceName string, method
 }
 
 // Generate a raw method that allows raw json input and output
-func printRawMethod(method *OpenRpcMethod, out *os.File, serviceName string, methodName string, objectFuncToObject map[string]string) {
+func printRawMethod(method *OpenRpcMethod, out io.Writer, serviceName string, methodName string, objectFuncToObject map[string]string) {
 	responseMap := getPropertiesWithoutFilteredNxInfo(method.Responses.Schema.Properties, methodName)
 	sortedResponseKeys := getOriginalOrderSortedKeys(responseMap)
 	actualResponseMap := getPropertiesWithoutRedundantResponses(responseMap, methodName)
@@ -604,37 +687,7 @@ func printRawMethod(method *OpenRpcMethod, out *os.File, serviceName string, met
 		fmt.Fprintln(out, formatComment("", method.Description, method.Parameters))
 	}
 	printExtensionTags(out, "", method.QlikExtensions)
-	fmt.Fprint(out, "func (obj *", serviceName, ") ", methodName, "Raw(ctx context.Context")
-
-	// Generate Parameters
-	for _, param := range method.Parameters {
-		fmt.Fprint(out, ", ", "", toParamName(param.Name), " ", getRawInputTypeName(param))
-	}
-	fmt.Fprint(out, ")")
-
-	// Generate Return Types
-	if len(actualResponseMap) > 0 {
-		fmt.Fprint(out, " (")
-	}
-	for _, responseKey := range sortedActualResponseKeys {
-		response := actualResponseMap[responseKey]
-		typeName := getRawOutputTypeName(response)
-		if typeName == "*ObjectInterface" {
-			objectTypeName := objectFuncToObject[serviceName+"."+methodName]
-			if objectTypeName == "" {
-				fmt.Fprint(out, "*"+enigmaStandardTypesPrefix+"RemoteObject, ")
-			} else {
-				fmt.Fprint(out, "*"+objectTypeName, ", ")
-			}
-		} else {
-			fmt.Fprint(out, getRawOutputTypeName(response), ", ")
-		}
-
-	}
-	fmt.Fprint(out, "error")
-	if len(actualResponseMap) > 0 {
-		fmt.Fprint(out, ")")
-	}
+	fmt.Fprint(out, "func (obj *", serviceName, ") ", getMethodSignature(method, serviceName, methodName, objectFuncToObject, true).format(identity))
 
 	// Generate Start of Function body
 	fmt.Fprintln(out, " {")
@@ -694,7 +747,7 @@ func printRawMethod(method *OpenRpcMethod, out *os.File, serviceName string, met
 	fmt.Fprintln(out, "")
 }
 
-func printExtensionTags(out *os.File, indent string, extensions QlikExtensions) {
+func printExtensionTags(out io.Writer, indent string, extensions QlikExtensions) {
 
 	if extensions.QlikDeprecated1 || extensions.QlikDeprecated2 {
 		if extensions.QlikDeprecationDescription != "" {
@@ -709,7 +762,7 @@ func printExtensionTags(out *os.File, indent string, extensions QlikExtensions)
 
 }
 
-func printErrorCodeLookup(out *os.File, def *Type) {
+func printErrorCodeLookup(out io.Writer, def *Type) {
 	fmt.Fprintln(out, "func ErrorCodeLookup(c int) string {")
 	fmt.Fprintln(out, "switch c {")
 	for _, opt := range def.OneOf {
@@ -719,19 +772,325 @@ func printErrorCodeLookup(out *os.File, def *Type) {
 	fmt.Fprint(out, "}\nreturn \"\"\n}\n\n")
 }
 
+// enumConstNames creates the names of the constants of an enum. The names are based on the descriptions, which are the
+// identifiers used by Qlik Associative Engine, without the prefix frozen for the type in enumConstPrefixes, so that
+// adding an option does not rename the others. Types missing there leave out the words all descriptions start with.
+// The titles are used for options without description or if the descriptions do not give unique names.
+func enumConstNames(typeName string, options []*Option) []string {
+	descriptions := enumConstSources(options, true)
+	prefix, frozen := enumConstPrefixes[typeName]
+	if !frozen {
+		prefix = enumConstPrefix(descriptions)
+	}
+	if names := enumConstNamesFrom(typeName, descriptions, prefix); names != nil {
+		return names
+	}
+	titles := enumConstSources(options, false)
+	if names := enumConstNamesFrom(typeName, titles, enumConstPrefix(titles)); names != nil {
+		return names
+	}
+	panic("Duplicate enum constant names for:" + typeName)
+}
+
+func enumConstSources(options []*Option, useDescriptions bool) []string {
+	sources := make([]string, len(options))
+	for i, opt := range options {
+		sources[i] = opt.Title
+		if useDescriptions && opt.Description != "" {
+			sources[i] = opt.Description
+		}
+	}
+	return sources
+}
+
+// enumConstPrefix returns the words, followed by an underscore, that all sources start with and that leave at least
+// one word of each source
+func enumConstPrefix(sources []string) string {
+	if len(sources) < 2 {
+		return ""
+	}
+	words := make([][]string, len(sources))
+	for i, source := range sources {
+		words[i] = strings.Split(source, "_")
+	}
+	prefix := ""
+	for {
+		word := words[0][0]
+		for _, w := range words {
+			if len(w) < 2 || w[0] != word {
+				return prefix
+			}
+		}
+		prefix += word + "_"
+		for i := range words {
+			words[i] = words[i][1:]
+		}
+	}
+}
+
+func enumConstNamesFrom(typeName string, sources []string, prefix string) []string {
+	names := make([]string, len(sources))
+	seen := map[string]bool{}
+	for i, source := range sources {
+		if len(source) > len(prefix) {
+			source = strings.TrimPrefix(source, prefix)
+		}
+		names[i] = typeName + toCamelCase(strings.Split(source, "_"))
+		if seen[names[i]] {
+			return nil
+		}
+		seen[names[i]] = true
+	}
+	return names
+}
+
+func toCamelCase(words []string) string {
+	result := ""
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		result += strings.ToUpper(word[0:1]) + word[1:]
+	}
+	return result
+}
+
+func printEnumHelpers(out io.Writer) {
+	fmt.Fprintln(out, "// unmarshalEnum decodes an enum sent either by name or by numeric value. Unknown names are kept as is so that")
+	fmt.Fprintln(out, "// values added in later versions of Qlik Associative Engine can be read, use IsValid to check them.")
+	fmt.Fprintln(out, "func unmarshalEnum[T ~string](data []byte, values map[T]int, typeName string) (T, error) {")
+	fmt.Fprintln(out, "\tvar name string")
+	fmt.Fprintln(out, "\tif err := json.Unmarshal(data, &name); err == nil {")
+	fmt.Fprintln(out, "\t\treturn T(name), nil")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tvar number int")
+	fmt.Fprintln(out, "\tif err := json.Unmarshal(data, &number); err != nil {")
+	fmt.Fprintf(out, "\t\treturn \"\", fmt.Errorf(\"invalid %%s: %%s\", typeName, data)\n")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\tfor value, n := range values {")
+	fmt.Fprintln(out, "\t\tif n == number {")
+	fmt.Fprintln(out, "\t\t\treturn value, nil")
+	fmt.Fprintln(out, "\t\t}")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintf(out, "\treturn \"\", fmt.Errorf(\"invalid %%s: %%d\", typeName, number)\n")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+// printEnum prints a named string type with one constant per option, the constants have the names sent to and
+// received from Qlik Associative Engine as values
+func printEnum(out io.Writer, defName string, def *Type) {
+	valuesName := "valuesOf" + defName
+	names := enumConstNames(defName, def.OneOf)
+	fmt.Fprintln(out, "type", defName, "string")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "const (")
+	for i, opt := range def.OneOf {
+		fmt.Fprintf(out, "\t%s %s = \"%s\"\n", names[i], defName, opt.Title)
+	}
+	fmt.Fprintln(out, ")")
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "var %s = map[%s]int{\n", valuesName, defName)
+	for i, opt := range def.OneOf {
+		fmt.Fprintf(out, "\t%s: %d,\n", names[i], opt.ConstValue)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "// String returns the name of the value as sent to Qlik Associative Engine")
+	fmt.Fprintf(out, "func (e %s) String() string {\n\treturn string(e)\n}\n\n", defName)
+	fmt.Fprintf(out, "// IsValid tells if the value is one of the %s constants\n", defName)
+	fmt.Fprintf(out, "func (e %s) IsValid() bool {\n\t_, ok := %s[e]\n\treturn ok\n}\n\n", defName, valuesName)
+	fmt.Fprintln(out, "// Int returns the numeric value used by Qlik Associative Engine and whether the value is valid")
+	fmt.Fprintf(out, "func (e %s) Int() (int, bool) {\n\tn, ok := %s[e]\n\treturn n, ok\n}\n\n", defName, valuesName)
+	fmt.Fprintln(out, "// UnmarshalJSON accepts both the name and the numeric value")
+	fmt.Fprintf(out, "func (e *%s) UnmarshalJSON(data []byte) error {\n", defName)
+	fmt.Fprintf(out, "\tvalue, err := unmarshalEnum(data, %s, \"%s\")\n", valuesName, defName)
+	fmt.Fprint(out, "\tif err != nil {\n\t\treturn err\n\t}\n\t*e = value\n\treturn nil\n}\n\n")
+}
+
 func isNonZero(value any) bool {
 	return !(value == nil || value == "" || value == float64(0) || value == 0 || value == false)
 }
 
 func hasEnumRef(property *Type) bool {
 	if property.Ref != "" {
-		name := refToName(property.Ref)
 		// "Enums" have type string but are sent as "objects" with a list of valid options for said "enum".
-		return name == "string"
+		return enumsMap[strings.Replace(property.Ref, "#/components/schemas/", "", 1)]
 	}
 	return false
 }
 
+// isReadOnlyMethod tells if a method only reads state from the engine. Such methods have no access control
+// other than read, are named Get or Evaluate something and do not create new remote objects. Methods on Global
+// are left out since they report session wide state that is not covered by change lists.
+func isReadOnlyMethod(serviceName string, methodName string, method *OpenRpcMethod) bool {
+	if serviceName == "Global" || atLeastOneObjectInterface(method) {
+		return false
+	}
+	for _, access := range method.QlikAccessControl {
+		if access != "read" {
+			return false
+		}
+	}
+	return strings.HasPrefix(methodName, "Get") || strings.HasPrefix(methodName, "Evaluate")
+}
+
+func printReadOnlyMethods(out io.Writer, readOnlyMethods []string) {
+	fmt.Fprintln(out, "// ReadOnlyMethods lists the methods, keyed by object type and method name, that do not modify any state in Qlik Associative Engine.")
+	fmt.Fprintln(out, "// The list is derived from the schema and is used as the default allowlist by NewResponseCache.")
+	fmt.Fprintln(out, "var ReadOnlyMethods = map[string]bool{")
+	for _, name := range readOnlyMethods {
+		fmt.Fprintf(out, "\t\"%s\": true,\n", name)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+// printSchemaMethods prints all methods of the schema, including those left out of the generated code, and registers
+// them for capability negotiation
+func printSchemaMethods(out io.Writer, schemaMethods []string) {
+	fmt.Fprintln(out, "// SchemaMethods lists all methods of the schema, keyed by object type and method name. It is registered with")
+	fmt.Fprintln(out, "// RegisterEngineSchema to tell which methods an engine of this version has.")
+	fmt.Fprintln(out, "var SchemaMethods = map[string]bool{")
+	for _, name := range schemaMethods {
+		fmt.Fprintf(out, "\t\"%s\": true,\n", name)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "func init() {")
+	fmt.Fprintln(out, "\t"+enigmaStandardTypesPrefix+"RegisterEngineSchema(QIX_SCHEMA_VERSION, SchemaMethods)")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+// placedSignature is the signature of a generated method and the placement of the method
+type placedSignature struct {
+	placement placement
+	signature methodSignature
+}
+
+// groupByPlacement returns the placements of the methods, the main placement first, and the signatures per placement
+func groupByPlacement(methods []placedSignature) ([]placement, map[string][]methodSignature) {
+	placements := []placement{{}}
+	signatures := map[string][]methodSignature{}
+	for _, method := range methods {
+		key := method.placement.key()
+		if _, ok := signatures[key]; !ok && key != "" {
+			placements = append(placements, method.placement)
+		}
+		signatures[key] = append(signatures[key], method.signature)
+	}
+	sort.Slice(placements, func(i, j int) bool { return placements[i].key() < placements[j].key() })
+	return placements, signatures
+}
+
+// printServiceInterface prints an interface with all methods of a remote object type and asserts that the type
+// implements it. Methods behind build tags are declared in unexported interfaces that are empty when the tags are set.
+func printServiceInterface(files *generatedFiles, serviceName string, methods []placedSignature) {
+	placements, signatures := groupByPlacement(methods)
+	out := files.objectOut(serviceName, placement{})
+	fmt.Fprintf(out, "// %sAPI holds all methods of %s. Depend on it instead of %s to be able to use fakes or mocks in tests.\n", serviceName, serviceName, serviceName)
+	fmt.Fprintln(out, "type", serviceName+"API", "interface {")
+	fmt.Fprintln(out, "\t"+enigmaStandardTypesPrefix+"RemoteObjectAPI")
+	if serviceName == "Global" {
+		fmt.Fprintln(out, "\t"+enigmaStandardTypesPrefix+"SessionAPI")
+	}
+	for _, p := range placements[1:] {
+		fmt.Fprintln(out, "\t"+taggedInterfaceName(serviceName, p))
+	}
+	for _, signature := range signatures[""] {
+		fmt.Fprintln(out, "\t"+signature.format(identity))
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "var _ %sAPI = (*%s)(nil)\n\n", serviceName, serviceName)
+
+	for _, p := range placements[1:] {
+		out := files.out(p, false)
+		fmt.Fprintln(out, "type", taggedInterfaceName(serviceName, p), "interface {")
+		for _, signature := range signatures[p.key()] {
+			fmt.Fprintln(out, "\t"+signature.format(identity))
+		}
+		fmt.Fprintln(out, "}")
+		fmt.Fprintln(out, "")
+		fmt.Fprintln(files.out(p, true), "type", taggedInterfaceName(serviceName, p), "interface{}")
+		fmt.Fprintln(files.out(p, true), "")
+	}
+}
+
+func taggedInterfaceName(serviceName string, p placement) string {
+	return strings.ToLower(serviceName[0:1]) + serviceName[1:] + toCamelCase(p.categories) + "API"
+}
+
+var builtInTypeNames = map[string]bool{"string": true, "int": true, "bool": true, "byte": true, "any": true, "float64": true}
+
+// qualifyTypeName prefixes type names declared in the generated package with its name, for use in another package
+func qualifyTypeName(packageName string) func(string) string {
+	return func(typeName string) string {
+		modifiers := ""
+		for strings.HasPrefix(typeName, "[]") || strings.HasPrefix(typeName, "*") {
+			if typeName[0] == '*' {
+				modifiers += "*"
+				typeName = typeName[1:]
+			} else {
+				modifiers += "[]"
+				typeName = typeName[2:]
+			}
+		}
+		if builtInTypeNames[typeName] || strings.Contains(typeName, ".") {
+			return modifiers + typeName
+		}
+		return modifiers + packageName + "." + typeName
+	}
+}
+
+// printMocks generates testify mocks of the service interfaces in the package of the mocks file. The package
+// is expected to contain a RemoteObject mock, see the enigmamock package, that is embedded in the generated mocks.
+func printMocks(mocksFilePath string, importPath string, packageName string, splitObjects bool, serviceNames []string, serviceMethods map[string][]placedSignature) error {
+	qualify := qualifyTypeName(packageName)
+	files := newGeneratedFiles(splitObjects)
+	for _, serviceName := range serviceNames {
+		out := files.objectOut(serviceName, placement{})
+		fmt.Fprintf(out, "// %s is a mock of %s.%sAPI\n", serviceName, packageName, serviceName)
+		fmt.Fprintln(out, "type", serviceName, "struct {")
+		fmt.Fprintln(out, "\tRemoteObject")
+		fmt.Fprintln(out, "}")
+		fmt.Fprintln(out, "")
+		fmt.Fprintf(out, "var _ %s.%sAPI = (*%s)(nil)\n\n", packageName, serviceName, serviceName)
+		for _, method := range serviceMethods[serviceName] {
+			out := files.objectOut(serviceName, method.placement)
+			signature := method.signature
+			fmt.Fprintf(out, "// %s mocks %s.%s\n", signature.name, serviceName, signature.name)
+			fmt.Fprintf(out, "func (m *%s) %s {\n", serviceName, signature.format(qualify))
+			fmt.Fprint(out, "\targs := m.Called(ctx")
+			for _, param := range signature.params {
+				fmt.Fprint(out, ", ", param.name)
+			}
+			fmt.Fprintln(out, ")")
+			fmt.Fprint(out, "\treturn ")
+			for i, typeName := range signature.results {
+				fmt.Fprintf(out, "returnValue[%s](args, %d), ", qualify(typeName), i)
+			}
+			fmt.Fprintf(out, "args.Error(%d)\n", len(signature.results))
+			fmt.Fprintln(out, "}")
+			fmt.Fprintln(out, "")
+		}
+	}
+	return files.write(mocksFilePath, func(out io.Writer, constraint string, body string) {
+		fmt.Fprintln(out, "// Code generated by QIX generator (./schema/generate.go). DO NOT EDIT.")
+		fmt.Fprintln(out)
+		if constraint != "" {
+			fmt.Fprintln(out, "//go:build "+constraint)
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintln(out, "package "+filepath.Base(filepath.Dir(mocksFilePath)))
+		printImports(out, body, goImport{"context", "context"}, goImport{"json", "encoding/json"}, goImport{packageName, importPath})
+	})
+}
+
 func getExtraCrossAssignmentLine(methodName string) string {
 	switch methodName {
 	case "GetMediaList":
@@ -750,57 +1109,75 @@ func getExtraCrossAssignmentLine(methodName string) string {
 }
 
 func main() {
-
-	if len(os.Args) < 5 {
-		fmt.Println("Usage: go run schema/generate.go <file path to spec.json> <file path to generated file.go> <generated package name>")
-		os.Exit(1)
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:], os.Stdout); err != nil {
+			fmt.Println(err.Error())
+			os.Exit(2)
+		}
+		return
 	}
-	schemaFilePath := os.Args[1]
-	schemaCompanionFilePath := os.Args[2]
-	generatedFilePath := os.Args[3]
-	generatedFilePackage := os.Args[4]
-	enigmaImports := ""
-	enigmaStandardTypesPrefix = ""
-	// The fourth argument disable-enigma-import is used to indicate that we are genering the standard
-	// schema file inside of enigma-go and not an external one.
-	if !(len(os.Args) > 5 && os.Args[5] == "disable-enigma-import") {
-		enigmaImports = "\t\"github.com/qlik-oss/enigma-go/v4\"\n"
-		enigmaStandardTypesPrefix = "enigma."
+	options, err := parseArgs(os.Args[1:], os.Stderr)
+	if err == flag.ErrHelp {
+		os.Exit(0)
 	}
-
-	objectFuncToObject := createObjectFunctionToObjectTypeMapping(schemaCompanionFilePath)
-	schemaFile, err := loadSchemaFile(schemaFilePath)
 	if err != nil {
 		fmt.Println(err.Error())
-		os.Exit(1)
+		os.Exit(2)
+	}
+	enigmaStandardTypesPrefix = ""
+	imports := []goImport{{"context", "context"}, {"json", "encoding/json"}, {"fmt", "fmt"}}
+	if options.enigmaImportPath != "" {
+		imports = append(imports, goImport{"enigma", options.enigmaImportPath})
+		enigmaStandardTypesPrefix = "enigma."
 	}
 
-	// Start generating the go file
-	out, err := os.Create(generatedFilePath)
+	objectFuncToObject := map[string]string{}
+	if options.companionFilePath != "" {
+		companion := loadSchemaCompanion(options.companionFilePath)
+		objectFuncToObject = companion.RemoteObjectReturnTypes
+		enumConstPrefixes = companion.EnumConstantPrefixes
+	}
+	schemaFile, err := loadSchemaFile(options.schemaFilePath)
 	if err != nil {
 		fmt.Println(err.Error())
 		os.Exit(1)
 	}
-	defer out.Close()
+	if options.methodsOnly {
+		if err := writeMethodsOnly(options, schemaFile); err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
 
-	fmt.Fprintln(out, "// Code generated by QIX generator (./schema/generate.go) for Qlik Associative Engine version", schemaFile.Info.Version, ". DO NOT EDIT.")
-	fmt.Fprintln(out)
-	fmt.Fprintln(out, "package "+generatedFilePackage)
-	fmt.Fprintln(out, "import (")
-	fmt.Fprintln(out, "\t\"context\"")
-	fmt.Fprintln(out, "\t\"encoding/json\"")
-	fmt.Fprint(out, enigmaImports)
-	fmt.Fprint(out, ")\n\n")
+	// Start generating the go files
+	typePlacements := placeTypes(schemaFile, options.filter)
+	files := newGeneratedFiles(options.splitObjects)
+	out := files.out(placement{}, false)
 	fmt.Fprintln(out, "// Version of the schema used to generate the enigma.go QIX API")
 	fmt.Fprintf(out, "const QIX_SCHEMA_VERSION = \"%s\"\n\n", schemaFile.Info.Version)
+	if len(enumsMap) > 0 {
+		printEnumHelpers(out)
+	}
+	validatedTypes := findValidatedTypes(schemaFile, options.filter, typePlacements)
+	if len(validatedTypes) > 0 {
+		printValidationHelpers(out)
+	}
+	for defName, def := range schemaFile.Components.Schemas {
+		if hasExtraProperties(defName, def) && !typePlacements[defName].excluded {
+			printExtraHelpers(out)
+			break
+		}
+	}
 
 	// Generate definition data type structs
 	definitionKeys := getAlphabeticSortedKeys(schemaFile.Components.Schemas)
 	for _, defName := range definitionKeys {
-		if defName == "JsonObject" {
+		if defName == "JsonObject" || typePlacements[defName].excluded {
 			continue
 		}
 		def := schemaFile.Components.Schemas[defName]
+		out := files.out(typePlacements[defName], false)
 		if def.Description != "" {
 			fmt.Fprintln(out, formatComment("", def.Description, nil))
 		}
@@ -813,20 +1190,40 @@ func main() {
 			for _, key := range propertiesKeys {
 				propertyName := key.Key
 				property := def.Properties[key]
-				printStructMember(propertyName, property, out)
+				if !options.filter.isExcluded(property.QlikExtensions) {
+					printStructMember(propertyName, property, out)
+				}
 			}
 			if def.AdditionalProperties != nil {
 				for _, property := range def.AdditionalProperties.AnyOf {
 					printStructMember(property.Name, property.Schema, out)
 				}
 			}
+			if hasExtraProperties(defName, def) {
+				printExtraField(out)
+			}
 			fmt.Fprintln(out, "}")
 			fmt.Fprintln(out, "")
+			if hasExtraProperties(defName, def) {
+				propertyNames, _ := definitionProperties(def, options.filter)
+				printExtraMarshalling(out, defName, propertyNames)
+			}
+			if validatedTypes[defName] {
+				printValidate(out, defName, def, options.filter, validatedTypes)
+			}
 		case "array":
 			fmt.Fprintln(out, "type", defName, getTypeName(def))
 			fmt.Fprintln(out, "")
+			if validatedTypes[defName] {
+				printValidate(out, defName, def, options.filter, validatedTypes)
+			}
 		case "string":
-			// Enums are strings now and only one of them is of particular interest.
+			if len(def.OneOf) > 0 {
+				if _, frozen := enumConstPrefixes[defName]; !frozen && options.companionFilePath != "" {
+					fmt.Fprintf(os.Stderr, "enum %s has no constant prefix in the schema companion, add \"%s\": %q to enum-constant-prefixes\n", defName, defName, enumConstPrefix(enumConstSources(def.OneOf, true)))
+				}
+				printEnum(out, defName, def)
+			}
 			if defName == "NxLocalizedErrorCode" {
 				printErrorCodeLookup(out, def)
 			}
@@ -842,16 +1239,23 @@ func main() {
 	mapmap := restructureByRemoteObject(schemaFile.Methods)
 	// Generate structs for the remote objects (service APIs)
 	serviceNames := getSortedServiceKeys(mapmap)
+	readOnlyMethods := []string{}
+	serviceMethods := map[string][]placedSignature{}
 	for _, serviceName := range serviceNames {
 
 		var serviceImplName = serviceName
-		fmt.Fprintln(out, "type ", serviceImplName, "struct {")
-		fmt.Fprintln(out, "\t*"+enigmaStandardTypesPrefix+"RemoteObject")
-		fmt.Fprintln(out, "}")
+		fmt.Fprintln(files.objectOut(serviceName, placement{}), "type ", serviceImplName, "struct {")
+		fmt.Fprintln(files.objectOut(serviceName, placement{}), "\t*"+enigmaStandardTypesPrefix+"RemoteObject")
+		fmt.Fprintln(files.objectOut(serviceName, placement{}), "}")
 
 		methodKeys := getSortedMethodKeys(mapmap[serviceName])
 		for _, methodName := range methodKeys {
 			method := mapmap[serviceName][methodName]
+			methodPlacement := options.filter.place(method.QlikExtensions)
+			if methodPlacement.excluded {
+				continue
+			}
+			out := files.objectOut(serviceName, methodPlacement)
 
 			for _, par := range method.Parameters {
 				patchMissingTypeInfo(par.Schema, par.Name, methodName)
@@ -863,17 +1267,45 @@ func main() {
 
 			// Generate typed methods
 			printMethod(method, out, serviceName, methodName, objectFuncToObject)
+			serviceMethods[serviceName] = append(serviceMethods[serviceName], placedSignature{methodPlacement, getMethodSignature(method, serviceName, methodName, objectFuncToObject, false)})
+			if isReadOnlyMethod(serviceName, methodName, method) {
+				readOnlyMethods = append(readOnlyMethods, serviceName+"."+methodName)
+			}
 
 			// Generate untyped (raw) methods for those with complex parameters and/or return values
 			actualResponses := getPropertiesWithoutFilteredNxInfo(method.Responses.Schema.Properties, methodName)
-			if !(allTypesBuiltIn(method.Parameters) && allTypesBuiltInMap(actualResponses)) {
-				printRawMethod(method, out, serviceName, methodName, objectFuncToObject)
+			rawPlacement := options.filter.placeRaw(methodPlacement)
+			if !rawPlacement.excluded && !(allTypesBuiltIn(method.Parameters) && allTypesBuiltInMap(actualResponses)) {
+				printRawMethod(method, files.objectOut(serviceName, rawPlacement), serviceName, methodName, objectFuncToObject)
+				serviceMethods[serviceName] = append(serviceMethods[serviceName], placedSignature{rawPlacement, getMethodSignature(method, serviceName, methodName, objectFuncToObject, true)})
 			}
 		}
+		printServiceInterface(files, serviceName, serviceMethods[serviceName])
+	}
+	printReadOnlyMethods(files.out(placement{}, false), readOnlyMethods)
+	printSchemaMethods(files.out(placement{}, false), schemaMethodNames(schemaFile))
+	printMethodParams(files.out(placement{}, false), schemaFile.Methods)
+
+	err = files.write(options.generatedFilePath, func(out io.Writer, constraint string, body string) {
+		fmt.Fprintln(out, "// Code generated by QIX generator (./schema/generate.go) for Qlik Associative Engine version", schemaFile.Info.Version, ". DO NOT EDIT.")
+		fmt.Fprintln(out)
+		if constraint != "" {
+			fmt.Fprintln(out, "//go:build "+constraint)
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintln(out, "package "+options.packageName)
+		printImports(out, body, imports...)
+	})
+	if err == nil && options.mocksFilePath != "" {
+		err = printMocks(options.mocksFilePath, options.mocksImportPath, options.packageName, options.splitObjects, serviceNames, serviceMethods)
+	}
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
 	}
 }
 
-func printStructMember(propertyName string, property *Type, out *os.File) {
+func printStructMember(propertyName string, property *Type, out io.Writer) {
 	if propertyName[0:1] == "q" {
 		propertyName = propertyName[1:]
 	}
@@ -16,9 +16,13 @@ generate() {
   if [[ $? -ne 0 ]]; then
     ENGINE_VERSION=$(cat ./schema/engine-rpc.json | jq -r '.info.version')
     echo "Generating enigma-go based on OPEN-RPC API for Qlik Associative Engine version $ENGINE_VERSION"
+    ## list the API changes for the review and the release notes
+    OLD_SCHEMA=$(mktemp)
+    git show origin/master:schema/engine-rpc.json > $OLD_SCHEMA
+    go run ./schema diff -companion ./schema/schema-companion.json $OLD_SCHEMA ./schema/engine-rpc.json
+    rm -f $OLD_SCHEMA
     ## generate code
-    go run ./schema/generate.go ./schema/engine-rpc.json ./schema/schema-companion.json ./qix_generated.go enigma disable-enigma-import && \
-      go fmt ./qix_generated.go >/dev/null
+    go generate .
   else
     echo "No changes to engine-rpc.json, nothing to do."
   fi
@@ -20,3 +20,64 @@ func TestFormatComment2(t *testing.T) {
 	fmt.Println("-----------------------------------------")
 	fmt.Println(formatComment(" ", text, []*Type{}))
 }
+
+func TestEnumConstNames(t *testing.T) {
+	check := func(expected []string, actual []string) {
+		if fmt.Sprint(expected) != fmt.Sprint(actual) {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+	}
+	// Shared leading words of the descriptions are left out
+	check([]string{"NxSortIndicatorTypeNone", "NxSortIndicatorTypeAsc", "NxSortIndicatorTypeDesc"}, enumConstNames("NxSortIndicatorType", []*Option{
+		{Title: "N", Description: "NX_SORT_INDICATE_NONE"},
+		{Title: "A", Description: "NX_SORT_INDICATE_ASC"},
+		{Title: "D", Description: "NX_SORT_INDICATE_DESC"},
+	}))
+	// Titles are used for options without description
+	check([]string{"StateEnumTypeLocked", "StateEnumTypeNstates"}, enumConstNames("StateEnumType", []*Option{
+		{Title: "L", Description: "LOCKED"},
+		{Title: "NSTATES"},
+	}))
+	// Titles are used when the descriptions are not unique
+	check([]string{"KindOne", "KindTwo"}, enumConstNames("Kind", []*Option{
+		{Title: "one", Description: "Same"},
+		{Title: "two", Description: "Same"},
+	}))
+
+	// A frozen prefix keeps the names when an option without the prefix is added
+	defer func(saved map[string]string) { enumConstPrefixes = saved }(enumConstPrefixes)
+	enumConstPrefixes = map[string]string{"NxSortIndicatorType": "NX_SORT_INDICATE_"}
+	check([]string{"NxSortIndicatorTypeNone", "NxSortIndicatorTypeAsc", "NxSortIndicatorTypeSortByCustom"}, enumConstNames("NxSortIndicatorType", []*Option{
+		{Title: "N", Description: "NX_SORT_INDICATE_NONE"},
+		{Title: "A", Description: "NX_SORT_INDICATE_ASC"},
+		{Title: "C", Description: "SORT_BY_CUSTOM"},
+	}))
+}
+
+func TestEnumConstPrefixesAreFrozen(t *testing.T) {
+	schema, err := loadSchemaFile("engine-rpc.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefixes := loadSchemaCompanion("schema-companion.json").EnumConstantPrefixes
+	for name := range enumsMap {
+		if _, ok := prefixes[name]; !ok {
+			t.Errorf("enum %s has no constant prefix in schema-companion.json, add %q", name, enumConstPrefix(enumConstSources(schema.Components.Schemas[name].OneOf, true)))
+		}
+	}
+}
+
+func TestQualifyTypeName(t *testing.T) {
+	qualify := qualifyTypeName("enigma")
+	for typeName, expected := range map[string]string{
+		"string":          "string",
+		"[]*NxCell":       "[]*enigma.NxCell",
+		"*Doc":            "*enigma.Doc",
+		"json.RawMessage": "json.RawMessage",
+		"[][]Float64":     "[][]enigma.Float64",
+	} {
+		if actual := qualify(typeName); actual != expected {
+			t.Errorf("expected %s, got %s", expected, actual)
+		}
+	}
+}
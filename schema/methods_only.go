@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// schemaMethodNames returns the methods of the schema keyed by object type and method name, sorted like the generated
+// code
+func schemaMethodNames(schemaFile *OpenRpcFile) []string {
+	mapmap := restructureByRemoteObject(schemaFile.Methods)
+	var result []string
+	for _, serviceName := range getSortedServiceKeys(mapmap) {
+		for _, methodName := range getSortedMethodKeys(mapmap[serviceName]) {
+			result = append(result, serviceName+"."+methodName)
+		}
+	}
+	return result
+}
+
+// writeMethodsOnly writes a package that only registers the methods of the schema for capability negotiation, so that
+// the methods of older engines can be known without generating their whole API
+func writeMethodsOnly(options *generatorOptions, schemaFile *OpenRpcFile) error {
+	if err := os.MkdirAll(filepath.Dir(options.generatedFilePath), 0755); err != nil {
+		return err
+	}
+	files := newGeneratedFiles(false)
+	out := files.out(placement{}, false)
+	fmt.Fprintln(out, "// Version of the schema registered by the package")
+	fmt.Fprintf(out, "const QIX_SCHEMA_VERSION = \"%s\"\n\n", schemaFile.Info.Version)
+	printSchemaMethods(out, schemaMethodNames(schemaFile))
+	return files.write(options.generatedFilePath, func(out io.Writer, constraint string, body string) {
+		fmt.Fprintln(out, "// Code generated by QIX generator (./schema/generate.go) for Qlik Associative Engine version", schemaFile.Info.Version, ". DO NOT EDIT.")
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "// Package "+options.packageName+" registers the methods of Qlik Associative Engine "+schemaFile.Info.Version+" with")
+		fmt.Fprintln(out, "// enigma.RegisterEngineSchema when imported.")
+		fmt.Fprintln(out, "package "+options.packageName)
+		printImports(out, body, goImport{"enigma", options.enigmaImportPath})
+	})
+}
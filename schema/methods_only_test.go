@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteMethodsOnly(t *testing.T) {
+	schemaFile := &OpenRpcFile{
+		Info:    Info{Version: "12.1306.0"},
+		Methods: []*OpenRpcMethod{{Name: "Global.OpenDoc"}, {Name: "Doc.GetObject"}, {Name: "Doc.GetField"}},
+	}
+	filePath := filepath.Join(t.TempDir(), "qix_methods_generated.go")
+	options := &generatorOptions{generatedFilePath: filePath, packageName: "qix12_1306", enigmaImportPath: enigmaImportPath, methodsOnly: true}
+	enigmaStandardTypesPrefix = "enigma."
+	defer func() { enigmaStandardTypesPrefix = "" }()
+	if err := writeMethodsOnly(options, schemaFile); err != nil {
+		t.Fatal(err)
+	}
+	generated, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `// Code generated by QIX generator (./schema/generate.go) for Qlik Associative Engine version 12.1306.0 . DO NOT EDIT.
+
+// Package qix12_1306 registers the methods of Qlik Associative Engine 12.1306.0 with
+// enigma.RegisterEngineSchema when imported.
+package qix12_1306
+
+import (
+	"github.com/qlik-oss/enigma-go/v4"
+)
+
+// Version of the schema registered by the package
+const QIX_SCHEMA_VERSION = "12.1306.0"
+
+// SchemaMethods lists all methods of the schema, keyed by object type and method name. It is registered with
+// RegisterEngineSchema to tell which methods an engine of this version has.
+var SchemaMethods = map[string]bool{
+	"Doc.GetField":   true,
+	"Doc.GetObject":  true,
+	"Global.OpenDoc": true,
+}
+
+func init() {
+	enigma.RegisterEngineSchema(QIX_SCHEMA_VERSION, SchemaMethods)
+}
+`
+	if string(generated) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, generated)
+	}
+
+	options.enigmaImportPath = ""
+	if err := options.validate(); err == nil {
+		t.Error("expected an error for -methods-only without enigma import")
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// enigmaImportPath is the import path of enigma-go used by generated packages outside of enigma-go
+const enigmaImportPath = "github.com/qlik-oss/enigma-go/v4"
+
+// generatorOptions holds the command line options of the generator
+type generatorOptions struct {
+	schemaFilePath    string
+	companionFilePath string
+	generatedFilePath string
+	packageName       string
+	// enigmaImportPath is empty when generating enigma-go itself
+	enigmaImportPath string
+	mocksFilePath    string
+	mocksImportPath  string
+	filter           filterOptions
+	// splitObjects places the methods of each remote object type in a file of its own
+	splitObjects bool
+	// methodsOnly generates a package that only registers the methods of the schema
+	methodsOnly bool
+}
+
+type categoriesFlag struct {
+	categories *map[string]bool
+}
+
+func (c categoriesFlag) String() string {
+	if c.categories == nil {
+		return ""
+	}
+	var result []string
+	for _, category := range allCategories {
+		if (*c.categories)[category] {
+			result = append(result, category)
+		}
+	}
+	return strings.Join(result, ",")
+}
+
+func (c categoriesFlag) Set(value string) (err error) {
+	*c.categories, err = parseCategories(value)
+	return err
+}
+
+// parseArgs parses the command line. For backwards compatibility the arguments can also be given as
+// <spec.json> <schema-companion.json> <generated file.go> <package name> followed by the options in
+// the form disable-enigma-import, mocks=<file> and so on.
+func parseArgs(args []string, output io.Writer) (*generatorOptions, error) {
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		return parsePositionalArgs(args)
+	}
+	options := &generatorOptions{}
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintln(output, "Usage: go run github.com/qlik-oss/enigma-go/v4/schema -schema <spec.json> -out <generated file.go> [options]")
+		fmt.Fprintln(output, "")
+		fmt.Fprintln(output, "Generates a typed Go API from an OpenRPC specification of Qlik Associative Engine, for instance in a")
+		fmt.Fprintln(output, "go:generate directive:")
+		fmt.Fprintln(output, "")
+		fmt.Fprintln(output, "\t//go:generate go run github.com/qlik-oss/enigma-go/v4/schema -schema ./qix.json -out ./qix_generated.go")
+		fmt.Fprintln(output, "")
+		fmt.Fprintln(output, "Options:")
+		flags.PrintDefaults()
+	}
+	flags.StringVar(&options.schemaFilePath, "schema", "", "`file` path of the OpenRPC specification, required")
+	flags.StringVar(&options.companionFilePath, "companion", "", "`file` path of the schema companion mapping methods to the types of the objects they return and holding\nthe enum constant prefixes, if not set the methods return *enigma.RemoteObject")
+	flags.StringVar(&options.generatedFilePath, "out", "", "`file` path of the generated code, required. Files with build constraints are written next to it.")
+	flags.StringVar(&options.packageName, "package", os.Getenv("GOPACKAGE"), "`name` of the generated package, defaults to the package of the go:generate directive")
+	flags.StringVar(&options.enigmaImportPath, "enigma-import", enigmaImportPath, "import `path` of enigma-go, empty when generating enigma-go itself")
+	flags.StringVar(&options.mocksFilePath, "mocks", "", "`file` path of generated testify mocks of the API interfaces, none if empty. The package of\nthe file must contain a RemoteObject mock like the one in enigmamock.")
+	flags.StringVar(&options.mocksImportPath, "mocks-import", "", "import `path` of the generated package used by the mocks, required with -mocks unless\ngenerating enigma-go itself")
+	flags.Var(categoriesFlag{&options.filter.exclude}, "exclude", "comma separated `categories` of items to leave out: "+strings.Join(allCategories, ", "))
+	flags.Var(categoriesFlag{&options.filter.tagged}, "build-tags", "comma separated `categories` of items to place in files left out by the build tags\n"+buildTagPrefix+"<category>")
+	flags.BoolVar(&options.splitObjects, "split-objects", false, "write the methods and interfaces of each remote object type to a file of its own next to -out")
+	flags.BoolVar(&options.methodsOnly, "methods-only", false, "only generate the list of methods of the schema and register it with enigma.RegisterEngineSchema,\nfor capability negotiation with engines of that version")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+	return options, options.validate()
+}
+
+func parsePositionalArgs(args []string) (*generatorOptions, error) {
+	if len(args) < 4 {
+		return nil, errors.New("usage: go run ./schema <file path to spec.json> <file path to schema-companion.json> <file path to generated file.go> <generated package name> [disable-enigma-import] [mocks=<file>] [mocks-import=<import path>] [exclude=<categories>] [build-tags=<categories>] [split-objects]")
+	}
+	options := &generatorOptions{
+		schemaFilePath:    args[0],
+		companionFilePath: args[1],
+		generatedFilePath: args[2],
+		packageName:       args[3],
+		enigmaImportPath:  enigmaImportPath,
+	}
+	var err error
+	for _, arg := range args[4:] {
+		switch {
+		case arg == "disable-enigma-import":
+			options.enigmaImportPath = ""
+		case arg == "split-objects":
+			options.splitObjects = true
+		case strings.HasPrefix(arg, "mocks="):
+			options.mocksFilePath = strings.TrimPrefix(arg, "mocks=")
+		case strings.HasPrefix(arg, "mocks-import="):
+			options.mocksImportPath = strings.TrimPrefix(arg, "mocks-import=")
+		case strings.HasPrefix(arg, "exclude="):
+			options.filter.exclude, err = parseCategories(strings.TrimPrefix(arg, "exclude="))
+		case strings.HasPrefix(arg, "build-tags="):
+			options.filter.tagged, err = parseCategories(strings.TrimPrefix(arg, "build-tags="))
+		default:
+			err = fmt.Errorf("unknown argument: %s", arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return options, options.validate()
+}
+
+func (o *generatorOptions) validate() error {
+	switch {
+	case o.schemaFilePath == "":
+		return errors.New("-schema is required")
+	case o.generatedFilePath == "":
+		return errors.New("-out is required")
+	case o.packageName == "":
+		return errors.New("-package is required outside of go:generate")
+	}
+	if o.methodsOnly && o.enigmaImportPath == "" {
+		return errors.New("-methods-only cannot be used when generating enigma-go itself")
+	}
+	if o.mocksFilePath != "" && o.mocksImportPath == "" {
+		if o.enigmaImportPath != "" {
+			return errors.New("-mocks-import is required with -mocks")
+		}
+		o.mocksImportPath = enigmaImportPath
+	}
+	return nil
+}
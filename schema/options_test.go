@@ -0,0 +1,36 @@
+package main
+
+import (
+	"io"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	options, err := parseArgs([]string{"-schema", "spec.json", "-out", "qix.go", "-package", "qix", "-exclude", "experimental,deprecated", "-build-tags", "raw", "-split-objects"}, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.enigmaImportPath != enigmaImportPath || !options.filter.exclude["deprecated"] || options.filter.exclude["stable"] || !options.filter.tagged["raw"] || !options.splitObjects {
+		t.Errorf("unexpected options %+v", options)
+	}
+
+	// Positional arguments are still supported
+	options, err = parseArgs([]string{"spec.json", "companion.json", "qix.go", "enigma", "disable-enigma-import", "mocks=mock/mocks.go"}, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.enigmaImportPath != "" || options.companionFilePath != "companion.json" || options.mocksImportPath != enigmaImportPath {
+		t.Errorf("unexpected options %+v", options)
+	}
+
+	for _, args := range [][]string{
+		{"-out", "qix.go", "-package", "qix"},
+		{"-schema", "spec.json", "-out", "qix.go", "-package", "qix", "-mocks", "mock/mocks.go"},
+		{"-schema", "spec.json", "-out", "qix.go", "-package", "qix", "-build-tags", "unknown"},
+		{"spec.json", "companion.json", "qix.go"},
+	} {
+		if _, err := parseArgs(args, io.Discard); err == nil {
+			t.Errorf("expected error for %v", args)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// intRanges holds the bounds of the integer formats that do not have a Go type of their own
+var intRanges = map[string][2]string{
+	"int8":  {"-128", "127"},
+	"int32": {"-2147483648", "2147483647"},
+}
+
+func resolveSchema(t *Type) *Type {
+	if t != nil && t.Type == "" && t.Schema != nil {
+		return t.Schema
+	}
+	return t
+}
+
+func arrayItems(t *Type) *Type {
+	if t.Items == nil && t.Ref != "" {
+		return &Type{Ref: t.Ref}
+	}
+	return t.Items
+}
+
+// needsValidation tells if values of the type can be invalid according to the schema
+func needsValidation(t *Type, validatedTypes map[string]bool) bool {
+	t = resolveSchema(t)
+	switch {
+	case t == nil:
+		return false
+	case t.Type == "array":
+		return needsValidation(arrayItems(t), validatedTypes)
+	case t.Ref != "":
+		name := strings.Replace(t.Ref, "#/components/schemas/", "", 1)
+		return enumsMap[name] || validatedTypes[name]
+	case t.Type == "integer":
+		_, ok := intRanges[t.Format]
+		return ok
+	}
+	return false
+}
+
+// definitionProperties returns the fields of a struct type, keyed by their name in the schema
+func definitionProperties(def *Type, options filterOptions) ([]string, map[string]*Type) {
+	var names []string
+	properties := map[string]*Type{}
+	for _, key := range getOriginalOrderSortedKeys(def.Properties) {
+		if property := def.Properties[key]; !options.isExcluded(property.QlikExtensions) {
+			names = append(names, key.Key)
+			properties[key.Key] = property
+		}
+	}
+	if def.AdditionalProperties != nil {
+		for _, property := range def.AdditionalProperties.AnyOf {
+			names = append(names, property.Name)
+			properties[property.Name] = property.Schema
+		}
+	}
+	return names, properties
+}
+
+// findValidatedTypes returns the struct and array types that get a Validate method, those with enums, integers with
+// a limited range or such types in their fields
+func findValidatedTypes(schema *OpenRpcFile, options filterOptions, typePlacements map[string]placement) map[string]bool {
+	result := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for name, def := range schema.Components.Schemas {
+			if result[name] || typePlacements[name].excluded || name == "JsonObject" {
+				continue
+			}
+			validated := false
+			switch def.Type {
+			case "object":
+				_, properties := definitionProperties(def, options)
+				for _, property := range properties {
+					validated = validated || needsValidation(property, result)
+				}
+			case "array":
+				validated = needsValidation(arrayItems(def), result)
+			}
+			if validated {
+				result[name] = true
+				changed = true
+			}
+		}
+	}
+	return result
+}
+
+func printValidationHelpers(out io.Writer) {
+	fmt.Fprintln(out, "func joinPath(path string, name string) string {")
+	fmt.Fprintln(out, "\tif path == \"\" {")
+	fmt.Fprintln(out, "\t\treturn name")
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn path + \".\" + name")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "func indexPath(path string, index int) string {")
+	fmt.Fprintf(out, "\treturn fmt.Sprintf(\"%%s[%%d]\", path, index)\n")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "func validateRange(path string, value int, min int, max int) error {")
+	fmt.Fprintln(out, "\tif value < min || value > max {")
+	fmt.Fprintf(out, "\t\treturn &%sValidationError{Path: path, Message: fmt.Sprintf(\"%%d is out of range [%%d, %%d]\", value, min, max)}\n", enigmaStandardTypesPrefix)
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn nil")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "func validateEnum[T interface {")
+	fmt.Fprintln(out, "\t~string")
+	fmt.Fprintln(out, "\tIsValid() bool")
+	fmt.Fprintln(out, "}](path string, value T) error {")
+	fmt.Fprintln(out, "\tif value != \"\" && !value.IsValid() {")
+	fmt.Fprintf(out, "\t\treturn &%sValidationError{Path: path, Message: fmt.Sprintf(\"unknown value %%q\", string(value))}\n", enigmaStandardTypesPrefix)
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "\treturn nil")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+// printValidate prints the Validate method of a struct or array type and the validate method used by the types
+// containing it
+func printValidate(out io.Writer, defName string, def *Type, options filterOptions, validatedTypes map[string]bool) {
+	receiver := "*" + defName
+	if def.Type == "array" {
+		receiver = defName
+	}
+	fmt.Fprintf(out, "// Validate checks the values against the enums and integer formats of the schema, the error is a *%sValidationError\n", enigmaStandardTypesPrefix)
+	fmt.Fprintf(out, "func (v %s) Validate() error {\n", receiver)
+	fmt.Fprintln(out, "\treturn v.validate(\"\")")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "func (v %s) validate(path string) error {\n", receiver)
+	if def.Type == "array" {
+		printValueValidation(out, "\t", "v", "path", def, 0, validatedTypes)
+	} else {
+		fmt.Fprintln(out, "\tif v == nil {")
+		fmt.Fprintln(out, "\t\treturn nil")
+		fmt.Fprintln(out, "\t}")
+		names, properties := definitionProperties(def, options)
+		for _, name := range names {
+			property := properties[name]
+			if !needsValidation(property, validatedTypes) {
+				continue
+			}
+			name = strings.TrimPrefix(name, "q")
+			value := "v." + toPublicMemberName(name)
+			path := "joinPath(path, \"q" + name + "\")"
+			if isNonZero(property.Default) && !hasEnumRef(property) {
+				fmt.Fprintf(out, "\tif %s != nil {\n", value)
+				printValueValidation(out, "\t\t", "*"+value, path, property, 0, validatedTypes)
+				fmt.Fprintln(out, "\t}")
+			} else {
+				printValueValidation(out, "\t", value, path, property, 0, validatedTypes)
+			}
+		}
+	}
+	fmt.Fprintln(out, "\treturn nil")
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
+
+func printValueValidation(out io.Writer, indent string, value string, path string, t *Type, depth int, validatedTypes map[string]bool) {
+	t = resolveSchema(t)
+	check := ""
+	switch {
+	case t.Type == "array":
+		index, item := fmt.Sprint("i", depth), fmt.Sprint("item", depth)
+		fmt.Fprintf(out, "%sfor %s, %s := range %s {\n", indent, index, item, value)
+		printValueValidation(out, indent+"\t", item, "indexPath("+path+", "+index+")", arrayItems(t), depth+1, validatedTypes)
+		fmt.Fprintf(out, "%s}\n", indent)
+		return
+	case t.Ref != "" && enumsMap[strings.Replace(t.Ref, "#/components/schemas/", "", 1)]:
+		check = "validateEnum(" + path + ", " + value + ")"
+	case t.Ref != "":
+		check = value + ".validate(" + path + ")"
+	default:
+		bounds := intRanges[t.Format]
+		check = "validateRange(" + path + ", " + value + ", " + bounds[0] + ", " + bounds[1] + ")"
+	}
+	fmt.Fprintf(out, "%sif err := %s; err != nil {\n", indent, check)
+	fmt.Fprintf(out, "%s\treturn err\n", indent)
+	fmt.Fprintf(out, "%s}\n", indent)
+}
+
+// printMethodParams prints the parameters of all methods of the schema for the validation interceptor
+func printMethodParams(out io.Writer, methods []*OpenRpcMethod) {
+	fmt.Fprintln(out, "// MethodParams lists the parameters of the methods of the schema, keyed by object type and method name. It is")
+	fmt.Fprintln(out, "// used by NewValidationInterceptor to find missing required parameters.")
+	fmt.Fprintf(out, "var MethodParams = map[string][]%sParamSchema{\n", enigmaStandardTypesPrefix)
+	sorted := append([]*OpenRpcMethod{}, methods...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	for _, method := range sorted {
+		if len(method.Parameters) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\t\"%s\": {", method.Name)
+		for i, param := range method.Parameters {
+			if i > 0 {
+				fmt.Fprint(out, ", ")
+			}
+			fmt.Fprintf(out, "{Name: \"%s\", Required: %t}", param.Name, param.Required)
+		}
+		fmt.Fprintln(out, "},")
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out, "")
+}
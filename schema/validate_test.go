@@ -0,0 +1,39 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestFindValidatedTypes(t *testing.T) {
+	ref := func(name string) *Type { return &Type{Ref: "#/components/schemas/" + name} }
+	defer func(saved map[string]bool) { enumsMap = saved }(enumsMap)
+	enumsMap = map[string]bool{"Mode": true}
+	schema := &OpenRpcFile{Components: &OpenRpcComponents{Schemas: map[string]*Type{
+		"Mode":     {Type: "string", OneOf: []*Option{{Title: "A"}}},
+		"Sort":     {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qState", Order: 1}: {Type: "integer", Format: "int8"}}},
+		"Sorts":    {Type: "array", Items: ref("Sort")},
+		"Def":      {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qSorts", Order: 2}: {Type: "array", Items: ref("Sorts")}, {Key: "qMode", Order: 3}: ref("Mode")}},
+		"Plain":    {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qText", Order: 4}: {Type: "string"}, {Key: "qCount", Order: 5}: {Type: "integer", Format: "int64"}}},
+		"Wrapping": {Type: "object", Properties: map[OrderAwareKey]*Type{{Key: "qPlain", Order: 6}: ref("Plain")}},
+	}}}
+	validated := findValidatedTypes(schema, filterOptions{}, map[string]placement{})
+	for name, expected := range map[string]bool{"Mode": false, "Sort": true, "Sorts": true, "Def": true, "Plain": false, "Wrapping": false} {
+		if validated[name] != expected {
+			t.Errorf("expected %v for %s", expected, name)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	printValidate(out, "Def", schema.Components.Schemas["Def"], filterOptions{}, validated)
+	for _, expected := range []string{
+		"for i0, item0 := range v.Sorts {",
+		`if err := item0.validate(indexPath(joinPath(path, "qSorts"), i0)); err != nil {`,
+		`if err := validateEnum(joinPath(path, "qMode"), v.Mode); err != nil {`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %s in\n%s", expected, out.String())
+		}
+	}
+}
@@ -26,6 +26,17 @@ type (
 		interceptorChain         InterceptorContinuation
 	}
 
+	// SessionAPI holds the session methods available on all remote objects, it is embedded in GlobalAPI
+	SessionAPI interface {
+		DisconnectFromServer()
+		Disconnected() chan struct{}
+		SessionMessageChannel(topics ...string) chan SessionMessage
+		CloseSessionMessageChannel(channel chan SessionMessage)
+		SessionState(ctx context.Context) (string, error)
+		ChangeListsChannel(pushedOnly bool) chan ChangeLists
+		CloseChangeListsChannel(channel chan ChangeLists)
+	}
+
 	// ChangeListsKey key for ChangeLists context value
 	ChangeListsKey struct{}
 	// ChangeLists list of changed and closed handles.
@@ -41,7 +52,8 @@ type (
 
 func (q *session) connect(ctx context.Context, url string, httpHeader http.Header) error {
 	// Connect websocket
-	socket, err := q.dialer.CreateSocket(ctx, url, httpHeader)
+	createSocket := buildDialChain(q.dialer.SessionInterceptors, q.dialer.CreateSocket)
+	socket, err := createSocket(ctx, url, httpHeader)
 	if err != nil {
 		return err
 	}
@@ -129,6 +141,7 @@ func (q *session) mainSessionLoop() {
 		q.dialer.TrafficLogger.Closed()
 	}
 	q.socket.Close()
+	q.interceptClosed(q.closedWithError())
 	q.closeAllSessionEventChannels()
 	q.closeAllChangeListChannels()
 	close(q.disconnectedFromServerCh)
@@ -141,10 +154,16 @@ func (q *session) handleResponse(message []byte, receiveTimestamp time.Time) {
 	rpcResponse := &socketInput{}
 	json.Unmarshal(message, rpcResponse)
 	if rpcResponse.Method != "" { //This is a notification
-		q.emitSessionMessage(rpcResponse.Method, rpcResponse.Params)
+		if sessionMessage, ok := q.interceptNotification(SessionMessage{Topic: rpcResponse.Method, Content: rpcResponse.Params}); ok {
+			q.emitSessionMessage(sessionMessage.Topic, sessionMessage.Content)
+		}
 	} else {
 		pendingCall := q.removePendingCall(rpcResponse.ID)
+		changeLists := q.interceptChangeLists(ChangeLists{Changed: rpcResponse.Change, Closed: rpcResponse.Close, Suspended: rpcResponse.Suspend}, pendingCall == nil)
+		rpcResponse.Change, rpcResponse.Close, rpcResponse.Suspend = changeLists.Changed, changeLists.Closed, changeLists.Suspended
 		q.emitChangeLists(rpcResponse.Change, rpcResponse.Close, rpcResponse.Suspend, pendingCall == nil) // Emit this before marking the pending call as done to make sure it is there when the pending call returns
+		// Also update the remote objects before marking the pending call as done so that nothing observes stale state after the call has returned
+		q.handleUpdates(rpcResponse.Change, rpcResponse.Close)
 		if pendingCall != nil {
 			pendingCall.Response = rpcResponse
 			pendingCall.receiveTimestamp = receiveTimestamp
@@ -152,7 +171,6 @@ func (q *session) handleResponse(message []byte, receiveTimestamp time.Time) {
 			pendingCall.Done <- nil
 		}
 	}
-	q.handleUpdates(rpcResponse.Change, rpcResponse.Close)
 }
 
 func (q *session) sendCancelRequest(requestID int) {
@@ -168,11 +186,7 @@ func (q *session) sendCancelRequest(requestID int) {
 func (q *session) invokeRPC(ctx context.Context, invocation *Invocation) *InvocationResponse {
 	invokeTimestamp := time.Now()
 
-	// Change empty params to empty interface array
-	params := invocation.Params
-	if params == nil {
-		params = []interface{}{}
-	}
+	params := invocation.params()
 
 	if closedError := q.closedWithError(); closedError != nil {
 		if metricsCollector := getMetricsCollector(ctx); metricsCollector != nil {
@@ -293,7 +307,7 @@ func newSession(dialer *Dialer) *session {
 		remoteObjectRegistry:     newRemoteObjectRegistry(),
 		disconnectedFromServerCh: make(chan struct{}, 1),
 	}
-	qixSession.interceptorChain = buildInterceptorChain(dialer.Interceptors, qixSession.invokeRPC)
+	qixSession.interceptorChain = buildInterceptorChain(buildInvocationInterceptors(dialer), qixSession.invokeRPC)
 
 	return qixSession
 }
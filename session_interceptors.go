@@ -0,0 +1,98 @@
+package enigma
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	// DialContinuation creates the socket by calling the rest of the dial interceptor chain and finally the CreateSocket function of the Dialer
+	DialContinuation func(ctx context.Context, url string, httpHeader http.Header) (Socket, error)
+
+	// DialInterceptor wraps the creation of the socket. It can for instance alter the url or the headers, retry failed attempts
+	// or wrap the returned socket.
+	DialInterceptor func(ctx context.Context, url string, httpHeader http.Header, next DialContinuation) (Socket, error)
+
+	// NotificationInterceptor observes an inbound notification before it reaches the session message channels.
+	// The returned message replaces the inbound one and returning false drops the notification.
+	NotificationInterceptor func(message SessionMessage) (SessionMessage, bool)
+
+	// ChangeListsInterceptor observes non-empty inbound change, close and suspend lists before they are applied to remote objects and
+	// handed to change list channels. The pushed argument is true for lists that did not arrive as part of a response.
+	// The returned lists replace the inbound ones.
+	ChangeListsInterceptor func(changeLists ChangeLists, pushed bool) ChangeLists
+
+	// CloseInterceptor is called once the socket has been closed with the error that ended the session
+	CloseInterceptor func(err error)
+
+	// SessionInterceptor hooks into the whole life cycle of a session and not only into invocations. All fields are optional.
+	// Session interceptors are applied in the order they appear in the Dialer and after the plain Interceptors.
+	SessionInterceptor struct {
+		// Invocation is added to the invocation interceptor chain
+		Invocation Interceptor
+		// Dial wraps the creation of the socket
+		Dial DialInterceptor
+		// Notification observes and modifies inbound notifications
+		Notification NotificationInterceptor
+		// ChangeLists observes and modifies inbound change lists
+		ChangeLists ChangeListsInterceptor
+		// Closed is called when the session ends
+		Closed CloseInterceptor
+	}
+)
+
+func buildInvocationInterceptors(dialer *Dialer) []Interceptor {
+	interceptors := make([]Interceptor, 0, len(dialer.Interceptors)+len(dialer.SessionInterceptors))
+	interceptors = append(interceptors, dialer.Interceptors...)
+	for _, sessionInterceptor := range dialer.SessionInterceptors {
+		if sessionInterceptor.Invocation != nil {
+			interceptors = append(interceptors, sessionInterceptor.Invocation)
+		}
+	}
+	return interceptors
+}
+
+func buildDialChain(sessionInterceptors []SessionInterceptor, createSocket DialContinuation) DialContinuation {
+	next := createSocket
+	for i := len(sessionInterceptors) - 1; i >= 0; i-- {
+		if dialInterceptor := sessionInterceptors[i].Dial; dialInterceptor != nil {
+			continuation := next
+			next = func(ctx context.Context, url string, httpHeader http.Header) (Socket, error) {
+				return dialInterceptor(ctx, url, httpHeader, continuation)
+			}
+		}
+	}
+	return next
+}
+
+func (q *session) interceptNotification(message SessionMessage) (SessionMessage, bool) {
+	for _, sessionInterceptor := range q.dialer.SessionInterceptors {
+		if sessionInterceptor.Notification != nil {
+			var keep bool
+			if message, keep = sessionInterceptor.Notification(message); !keep {
+				return message, false
+			}
+		}
+	}
+	return message, true
+}
+
+func (q *session) interceptChangeLists(changeLists ChangeLists, pushed bool) ChangeLists {
+	if len(changeLists.Changed) == 0 && len(changeLists.Closed) == 0 && len(changeLists.Suspended) == 0 {
+		return changeLists
+	}
+	for _, sessionInterceptor := range q.dialer.SessionInterceptors {
+		if sessionInterceptor.ChangeLists != nil {
+			changeLists = sessionInterceptor.ChangeLists(changeLists, pushed)
+		}
+	}
+	return changeLists
+}
+
+func (q *session) interceptClosed(err error) {
+	for _, sessionInterceptor := range q.dialer.SessionInterceptors {
+		if sessionInterceptor.Closed != nil {
+			sessionInterceptor.Closed(err)
+		}
+	}
+}
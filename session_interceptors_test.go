@@ -0,0 +1,84 @@
+package enigma
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionInterceptors(t *testing.T) {
+	ctx := context.Background()
+	log := ""
+	closedErr := make(chan error, 1)
+	dialer := &Dialer{
+		CreateSocket: func(ctx context.Context, url string, header http.Header) (Socket, error) {
+			log += "<create " + url + " " + header.Get("Authorization") + ">"
+			return NewMockSocket("")
+		},
+		Interceptors: []Interceptor{func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+			log += "<interceptor>"
+			return next(ctx, invocation)
+		}},
+		SessionInterceptors: []SessionInterceptor{{
+			Dial: func(ctx context.Context, url string, header http.Header, next DialContinuation) (Socket, error) {
+				log += "<dial1>"
+				header.Set("Authorization", "Bearer token")
+				return next(ctx, url, header)
+			},
+			Invocation: func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+				log += "<session interceptor>"
+				return next(ctx, invocation)
+			},
+			Notification: func(message SessionMessage) (SessionMessage, bool) {
+				if message.Topic == "OnDropped" {
+					return message, false
+				}
+				message.Topic = "Renamed" + message.Topic
+				return message, true
+			},
+			ChangeLists: func(changeLists ChangeLists, pushed bool) ChangeLists {
+				// Ignore changes to the handle 7
+				changed := []int{}
+				for _, handle := range changeLists.Changed {
+					if handle != 7 {
+						changed = append(changed, handle)
+					}
+				}
+				changeLists.Changed = changed
+				return changeLists
+			},
+			Closed: func(err error) {
+				closedErr <- err
+			},
+		}, {
+			Dial: func(ctx context.Context, url string, header http.Header, next DialContinuation) (Socket, error) {
+				log += "<dial2>"
+				return next(ctx, url+"/app", header)
+			},
+		}},
+	}
+	session := newSession(dialer)
+	assert.NoError(t, session.connect(ctx, "ws://engine", http.Header{}))
+	assert.Equal(t, "<dial1><dial2><create ws://engine/app Bearer token>", log)
+
+	testSocket := session.GetMockSocket()
+	testSocket.AddReceivedMessage(`{"jsonrpc":"2.0","method":"OnDropped","params":{}}`)
+	testSocket.AddReceivedMessage(`{"jsonrpc":"2.0","method":"OnConnected","params":{}}`)
+	message := <-session.SessionMessageChannel()
+	assert.Equal(t, "RenamedOnConnected", message.Topic)
+
+	log = ""
+	object := session.getRemoteObject(&ObjectInterface{Handle: -1})
+	testSocket.ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"DummyQixMethod","handle":-1,"id":1,"params":[]}`,
+		`{"jsonrpc":"2.0","id":1,"result":{},"change":[1,7]}`)
+	cl := &ChangeLists{}
+	assert.NoError(t, object.RPC(context.WithValue(ctx, ChangeListsKey{}, cl), "DummyQixMethod", nil))
+	assert.Equal(t, "<interceptor><session interceptor>", log)
+	assert.Equal(t, []int{1}, cl.Changed)
+
+	session.DisconnectFromServer()
+	assert.Error(t, <-closedErr)
+}
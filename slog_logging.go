@@ -0,0 +1,236 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+type (
+	// SlogOptions configures the structured logging created by NewSlogInterceptor and NewSlogTrafficLogger.
+	SlogOptions struct {
+		// Logger receives the log records. If nil slog.Default() is used.
+		Logger *slog.Logger
+
+		// Level is the level used for methods that have no entry in MethodLevels.
+		Level slog.Level
+
+		// MethodLevels overrides Level per method. A key is either a method name such as "GetLayout"
+		// or a method name prefixed with the object type such as "GenericObject.GetLayout". The latter takes precedence.
+		MethodLevels map[string]slog.Level
+
+		// MaxPayloadSize is the maximum number of bytes of params and results included in a record.
+		// Longer payloads are truncated. Zero leaves payloads out of the records and a negative value disables truncation.
+		MaxPayloadSize int
+
+		// PayloadSampleRate includes payloads in every n:th record only. Zero and one include payloads in all records.
+		PayloadSampleRate int
+	}
+
+	slogTrafficLogger struct {
+		options        SlogOptions
+		payloadCounter uint64
+		mutex          sync.Mutex
+		pendingMethods map[int]string
+	}
+
+	slogTrafficFrame struct {
+		Method string          `json:"method"`
+		Handle *int            `json:"handle"`
+		ID     int             `json:"id"`
+		Params json.RawMessage `json:"params"`
+		Result json.RawMessage `json:"result"`
+		Error  *qixError       `json:"error"`
+		rpcStatusInfo
+	}
+)
+
+func (o *SlogOptions) logger() *slog.Logger {
+	if o.Logger != nil {
+		return o.Logger
+	}
+	return slog.Default()
+}
+
+func (o *SlogOptions) levelFor(objectType string, method string) slog.Level {
+	if level, ok := o.MethodLevels[objectType+"."+method]; ok {
+		return level
+	}
+	if level, ok := o.MethodLevels[method]; ok {
+		return level
+	}
+	return o.Level
+}
+
+// includePayload decides whether payloads should be part of the next record
+func (o *SlogOptions) includePayload(counter *uint64) bool {
+	if o.MaxPayloadSize == 0 {
+		return false
+	}
+	if o.PayloadSampleRate <= 1 {
+		return true
+	}
+	return (atomic.AddUint64(counter, 1)-1)%uint64(o.PayloadSampleRate) == 0
+}
+
+func (o *SlogOptions) truncatePayload(payload []byte) string {
+	if o.MaxPayloadSize < 0 || len(payload) <= o.MaxPayloadSize {
+		return string(payload)
+	}
+	return fmt.Sprintf("%s...(truncated, %d bytes)", payload[:o.MaxPayloadSize], len(payload))
+}
+
+// NewSlogInterceptor creates an interceptor that writes one structured log record per invocation including
+// method, handle, object id and type, duration, message sizes, error code and the change and close lists.
+func NewSlogInterceptor(options SlogOptions) Interceptor {
+	var payloadCounter uint64
+	return func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+		logger := options.logger()
+		objectType, objectID, handle := "", "", 0
+		if invocation.RemoteObject != nil && invocation.RemoteObject.ObjectInterface != nil {
+			objectType = invocation.RemoteObject.Type
+			objectID = invocation.RemoteObject.GenericId
+			handle = invocation.RemoteObject.Handle
+		}
+		level := options.levelFor(objectType, invocation.Method)
+		if !logger.Enabled(ctx, level) && !logger.Enabled(ctx, slog.LevelError) {
+			return next(ctx, invocation)
+		}
+
+		// Reuse collectors already present in the context so that outer interceptors still get their data
+		metricsCollector := getMetricsCollector(ctx)
+		if metricsCollector == nil {
+			ctx, metricsCollector = WithMetricsCollector(ctx)
+		}
+		changeLists := changeListFromContext(ctx)
+		if changeLists == nil {
+			changeLists = &ChangeLists{}
+			ctx = context.WithValue(ctx, ChangeListsKey{}, changeLists)
+		}
+
+		start := time.Now()
+		response := next(ctx, invocation)
+		duration := time.Since(start)
+
+		metrics := metricsCollector.Metrics()
+		attrs := []slog.Attr{
+			slog.String("method", invocation.Method),
+			slog.Int("handle", handle),
+			slog.String("objectType", objectType),
+			slog.String("objectId", objectID),
+			slog.Int("requestId", response.RequestID),
+			slog.Duration("duration", duration),
+			slog.Int("requestSize", metrics.RequestMessageSize),
+			slog.Int("responseSize", metrics.ResponseMessageSize),
+		}
+		if len(changeLists.Changed) > 0 {
+			attrs = append(attrs, slog.Any("changed", changeLists.Changed))
+		}
+		if len(changeLists.Closed) > 0 {
+			attrs = append(attrs, slog.Any("closed", changeLists.Closed))
+		}
+		if response.Error != nil {
+			var enigmaError Error
+			if errors.As(response.Error, &enigmaError) {
+				attrs = append(attrs, slog.Int("errorCode", enigmaError.Code()))
+			}
+			attrs = append(attrs, slog.String("error", response.Error.Error()))
+			level = slog.LevelError
+		}
+		if options.includePayload(&payloadCounter) {
+			if params, err := marshal(invocation.params()); err == nil {
+				attrs = append(attrs, slog.String("params", options.truncatePayload(params)))
+			}
+			if response.Result != nil {
+				attrs = append(attrs, slog.String("result", options.truncatePayload(response.Result)))
+			}
+		}
+		logger.LogAttrs(ctx, level, "enigma invocation", attrs...)
+		return response
+	}
+}
+
+// NewSlogTrafficLogger creates a TrafficLogger that writes one structured log record per websocket frame.
+// Requests and responses are correlated by request id so that MethodLevels applies to both. Frames do not carry
+// the object type so only the plain method name keys in MethodLevels are used.
+func NewSlogTrafficLogger(options SlogOptions) TrafficLogger {
+	return &slogTrafficLogger{options: options, pendingMethods: make(map[int]string)}
+}
+
+// Opened implements the TrafficLogger interface
+func (t *slogTrafficLogger) Opened() {
+	t.options.logger().LogAttrs(context.Background(), t.options.Level, "enigma socket opened")
+}
+
+// Sent implements the TrafficLogger interface
+func (t *slogTrafficLogger) Sent(message []byte) {
+	frame := &slogTrafficFrame{}
+	json.Unmarshal(message, frame)
+	t.mutex.Lock()
+	t.pendingMethods[frame.ID] = frame.Method
+	t.mutex.Unlock()
+
+	attrs := []slog.Attr{
+		slog.String("direction", "sent"),
+		slog.String("method", frame.Method),
+		slog.Int("requestId", frame.ID),
+		slog.Int("size", len(message)),
+	}
+	if frame.Handle != nil {
+		attrs = append(attrs, slog.Int("handle", *frame.Handle))
+	}
+	if t.options.includePayload(&t.payloadCounter) && frame.Params != nil {
+		attrs = append(attrs, slog.String("params", t.options.truncatePayload(frame.Params)))
+	}
+	t.options.logger().LogAttrs(context.Background(), t.options.levelFor("", frame.Method), "enigma traffic", attrs...)
+}
+
+// Received implements the TrafficLogger interface
+func (t *slogTrafficLogger) Received(message []byte) {
+	frame := &slogTrafficFrame{}
+	json.Unmarshal(message, frame)
+	method := frame.Method
+	attrs := []slog.Attr{
+		slog.String("direction", "received"),
+		slog.Int("size", len(message)),
+	}
+	if method != "" {
+		attrs = append(attrs, slog.String("notification", method))
+	} else {
+		t.mutex.Lock()
+		method = t.pendingMethods[frame.ID]
+		delete(t.pendingMethods, frame.ID)
+		t.mutex.Unlock()
+		attrs = append(attrs, slog.String("method", method), slog.Int("requestId", frame.ID))
+	}
+	if len(frame.Change) > 0 {
+		attrs = append(attrs, slog.Any("changed", frame.Change))
+	}
+	if len(frame.Close) > 0 {
+		attrs = append(attrs, slog.Any("closed", frame.Close))
+	}
+	level := t.options.levelFor("", method)
+	if frame.Error != nil {
+		attrs = append(attrs, slog.Int("errorCode", frame.Error.Code()), slog.String("error", frame.Error.Error()))
+		level = slog.LevelError
+	}
+	if t.options.includePayload(&t.payloadCounter) {
+		if frame.Result != nil {
+			attrs = append(attrs, slog.String("result", t.options.truncatePayload(frame.Result)))
+		} else if frame.Params != nil {
+			attrs = append(attrs, slog.String("params", t.options.truncatePayload(frame.Params)))
+		}
+	}
+	t.options.logger().LogAttrs(context.Background(), level, "enigma traffic", attrs...)
+}
+
+// Closed implements the TrafficLogger interface
+func (t *slogTrafficLogger) Closed() {
+	t.options.logger().LogAttrs(context.Background(), t.options.Level, "enigma socket closed")
+}
@@ -0,0 +1,90 @@
+package enigma
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeLogRecords(t *testing.T, buffer *bytes.Buffer) []map[string]interface{} {
+	records := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		record := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestSlogInterceptor(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	session := newSession(&Dialer{
+		CreateSocket: func(ctx context.Context, url string, header http.Header) (Socket, error) { return NewMockSocket("") },
+		Interceptors: []Interceptor{NewSlogInterceptor(SlogOptions{
+			Logger:         logger,
+			MethodLevels:   map[string]slog.Level{"GenericObject.GetLayout": slog.LevelDebug},
+			MaxPayloadSize: 10,
+		})},
+	})
+	session.connect(context.Background(), "", nil)
+	object := session.getRemoteObject(&ObjectInterface{Handle: 4, Type: "GenericObject", GenericId: "abc"})
+
+	session.GetMockSocket().ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"GetLayout","handle":4,"id":1,"params":[]}`,
+		`{"jsonrpc":"2.0","id":1,"result":{"qLayout":{"qInfo":{"qId":"abc"}}},"change":[4]}`)
+	session.GetMockSocket().ExpectCall(
+		`{"jsonrpc":"2.0","delta":false,"method":"SetProperties","handle":4,"id":2,"params":[{}]}`,
+		`{"jsonrpc":"2.0","id":2,"error":{"code":2,"parameter":"p","message":"m"}}`)
+
+	assert.NoError(t, object.RPC(context.Background(), "GetLayout", nil))
+	assert.Error(t, object.RPC(context.Background(), "SetProperties", nil, json.RawMessage(`{}`)))
+
+	records := decodeLogRecords(t, buffer)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "DEBUG", records[0]["level"])
+	assert.Equal(t, "GetLayout", records[0]["method"])
+	assert.Equal(t, "abc", records[0]["objectId"])
+	assert.Equal(t, "GenericObject", records[0]["objectType"])
+	assert.EqualValues(t, 4, records[0]["handle"])
+	assert.EqualValues(t, []interface{}{float64(4)}, records[0]["changed"])
+	assert.Equal(t, `{"qLayout"...(truncated, 35 bytes)`, records[0]["result"])
+	assert.NotZero(t, records[0]["responseSize"])
+	assert.Equal(t, "ERROR", records[1]["level"])
+	assert.EqualValues(t, 2, records[1]["errorCode"])
+}
+
+func TestSlogTrafficLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := NewSlogTrafficLogger(SlogOptions{
+		Logger:            slog.New(slog.NewJSONHandler(buffer, nil)),
+		MethodLevels:      map[string]slog.Level{"GetProgress": slog.LevelDebug},
+		MaxPayloadSize:    -1,
+		PayloadSampleRate: 2,
+	})
+	logger.Opened()
+	logger.Sent([]byte(`{"jsonrpc":"2.0","method":"GetProgress","handle":-1,"id":1,"params":[0]}`))
+	logger.Received([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	logger.Sent([]byte(`{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":2,"params":["app"]}`))
+	logger.Received([]byte(`{"jsonrpc":"2.0","id":2,"result":{"qReturn":{"qHandle":1}},"change":[1]}`))
+	logger.Received([]byte(`{"jsonrpc":"2.0","method":"OnConnected","params":{}}`))
+	logger.Closed()
+
+	records := decodeLogRecords(t, buffer)
+	// The debug level records for GetProgress are filtered out by the handler
+	assert.Len(t, records, 5)
+	assert.Equal(t, "enigma socket opened", records[0]["msg"])
+	assert.Equal(t, "OpenDoc", records[1]["method"])
+	assert.Equal(t, `["app"]`, records[1]["params"])
+	assert.Equal(t, "OpenDoc", records[2]["method"])
+	assert.Nil(t, records[2]["result"])
+	assert.EqualValues(t, []interface{}{float64(1)}, records[2]["changed"])
+	assert.Equal(t, "OnConnected", records[3]["notification"])
+	assert.Equal(t, "enigma socket closed", records[4]["msg"])
+}
@@ -0,0 +1,87 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+type (
+	// TimeoutPolicy describes a default timeout for invocations of the methods matching Pattern
+	TimeoutPolicy struct {
+		// Pattern uses the path.Match syntax, for instance "Get*" or "DoReload*". It is matched against the method name
+		// or, if the pattern contains a dot, against the object type and method name such as "Doc.DoSave".
+		Pattern string
+		// Timeout is the maximum duration of a matching invocation
+		Timeout time.Duration
+	}
+
+	// TimeoutError is returned when the deadline applied by a TimeoutPolicy expires. It wraps context.DeadlineExceeded.
+	TimeoutError struct {
+		// Method is the name of the method that timed out
+		Method string
+		// Policy is the policy that applied the deadline
+		Policy TimeoutPolicy
+	}
+)
+
+// DefaultTimeoutPolicies contains timeouts suitable for most applications: long running reloads and saves get generous
+// timeouts while getters are expected to return quickly.
+var DefaultTimeoutPolicies = []TimeoutPolicy{
+	{Pattern: "DoReload*", Timeout: 30 * time.Minute},
+	{Pattern: "DoSave", Timeout: 2 * time.Minute},
+	{Pattern: "Get*", Timeout: 5 * time.Second},
+}
+
+func (err *TimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %v (timeout policy %q)", err.Method, err.Policy.Timeout, err.Policy.Pattern)
+}
+
+// Unwrap makes errors.Is(err, context.DeadlineExceeded) hold for a TimeoutError
+func (err *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+func (p *TimeoutPolicy) matches(invocation *Invocation) bool {
+	name := invocation.Method
+	if strings.Contains(p.Pattern, ".") {
+		if invocation.RemoteObject == nil || invocation.RemoteObject.ObjectInterface == nil {
+			return false
+		}
+		name = invocation.RemoteObject.Type + "." + name
+	}
+	matched, _ := path.Match(p.Pattern, name)
+	return matched
+}
+
+// NewTimeoutInterceptor creates an interceptor that applies the timeout of the first matching policy to invocations.
+// A deadline already present in the context is never extended: if it expires before the policy timeout the context is left untouched.
+// When the policy deadline expires the invocation fails with a *TimeoutError.
+func NewTimeoutInterceptor(policies ...TimeoutPolicy) Interceptor {
+	return func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+		var policy *TimeoutPolicy
+		for i := range policies {
+			if policies[i].matches(invocation) {
+				policy = &policies[i]
+				break
+			}
+		}
+		if policy == nil {
+			return next(ctx, invocation)
+		}
+		if deadline, ok := ctx.Deadline(); ok && !deadline.After(time.Now().Add(policy.Timeout)) {
+			return next(ctx, invocation)
+		}
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, policy.Timeout)
+		defer cancel()
+		response := next(ctxWithTimeout, invocation)
+		if response.Error != nil && errors.Is(response.Error, context.DeadlineExceeded) && ctx.Err() == nil {
+			response.Error = &TimeoutError{Method: invocation.Method, Policy: *policy}
+		}
+		return response
+	}
+}
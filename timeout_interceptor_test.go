@@ -0,0 +1,59 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	interceptor := NewTimeoutInterceptor(
+		TimeoutPolicy{Pattern: "Doc.DoSave", Timeout: time.Hour},
+		TimeoutPolicy{Pattern: "Get*", Timeout: 10 * time.Millisecond},
+	)
+	doc := &RemoteObject{ObjectInterface: &ObjectInterface{Handle: 1, Type: "Doc"}}
+
+	var deadline time.Time
+	var hasDeadline bool
+	blockingNext := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		deadline, hasDeadline = ctx.Deadline()
+		<-ctx.Done()
+		return &InvocationResponse{Error: ctx.Err()}
+	}
+	immediateNext := func(ctx context.Context, invocation *Invocation) *InvocationResponse {
+		deadline, hasDeadline = ctx.Deadline()
+		return &InvocationResponse{}
+	}
+
+	// The policy fires and the error tells which policy it was
+	response := interceptor(context.Background(), &Invocation{RemoteObject: doc, Method: "GetAppLayout"}, blockingNext)
+	var timeoutError *TimeoutError
+	assert.True(t, errors.As(response.Error, &timeoutError))
+	assert.Equal(t, "Get*", timeoutError.Policy.Pattern)
+	assert.True(t, errors.Is(response.Error, context.DeadlineExceeded))
+	assert.Equal(t, `GetAppLayout timed out after 10ms (timeout policy "Get*")`, response.Error.Error())
+
+	// Patterns with object type only match that type
+	interceptor(context.Background(), &Invocation{RemoteObject: doc, Method: "DoSave"}, immediateNext)
+	assert.True(t, hasDeadline)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), deadline, time.Minute)
+	interceptor(context.Background(), &Invocation{RemoteObject: &RemoteObject{ObjectInterface: &ObjectInterface{Type: "Global"}}, Method: "DoSave"}, immediateNext)
+	assert.False(t, hasDeadline)
+
+	// A shorter existing deadline is kept and its error is not rewritten
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	existingDeadline, _ := ctx.Deadline()
+	response = interceptor(ctx, &Invocation{RemoteObject: doc, Method: "GetAppLayout"}, blockingNext)
+	assert.Equal(t, existingDeadline, deadline)
+	assert.Equal(t, context.DeadlineExceeded, response.Error)
+
+	// A longer existing deadline is shortened
+	ctx, cancel = context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	interceptor(ctx, &Invocation{RemoteObject: doc, Method: "GetAppLayout"}, immediateNext)
+	assert.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, time.Second)
+}
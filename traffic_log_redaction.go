@@ -0,0 +1,330 @@
+package enigma
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+)
+
+type (
+	// RedactionRule removes sensitive values from the params and results of the methods matching Method.
+	//
+	// Paths use a JSONPath-style syntax rooted at $, which is the params array for ParamPaths and the result object for ResultPaths.
+	// Supported selectors are .name, ..name (any depth), [n], [*] and .*, for instance "$[2]", "$[0].qConnectionString" or "$..qDataPages".
+	RedactionRule struct {
+		// Method is matched against the method or notification name using the path.Match syntax, "*" matches all methods
+		Method string
+		// ParamPaths selects values in the params of requests and notifications. Selected values are replaced by RedactedValue.
+		ParamPaths []string
+		// ResultPaths selects values in results. Selected values are replaced by an empty value of the same JSON type
+		// (RedactedValue for strings) so that redacted logs still unmarshal into the generated types.
+		ResultPaths []string
+	}
+
+	// RedactionOptions configures a redacting traffic logger
+	RedactionOptions struct {
+		// Rules lists the redaction rules to apply, see DefaultRedactionRules
+		Rules []RedactionRule
+		// IncludeMethods, if not empty, limits the log to frames of methods matching at least one of the patterns
+		IncludeMethods []string
+		// ExcludeMethods drops frames of methods matching any of the patterns
+		ExcludeMethods []string
+	}
+
+	redactingTrafficLogger struct {
+		next           TrafficLogger
+		options        RedactionOptions
+		paramPaths     map[*RedactionRule][]redactionPath
+		resultPaths    map[*RedactionRule][]redactionPath
+		mutex          sync.Mutex
+		pendingMethods map[int]string
+		droppedIDs     map[int]bool
+	}
+
+	redactionPath []redactionPathSegment
+
+	redactionPathSegment struct {
+		name       string
+		index      int
+		wildcard   bool
+		descendant bool
+	}
+
+	redactionFrame struct {
+		Method string `json:"method"`
+		ID     int    `json:"id"`
+	}
+)
+
+// RedactedValue replaces redacted strings and params in traffic logs. A MockSocket treats it as a wildcard when matching
+// recorded requests so that redacted logs can still be replayed.
+const RedactedValue = "***REDACTED***"
+
+// DefaultRedactionRules covers credentials passed to OpenDoc, load scripts, data connection strings and data pages.
+var DefaultRedactionRules = []RedactionRule{
+	{Method: "OpenDoc", ParamPaths: []string{"$[2]", "$[3]", "$.qPassword", "$.qSerial"}},
+	{Method: "SetScript", ParamPaths: []string{"$[0]", "$.qScript"}},
+	{Method: "GetScript*", ResultPaths: []string{"$..qScript"}},
+	{Method: "CreateConnection", ParamPaths: []string{"$..qConnectionString", "$..qPassword"}},
+	{Method: "ModifyConnection", ParamPaths: []string{"$..qConnectionString", "$..qPassword"}},
+	{Method: "GetConnection*", ResultPaths: []string{"$..qConnectionString"}},
+	{Method: "GetTableData", ResultPaths: []string{"$.qData"}},
+	{Method: "GetHyperCubeTreeData", ResultPaths: []string{"$.qNodes"}},
+	{Method: "*", ResultPaths: []string{"$..qDataPages"}},
+}
+
+// NewRedactingTrafficLogger wraps a TrafficLogger and redacts every frame according to the options before passing it on.
+// Responses are correlated with their requests by request id to find out which method they belong to.
+func NewRedactingTrafficLogger(next TrafficLogger, options RedactionOptions) (TrafficLogger, error) {
+	t := &redactingTrafficLogger{
+		next:           next,
+		options:        options,
+		paramPaths:     make(map[*RedactionRule][]redactionPath),
+		resultPaths:    make(map[*RedactionRule][]redactionPath),
+		pendingMethods: make(map[int]string),
+		droppedIDs:     make(map[int]bool),
+	}
+	for i := range options.Rules {
+		rule := &options.Rules[i]
+		for _, p := range rule.ParamPaths {
+			parsed, err := parseRedactionPath(p)
+			if err != nil {
+				return nil, err
+			}
+			t.paramPaths[rule] = append(t.paramPaths[rule], parsed)
+		}
+		for _, p := range rule.ResultPaths {
+			parsed, err := parseRedactionPath(p)
+			if err != nil {
+				return nil, err
+			}
+			t.resultPaths[rule] = append(t.resultPaths[rule], parsed)
+		}
+	}
+	return t, nil
+}
+
+// Opened implements the TrafficLogger interface
+func (t *redactingTrafficLogger) Opened() {
+	t.next.Opened()
+}
+
+// Sent implements the TrafficLogger interface
+func (t *redactingTrafficLogger) Sent(message []byte) {
+	frame := &redactionFrame{}
+	json.Unmarshal(message, frame)
+	logged := t.isLogged(frame.Method)
+	t.mutex.Lock()
+	if logged {
+		t.pendingMethods[frame.ID] = frame.Method
+	} else {
+		t.droppedIDs[frame.ID] = true
+	}
+	t.mutex.Unlock()
+	if logged {
+		t.next.Sent(t.redact(message, frame.Method, "params", t.paramPaths, RedactedValue))
+	}
+}
+
+// Received implements the TrafficLogger interface
+func (t *redactingTrafficLogger) Received(message []byte) {
+	frame := &redactionFrame{}
+	json.Unmarshal(message, frame)
+	if frame.Method != "" {
+		// A notification
+		if t.isLogged(frame.Method) {
+			t.next.Received(t.redact(message, frame.Method, "params", t.paramPaths, RedactedValue))
+		}
+		return
+	}
+	t.mutex.Lock()
+	method, dropped := t.pendingMethods[frame.ID], t.droppedIDs[frame.ID]
+	delete(t.pendingMethods, frame.ID)
+	delete(t.droppedIDs, frame.ID)
+	t.mutex.Unlock()
+	if !dropped {
+		t.next.Received(t.redact(message, method, "result", t.resultPaths, nil))
+	}
+}
+
+// Closed implements the TrafficLogger interface
+func (t *redactingTrafficLogger) Closed() {
+	t.next.Closed()
+}
+
+func (t *redactingTrafficLogger) isLogged(method string) bool {
+	if method == "" {
+		return true
+	}
+	if len(t.options.IncludeMethods) > 0 && !matchesAnyMethodPattern(t.options.IncludeMethods, method) {
+		return false
+	}
+	return !matchesAnyMethodPattern(t.options.ExcludeMethods, method)
+}
+
+func matchesAnyMethodPattern(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, method); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// redact applies the paths of all rules matching the method to the member (params or result) of the message.
+// A nil replacement means that values are replaced by empty values of the same type.
+func (t *redactingTrafficLogger) redact(message []byte, method string, member string, paths map[*RedactionRule][]redactionPath, replacement interface{}) []byte {
+	if method == "" {
+		return message
+	}
+	var applicable []redactionPath
+	for i := range t.options.Rules {
+		rule := &t.options.Rules[i]
+		if matched, _ := path.Match(rule.Method, method); matched {
+			applicable = append(applicable, paths[rule]...)
+		}
+	}
+	if len(applicable) == 0 {
+		return message
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	envelope := map[string]interface{}{}
+	if err := decoder.Decode(&envelope); err != nil {
+		return message
+	}
+	value, ok := envelope[member]
+	if !ok {
+		return message
+	}
+	replace := func(v interface{}) interface{} {
+		if replacement != nil {
+			return replacement
+		}
+		return emptyJSONValue(v)
+	}
+	changed := false
+	for _, p := range applicable {
+		var c bool
+		value, c = p.apply(value, replace)
+		changed = changed || c
+	}
+	if !changed {
+		return message
+	}
+	envelope[member] = value
+	redacted, err := marshal(envelope)
+	if err != nil {
+		return message
+	}
+	return redacted
+}
+
+// emptyJSONValue returns an empty value of the same JSON type as the supplied value
+func emptyJSONValue(v interface{}) interface{} {
+	switch v.(type) {
+	case string:
+		return RedactedValue
+	case json.Number:
+		return json.Number("0")
+	case bool:
+		return false
+	case []interface{}:
+		return []interface{}{}
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	default:
+		return v
+	}
+}
+
+func parseRedactionPath(p string) (redactionPath, error) {
+	if !strings.HasPrefix(p, "$") {
+		return nil, fmt.Errorf("redaction path %q must start with $", p)
+	}
+	result := redactionPath{}
+	rest := p[1:]
+	for len(rest) > 0 {
+		segment := redactionPathSegment{}
+		switch {
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [ in redaction path %q", p)
+			}
+			if inner := rest[1:end]; inner == "*" {
+				segment.wildcard = true
+			} else {
+				index, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %q in redaction path %q", inner, p)
+				}
+				segment.index = index
+			}
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "."):
+			if strings.HasPrefix(rest, "..") {
+				segment.descendant = true
+				rest = rest[2:]
+			} else {
+				rest = rest[1:]
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			segment.name = rest[:end]
+			if segment.name == "" {
+				return nil, fmt.Errorf("empty name in redaction path %q", p)
+			}
+			segment.wildcard = segment.name == "*" && !segment.descendant
+			rest = rest[end:]
+		default:
+			return nil, fmt.Errorf("unexpected %q in redaction path %q", rest, p)
+		}
+		result = append(result, segment)
+	}
+	return result, nil
+}
+
+// apply replaces the values selected by the path and reports whether anything was replaced
+func (p redactionPath) apply(node interface{}, replace func(interface{}) interface{}) (interface{}, bool) {
+	if len(p) == 0 {
+		return replace(node), true
+	}
+	segment, rest := p[0], p[1:]
+	changed := false
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, child := range n {
+			var c bool
+			switch {
+			case segment.descendant && key == segment.name:
+				n[key], c = rest.apply(child, replace)
+			case segment.descendant:
+				n[key], c = p.apply(child, replace)
+			case segment.wildcard || (segment.name != "" && key == segment.name):
+				n[key], c = rest.apply(child, replace)
+			}
+			changed = changed || c
+		}
+	case []interface{}:
+		for i, child := range n {
+			var c bool
+			switch {
+			case segment.descendant:
+				n[i], c = p.apply(child, replace)
+			case segment.wildcard || (segment.name == "" && i == segment.index):
+				n[i], c = rest.apply(child, replace)
+			}
+			changed = changed || c
+		}
+	}
+	return node, changed
+}
@@ -0,0 +1,94 @@
+package enigma
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingTrafficLogger struct {
+	sent     []string
+	received []string
+}
+
+func (r *recordingTrafficLogger) Opened() {}
+
+func (r *recordingTrafficLogger) Sent(message []byte) {
+	r.sent = append(r.sent, string(message))
+}
+
+func (r *recordingTrafficLogger) Received(message []byte) {
+	r.received = append(r.received, string(message))
+}
+
+func (r *recordingTrafficLogger) Closed() {}
+
+func TestRedactingTrafficLoggerDefaultRules(t *testing.T) {
+	recorder := &recordingTrafficLogger{}
+	logger, err := NewRedactingTrafficLogger(recorder, RedactionOptions{Rules: DefaultRedactionRules})
+	assert.NoError(t, err)
+
+	logger.Sent([]byte(`{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":["app","user","token",""]}`))
+	logger.Received([]byte(`{"jsonrpc":"2.0","id":1,"result":{"qReturn":{"qHandle":1}}}`))
+	logger.Sent([]byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":2,"id":2,"params":[]}`))
+	logger.Received([]byte(`{"jsonrpc":"2.0","id":2,"result":{"qLayout":{"qHyperCube":{"qSize":{"qcx":2},"qDataPages":[{"qMatrix":[]}]},"qSelectionInfo":{"qInSelections":true}}}}`))
+
+	assert.JSONEq(t, `{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":["app","user","***REDACTED***","***REDACTED***"]}`, recorder.sent[0])
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":{"qReturn":{"qHandle":1}}}`, recorder.received[0])
+	assert.Equal(t, `{"jsonrpc":"2.0","method":"GetLayout","handle":2,"id":2,"params":[]}`, recorder.sent[1])
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":2,"result":{"qLayout":{"qHyperCube":{"qSize":{"qcx":2},"qDataPages":[]},"qSelectionInfo":{"qInSelections":true}}}}`, recorder.received[1])
+}
+
+func TestRedactingTrafficLoggerTypePreservingResults(t *testing.T) {
+	recorder := &recordingTrafficLogger{}
+	logger, err := NewRedactingTrafficLogger(recorder, RedactionOptions{Rules: []RedactionRule{
+		{Method: "GetProperties", ResultPaths: []string{"$.qProp.title", "$.qProp.count", "$.qProp.flags[*]", "$.qProp.nested.*"}},
+	}})
+	assert.NoError(t, err)
+	logger.Sent([]byte(`{"method":"GetProperties","handle":1,"id":3,"params":[]}`))
+	logger.Received([]byte(`{"id":3,"result":{"qProp":{"title":"secret","count":12,"flags":[true,false],"nested":{"a":{"b":1},"c":[1]},"kept":1.50}}}`))
+	assert.JSONEq(t, `{"id":3,"result":{"qProp":{"title":"***REDACTED***","count":0,"flags":[false,false],"nested":{"a":{},"c":[]},"kept":1.50}}}`, recorder.received[0])
+}
+
+func TestRedactingTrafficLoggerMethodFilters(t *testing.T) {
+	recorder := &recordingTrafficLogger{}
+	logger, err := NewRedactingTrafficLogger(recorder, RedactionOptions{ExcludeMethods: []string{"GetProgress"}})
+	assert.NoError(t, err)
+	logger.Sent([]byte(`{"method":"GetProgress","handle":-1,"id":1,"params":[0]}`))
+	logger.Sent([]byte(`{"method":"GetLayout","handle":1,"id":2,"params":[]}`))
+	logger.Received([]byte(`{"id":1,"result":{}}`))
+	logger.Received([]byte(`{"id":2,"result":{}}`))
+	logger.Received([]byte(`{"method":"OnConnected","params":{}}`))
+	assert.Equal(t, []string{`{"method":"GetLayout","handle":1,"id":2,"params":[]}`}, recorder.sent)
+	assert.Equal(t, []string{`{"id":2,"result":{}}`, `{"method":"OnConnected","params":{}}`}, recorder.received)
+
+	recorder = &recordingTrafficLogger{}
+	logger, err = NewRedactingTrafficLogger(recorder, RedactionOptions{IncludeMethods: []string{"Get*"}})
+	assert.NoError(t, err)
+	logger.Sent([]byte(`{"method":"SetScript","handle":1,"id":1,"params":["x"]}`))
+	logger.Received([]byte(`{"id":1,"result":{}}`))
+	logger.Received([]byte(`{"method":"OnConnected","params":{}}`))
+	assert.Empty(t, recorder.sent)
+	assert.Empty(t, recorder.received)
+}
+
+func TestRedactionPathErrors(t *testing.T) {
+	for _, p := range []string{"qScript", "$[x]", "$[0", "$.", "$a"} {
+		_, err := parseRedactionPath(p)
+		assert.Error(t, err, p)
+	}
+	_, err := NewRedactingTrafficLogger(&recordingTrafficLogger{}, RedactionOptions{Rules: []RedactionRule{{Method: "*", ParamPaths: []string{"bad"}}}})
+	assert.Error(t, err)
+}
+
+func TestMockSocketMatchesRedactedRequests(t *testing.T) {
+	matches := func(expected string, actual string) bool {
+		matched, err := requestMatches([]byte(expected), []byte(actual))
+		assert.NoError(t, err)
+		return matched
+	}
+	assert.True(t, matches(`{"params":["app","***REDACTED***"],"id":1}`, `{"id":1,"params":["app","token"]}`))
+	assert.True(t, matches(`{"params":[{"qConnectionString":"***REDACTED***"}]}`, `{"params":[{"qConnectionString":"x"}]}`))
+	assert.False(t, matches(`{"params":["other","***REDACTED***"],"id":1}`, `{"id":1,"params":["app","token"]}`))
+	assert.False(t, matches(`{"params":["app","***REDACTED***"]}`, `{"params":["app"]}`))
+}
@@ -0,0 +1,478 @@
+package enigma
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"time"
+)
+
+type (
+	// TrafficTimeline is a traffic log where every request has been paired with its response
+	TrafficTimeline struct {
+		// Start is the time of the first frame in the log. It is zero for logs without timestamps.
+		Start time.Time
+		// Calls lists the requests in the order they were sent
+		Calls []*TrafficCall
+		// Notifications lists notifications and pushed change lists in the order they were received
+		Notifications []*TrafficNotification
+	}
+
+	// TrafficCall is a request and its response
+	TrafficCall struct {
+		SessionID    string
+		ID           int
+		Method       string
+		Handle       int
+		Request      json.RawMessage
+		Response     json.RawMessage
+		Sent         time.Time
+		Received     time.Time
+		RequestSize  int
+		ResponseSize int
+		Changed      []int
+		Closed       []int
+		// Error is the error message of a failed call
+		Error string
+	}
+
+	// TrafficNotification is a frame received without a request, either a notification or a pushed change list
+	TrafficNotification struct {
+		SessionID string
+		Method    string
+		Params    json.RawMessage
+		Received  time.Time
+		Size      int
+		Changed   []int
+		Closed    []int
+	}
+
+	// TrafficTimelineFilter selects the calls and notifications to keep in a TrafficTimeline
+	TrafficTimelineFilter struct {
+		// Handles keeps the calls on the listed handles only. Empty keeps all handles.
+		Handles []int
+		// Methods keeps calls and notifications with a name matching one of the patterns (path.Match syntax) only. Empty keeps all methods.
+		Methods []string
+	}
+
+	trafficFrame struct {
+		Method string          `json:"method"`
+		Handle int             `json:"handle"`
+		ID     int             `json:"id"`
+		Params json.RawMessage `json:"params"`
+		Error  *qixError       `json:"error"`
+		rpcStatusInfo
+	}
+
+	trafficCallKey struct {
+		sessionID string
+		id        int
+	}
+)
+
+// Latency returns the time between sending the request and receiving the response. It is zero
+// for calls without a response and for logs without timestamps.
+func (c *TrafficCall) Latency() time.Duration {
+	if c.Sent.IsZero() || c.Received.IsZero() {
+		return 0
+	}
+	return c.Received.Sub(c.Sent)
+}
+
+// ReadTrafficTimeline reads a traffic log in any of the formats written by the Dialer and pairs requests with responses.
+// Only logs in the JSON Lines format contain timestamps.
+func ReadTrafficTimeline(fileName string) (*TrafficTimeline, error) {
+	rows, err := readTrafficLog(fileName)
+	if err != nil {
+		return nil, err
+	}
+	timeline := &TrafficTimeline{Calls: []*TrafficCall{}, Notifications: []*TrafficNotification{}}
+	pending := make(map[trafficCallKey]*TrafficCall)
+	for _, row := range rows {
+		if timeline.Start.IsZero() {
+			timeline.Start = row.Timestamp
+		}
+		message, size := row.Sent, row.Size
+		if message == nil {
+			message = row.Received
+		}
+		if size == 0 {
+			size = len(message)
+		}
+		frame := &trafficFrame{}
+		if err := json.Unmarshal(message, frame); err != nil {
+			return nil, err
+		}
+		key := trafficCallKey{sessionID: row.SessionID, id: frame.ID}
+		switch {
+		case row.Sent != nil:
+			call := &TrafficCall{
+				SessionID:   row.SessionID,
+				ID:          frame.ID,
+				Method:      frame.Method,
+				Handle:      frame.Handle,
+				Request:     row.Sent,
+				Sent:        row.Timestamp,
+				RequestSize: size,
+			}
+			timeline.Calls = append(timeline.Calls, call)
+			pending[key] = call
+		case frame.Method == "" && pending[key] != nil && frame.ID != 0:
+			call := pending[key]
+			delete(pending, key)
+			call.Response = row.Received
+			call.Received = row.Timestamp
+			call.ResponseSize = size
+			call.Changed = frame.Change
+			call.Closed = frame.Close
+			if frame.Error != nil {
+				call.Error = frame.Error.Error()
+			}
+		default:
+			timeline.Notifications = append(timeline.Notifications, &TrafficNotification{
+				SessionID: row.SessionID,
+				Method:    frame.Method,
+				Params:    frame.Params,
+				Received:  row.Timestamp,
+				Size:      size,
+				Changed:   frame.Change,
+				Closed:    frame.Close,
+			})
+		}
+	}
+	return timeline, nil
+}
+
+// Filter returns a new timeline with the calls and notifications selected by the filter.
+// Notifications are not bound to a handle so the Handles filter only applies to calls.
+func (t *TrafficTimeline) Filter(filter TrafficTimelineFilter) *TrafficTimeline {
+	result := &TrafficTimeline{Start: t.Start, Calls: []*TrafficCall{}, Notifications: []*TrafficNotification{}}
+	for _, call := range t.Calls {
+		if filter.matchesHandle(call.Handle) && filter.matchesMethod(call.Method) {
+			result.Calls = append(result.Calls, call)
+		}
+	}
+	for _, notification := range t.Notifications {
+		if filter.matchesMethod(notification.Method) {
+			result.Notifications = append(result.Notifications, notification)
+		}
+	}
+	return result
+}
+
+func (f TrafficTimelineFilter) matchesHandle(handle int) bool {
+	if len(f.Handles) == 0 {
+		return true
+	}
+	for _, h := range f.Handles {
+		if h == handle {
+			return true
+		}
+	}
+	return false
+}
+
+func (f TrafficTimelineFilter) matchesMethod(method string) bool {
+	return len(f.Methods) == 0 || matchesAnyMethodPattern(f.Methods, method)
+}
+
+type (
+	harFile struct {
+		Log harLog `json:"log"`
+	}
+
+	harLog struct {
+		Version       string                 `json:"version"`
+		Creator       harCreator             `json:"creator"`
+		Entries       []harEntry             `json:"entries"`
+		Notifications []*TrafficNotification `json:"_notifications"`
+	}
+
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+		SessionID       string      `json:"_sessionId,omitempty"`
+		RequestID       int         `json:"_requestId"`
+		Changed         []int       `json:"_changed,omitempty"`
+		Closed          []int       `json:"_closed,omitempty"`
+	}
+
+	harRequest struct {
+		Method      string        `json:"method"`
+		URL         string        `json:"url"`
+		HTTPVersion string        `json:"httpVersion"`
+		Cookies     []interface{} `json:"cookies"`
+		Headers     []interface{} `json:"headers"`
+		QueryString []interface{} `json:"queryString"`
+		PostData    harContent    `json:"postData"`
+		HeadersSize int           `json:"headersSize"`
+		BodySize    int           `json:"bodySize"`
+	}
+
+	harResponse struct {
+		Status      int           `json:"status"`
+		StatusText  string        `json:"statusText"`
+		HTTPVersion string        `json:"httpVersion"`
+		Cookies     []interface{} `json:"cookies"`
+		Headers     []interface{} `json:"headers"`
+		Content     harContent    `json:"content"`
+		RedirectURL string        `json:"redirectURL"`
+		HeadersSize int           `json:"headersSize"`
+		BodySize    int           `json:"bodySize"`
+	}
+
+	harContent struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// WriteHAR writes the timeline as a HAR 1.2 file. Every call becomes an entry with a qix://handle/method url,
+// the request as post data and the response as content. Failed calls get status 500 and calls without
+// a response status 0. Change and close lists and notifications are stored in custom underscore fields.
+func (t *TrafficTimeline) WriteHAR(w io.Writer) error {
+	har := harFile{Log: harLog{
+		Version:       "1.2",
+		Creator:       harCreator{Name: "enigma-go", Version: "4"},
+		Entries:       []harEntry{},
+		Notifications: t.Notifications,
+	}}
+	for _, call := range t.Calls {
+		status := 200
+		if call.Response == nil {
+			status = 0
+		} else if call.Error != "" {
+			status = 500
+		}
+		latency := milliseconds(call.Latency())
+		har.Log.Entries = append(har.Log.Entries, harEntry{
+			StartedDateTime: call.Sent.Format(time.RFC3339Nano),
+			Time:            latency,
+			Request: harRequest{
+				Method:      "POST",
+				URL:         fmt.Sprintf("qix://%d/%s", call.Handle, call.Method),
+				HTTPVersion: "JSON-RPC/2.0",
+				Cookies:     []interface{}{},
+				Headers:     []interface{}{},
+				QueryString: []interface{}{},
+				PostData:    harContent{Size: call.RequestSize, MimeType: "application/json", Text: string(call.Request)},
+				HeadersSize: -1,
+				BodySize:    call.RequestSize,
+			},
+			Response: harResponse{
+				Status:      status,
+				StatusText:  call.Error,
+				HTTPVersion: "JSON-RPC/2.0",
+				Cookies:     []interface{}{},
+				Headers:     []interface{}{},
+				Content:     harContent{Size: call.ResponseSize, MimeType: "application/json", Text: string(call.Response)},
+				HeadersSize: -1,
+				BodySize:    call.ResponseSize,
+			},
+			Timings:   harTimings{Wait: latency},
+			SessionID: call.SessionID,
+			RequestID: call.ID,
+			Changed:   call.Changed,
+			Closed:    call.Closed,
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(har)
+}
+
+type (
+	timelineReport struct {
+		Start    string
+		Duration string
+		Rows     []timelineRow
+		Handles  []int
+		Methods  []string
+	}
+
+	timelineRow struct {
+		Notification bool
+		Offset       string
+		Method       string
+		Handle       string
+		ID           int
+		Latency      string
+		LeftPercent  float64
+		WidthPercent float64
+		RequestSize  int
+		ResponseSize int
+		Changed      int
+		Closed       int
+		Error        string
+		Request      string
+		Response     string
+	}
+)
+
+// WriteHTML writes the timeline as a self-contained HTML page with one row per call and notification. Calls show
+// their latency as a bar on a common time axis together with payload sizes and change list fan-out. The page
+// contains filters by handle and method.
+func (t *TrafficTimeline) WriteHTML(w io.Writer) error {
+	report := timelineReport{}
+	end := t.Start
+	for _, call := range t.Calls {
+		if call.Received.After(end) {
+			end = call.Received
+		}
+	}
+	for _, notification := range t.Notifications {
+		if notification.Received.After(end) {
+			end = notification.Received
+		}
+	}
+	span := end.Sub(t.Start)
+	percent := func(d time.Duration) float64 {
+		if span <= 0 {
+			return 0
+		}
+		return 100 * float64(d) / float64(span)
+	}
+	offset := func(at time.Time) time.Duration {
+		if at.IsZero() || t.Start.IsZero() {
+			return 0
+		}
+		return at.Sub(t.Start)
+	}
+	if !t.Start.IsZero() {
+		report.Start = t.Start.Format(time.RFC3339Nano)
+	}
+	report.Duration = span.String()
+
+	handles := map[int]bool{}
+	methods := map[string]bool{}
+	calls, notifications := t.Calls, t.Notifications
+	// Merge calls and notifications in time order, notifications without timestamps go last
+	for len(calls) > 0 || len(notifications) > 0 {
+		if len(notifications) == 0 || (len(calls) > 0 && !notifications[0].Received.Before(calls[0].Sent)) {
+			call := calls[0]
+			calls = calls[1:]
+			handles[call.Handle] = true
+			methods[call.Method] = true
+			report.Rows = append(report.Rows, timelineRow{
+				Offset:       offset(call.Sent).String(),
+				Method:       call.Method,
+				Handle:       fmt.Sprint(call.Handle),
+				ID:           call.ID,
+				Latency:      call.Latency().String(),
+				LeftPercent:  percent(offset(call.Sent)),
+				WidthPercent: percent(call.Latency()),
+				RequestSize:  call.RequestSize,
+				ResponseSize: call.ResponseSize,
+				Changed:      len(call.Changed),
+				Closed:       len(call.Closed),
+				Error:        call.Error,
+				Request:      string(call.Request),
+				Response:     string(call.Response),
+			})
+		} else {
+			notification := notifications[0]
+			notifications = notifications[1:]
+			method := notification.Method
+			if method == "" {
+				method = "(change list)"
+			}
+			methods[method] = true
+			report.Rows = append(report.Rows, timelineRow{
+				Notification: true,
+				Offset:       offset(notification.Received).String(),
+				Method:       method,
+				LeftPercent:  percent(offset(notification.Received)),
+				ResponseSize: notification.Size,
+				Changed:      len(notification.Changed),
+				Closed:       len(notification.Closed),
+				Response:     string(notification.Params),
+			})
+		}
+	}
+	for handle := range handles {
+		report.Handles = append(report.Handles, handle)
+	}
+	for method := range methods {
+		report.Methods = append(report.Methods, method)
+	}
+	sort.Ints(report.Handles)
+	sort.Strings(report.Methods)
+	return timelineTemplate.Execute(w, report)
+}
+
+var timelineTemplate = template.Must(template.New("timeline").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>enigma-go traffic timeline</title>
+<style>
+body { font-family: sans-serif; font-size: 13px; margin: 16px; }
+table { border-collapse: collapse; width: 100%; }
+th, td { text-align: left; padding: 2px 6px; border-bottom: 1px solid #eee; white-space: nowrap; }
+td.bar { width: 40%; position: relative; }
+td.bar div { position: absolute; top: 4px; height: 10px; min-width: 2px; background: #4477aa; }
+tr.notification td { color: #777; }
+tr.notification td.bar div { background: #ccbb44; }
+tr.error td { color: #cc3311; }
+details pre { white-space: pre-wrap; max-width: 800px; max-height: 300px; overflow: auto; }
+</style>
+</head>
+<body>
+<h1>Traffic timeline</h1>
+<p>Start {{if .Start}}{{.Start}}{{else}}unknown{{end}}, duration {{.Duration}}</p>
+<p>
+<label>Handle <select id="handle"><option value="">all</option>{{range .Handles}}<option>{{.}}</option>{{end}}</select></label>
+<label>Method <select id="method"><option value="">all</option>{{range .Methods}}<option>{{.}}</option>{{end}}</select></label>
+<label><input type="checkbox" id="notifications" checked> Notifications</label>
+</p>
+<table>
+<thead><tr><th>Offset</th><th>Method</th><th>Handle</th><th>Id</th><th>Latency</th><th>Timeline</th><th>Request bytes</th><th>Response bytes</th><th>Changed</th><th>Closed</th><th>Payload</th></tr></thead>
+<tbody>
+{{range .Rows}}<tr class="{{if .Notification}}notification{{end}}{{if .Error}} error{{end}}" data-handle="{{.Handle}}" data-method="{{.Method}}">
+<td>{{.Offset}}</td><td>{{.Method}}</td><td>{{.Handle}}</td><td>{{if not .Notification}}{{.ID}}{{end}}</td><td>{{if not .Notification}}{{.Latency}}{{end}}</td>
+<td class="bar"><div style="left: {{printf "%.3f" .LeftPercent}}%; width: {{printf "%.3f" .WidthPercent}}%"></div></td>
+<td>{{if not .Notification}}{{.RequestSize}}{{end}}</td><td>{{.ResponseSize}}</td><td>{{.Changed}}</td><td>{{.Closed}}</td>
+<td><details><summary>{{if .Error}}{{.Error}}{{else}}show{{end}}</summary>{{if .Request}}<pre>{{.Request}}</pre>{{end}}<pre>{{.Response}}</pre></details></td>
+</tr>
+{{end}}</tbody>
+</table>
+<script>
+function applyFilters() {
+	var handle = document.getElementById("handle").value;
+	var method = document.getElementById("method").value;
+	var notifications = document.getElementById("notifications").checked;
+	document.querySelectorAll("tbody tr").forEach(function (row) {
+		var isNotification = row.classList.contains("notification");
+		var visible = (!handle || row.dataset.handle === handle) && (!method || row.dataset.method === method) && (notifications || !isNotification);
+		row.style.display = visible ? "" : "none";
+	});
+}
+["handle", "method", "notifications"].forEach(function (id) {
+	document.getElementById(id).addEventListener("change", applyFilters);
+});
+</script>
+</body>
+</html>
+`))
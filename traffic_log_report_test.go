@@ -0,0 +1,94 @@
+package enigma
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTimelineTestLog(t *testing.T) string {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	rows := []trafficLogRow{
+		{Sent: json.RawMessage(`{"method":"OpenDoc","handle":-1,"id":1,"params":["app"]}`), Timestamp: start, SessionID: "s"},
+		{Received: json.RawMessage(`{"method":"OnConnected","params":{"qSessionState":"SESSION_CREATED"}}`), Timestamp: start.Add(time.Millisecond), SessionID: "s"},
+		{Received: json.RawMessage(`{"id":1,"result":{"qReturn":{"qHandle":1}},"change":[1]}`), Timestamp: start.Add(20 * time.Millisecond), SessionID: "s"},
+		{Sent: json.RawMessage(`{"method":"GetLayout","handle":1,"id":2,"params":[]}`), Timestamp: start.Add(30 * time.Millisecond), SessionID: "s"},
+		{Sent: json.RawMessage(`{"method":"Bad","handle":1,"id":3,"params":[]}`), Timestamp: start.Add(31 * time.Millisecond), SessionID: "s"},
+		{Received: json.RawMessage(`{"id":3,"error":{"code":-32601,"message":"Method not found"}}`), Timestamp: start.Add(35 * time.Millisecond), SessionID: "s"},
+		{Received: json.RawMessage(`{"id":2,"result":{},"change":[1,2,3]}`), Timestamp: start.Add(40 * time.Millisecond), SessionID: "s"},
+	}
+	fileName := filepath.Join(t.TempDir(), "traffic.jsonl")
+	buffer := &bytes.Buffer{}
+	for _, row := range rows {
+		line, _ := json.Marshal(row)
+		buffer.Write(append(line, '\n'))
+	}
+	assert.NoError(t, os.WriteFile(fileName, buffer.Bytes(), 0644))
+	return fileName
+}
+
+func TestReadTrafficTimeline(t *testing.T) {
+	timeline, err := ReadTrafficTimeline(writeTimelineTestLog(t))
+	assert.NoError(t, err)
+	assert.Len(t, timeline.Calls, 3)
+	assert.Len(t, timeline.Notifications, 1)
+	assert.Equal(t, "OpenDoc", timeline.Calls[0].Method)
+	assert.Equal(t, 20*time.Millisecond, timeline.Calls[0].Latency())
+	assert.Equal(t, []int{1}, timeline.Calls[0].Changed)
+	assert.Equal(t, 10*time.Millisecond, timeline.Calls[1].Latency())
+	assert.Equal(t, []int{1, 2, 3}, timeline.Calls[1].Changed)
+	assert.Contains(t, timeline.Calls[2].Error, "Method not found")
+	assert.Equal(t, "OnConnected", timeline.Notifications[0].Method)
+
+	filtered := timeline.Filter(TrafficTimelineFilter{Handles: []int{1}, Methods: []string{"Get*"}})
+	assert.Len(t, filtered.Calls, 1)
+	assert.Equal(t, "GetLayout", filtered.Calls[0].Method)
+	assert.Empty(t, filtered.Notifications)
+}
+
+func TestTrafficTimelineWriteHAR(t *testing.T) {
+	timeline, err := ReadTrafficTimeline(writeTimelineTestLog(t))
+	assert.NoError(t, err)
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, timeline.WriteHAR(buffer))
+
+	har := harFile{}
+	assert.NoError(t, json.Unmarshal(buffer.Bytes(), &har))
+	assert.Equal(t, "1.2", har.Log.Version)
+	assert.Len(t, har.Log.Entries, 3)
+	assert.Equal(t, "qix://1/GetLayout", har.Log.Entries[1].Request.URL)
+	assert.Equal(t, 10.0, har.Log.Entries[1].Time)
+	assert.Equal(t, 200, har.Log.Entries[1].Response.Status)
+	assert.Equal(t, 500, har.Log.Entries[2].Response.Status)
+	assert.Len(t, har.Log.Notifications, 1)
+}
+
+func TestTrafficTimelineWriteHTML(t *testing.T) {
+	timeline, err := ReadTrafficTimeline(writeTimelineTestLog(t))
+	assert.NoError(t, err)
+	buffer := &bytes.Buffer{}
+	assert.NoError(t, timeline.WriteHTML(buffer))
+	html := buffer.String()
+	assert.True(t, strings.HasPrefix(html, "<!DOCTYPE html>"))
+	assert.Contains(t, html, `data-method="GetLayout"`)
+	assert.Contains(t, html, `class="notification"`)
+	assert.Contains(t, html, "<option>OnConnected</option>")
+	assert.Contains(t, html, "left: 75.000%; width: 25.000%")
+}
+
+func TestReadTrafficTimelineWithoutTimestamps(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "traffic.log")
+	assert.NoError(t, os.WriteFile(fileName, []byte(`[{"Sent":{"method":"GetLayout","handle":1,"id":1}},{"Received":{"id":1,"result":{}}}]`), 0644))
+	timeline, err := ReadTrafficTimeline(fileName)
+	assert.NoError(t, err)
+	assert.Len(t, timeline.Calls, 1)
+	assert.Equal(t, time.Duration(0), timeline.Calls[0].Latency())
+	assert.NoError(t, timeline.WriteHTML(&bytes.Buffer{}))
+	assert.NoError(t, timeline.WriteHAR(&bytes.Buffer{}))
+}
@@ -0,0 +1,119 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type (
+	// ValidationError tells which value does not match the schema. Path is made of the names of the properties in the
+	// schema, for instance qHyperCubeDef.qDimensions[0].qDef.qSortCriterias[1].qSortByState. Errors returned by the
+	// validation interceptor start with the method and parameter, for instance GenericObject.SetProperties(qProp).
+	ValidationError struct {
+		Path    string
+		Message string
+	}
+
+	// ParamSchema describes a parameter of a method in the schema
+	ParamSchema struct {
+		Name     string
+		Required bool
+	}
+
+	validator interface {
+		Validate() error
+	}
+)
+
+func (err *ValidationError) Error() string {
+	if err.Path == "" {
+		return err.Message
+	}
+	return err.Path + ": " + err.Message
+}
+
+// NewValidationInterceptor creates an interceptor that checks the params of invocations, positional or named, before
+// sending them. Required params must not be nil and params with a Validate method, such as *GenericObjectProperties,
+// must be valid. Invalid invocations fail with a *ValidationError. The params of the methods are keyed by object type
+// and method name, for instance "GenericObject.SetProperties". If methodParams is nil the generated MethodParams are
+// used.
+func NewValidationInterceptor(methodParams map[string][]ParamSchema) Interceptor {
+	if methodParams == nil {
+		methodParams = MethodParams
+	}
+	return func(ctx context.Context, invocation *Invocation, next InterceptorContinuation) *InvocationResponse {
+		var params []ParamSchema
+		method := invocation.Method
+		if invocation.RemoteObject != nil && invocation.RemoteObject.ObjectInterface != nil {
+			method = invocation.RemoteObject.Type + "." + invocation.Method
+			params = methodParams[method]
+		}
+		if invocation.NamedParams != nil {
+			for _, param := range params {
+				if param.Required && isNilParam(invocation.NamedParams[param.Name]) {
+					return &InvocationResponse{Error: &ValidationError{Path: method + "(" + param.Name + ")", Message: "required parameter is missing"}}
+				}
+			}
+			for name, param := range invocation.NamedParams {
+				if err := validateParam(method+"("+name+")", reflect.ValueOf(param)); err != nil {
+					return &InvocationResponse{Error: err}
+				}
+			}
+			return next(ctx, invocation)
+		}
+		for i, param := range invocation.Params {
+			name := fmt.Sprint(i)
+			if i < len(params) {
+				name = params[i].Name
+			}
+			path := method + "(" + name + ")"
+			if i < len(params) && params[i].Required && isNilParam(param) {
+				return &InvocationResponse{Error: &ValidationError{Path: path, Message: "required parameter is missing"}}
+			}
+			if err := validateParam(path, reflect.ValueOf(param)); err != nil {
+				return &InvocationResponse{Error: err}
+			}
+		}
+		return next(ctx, invocation)
+	}
+}
+
+func isNilParam(param interface{}) bool {
+	if param == nil {
+		return true
+	}
+	value := reflect.ValueOf(param)
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return value.IsNil()
+	}
+	return false
+}
+
+// validateParam validates values with a Validate method and the items of slices of them
+func validateParam(path string, value reflect.Value) error {
+	if !value.IsValid() {
+		return nil
+	}
+	if v, ok := value.Interface().(validator); ok {
+		if value.Kind() == reflect.Ptr && value.IsNil() {
+			return nil
+		}
+		err := v.Validate()
+		var validationError *ValidationError
+		if errors.As(err, &validationError) {
+			return &ValidationError{Path: path + "." + validationError.Path, Message: validationError.Message}
+		}
+		return err
+	}
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8 {
+		for i := 0; i < value.Len(); i++ {
+			if err := validateParam(fmt.Sprintf("%s[%d]", path, i), value.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package enigma
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	properties := &GenericObjectProperties{
+		Info: &NxInfo{Type: "table"},
+		HyperCubeDef: &HyperCubeDef{
+			Mode: NxHypercubeModeStraight,
+			Dimensions: []*NxDimension{{
+				Def: &NxInlineDimensionDef{FieldDefs: []string{"Country"}, SortCriterias: []*SortCriteria{{SortByAscii: 1}, {SortByState: 300}}},
+			}},
+		},
+	}
+	err := properties.Validate()
+	validationError := &ValidationError{}
+	assert.True(t, errors.As(err, &validationError))
+	assert.Equal(t, "qHyperCubeDef.qDimensions[0].qDef.qSortCriterias[1].qSortByState: 300 is out of range [-128, 127]", err.Error())
+
+	properties.HyperCubeDef.Dimensions[0].Def.SortCriterias[1].SortByState = -1
+	assert.NoError(t, properties.Validate())
+
+	properties.HyperCubeDef.Mode = "X"
+	assert.EqualError(t, properties.Validate(), `qHyperCubeDef.qMode: unknown value "X"`)
+
+	// Empty enums are left out of the request and nil structs are valid
+	properties.HyperCubeDef.Mode = ""
+	assert.NoError(t, properties.Validate())
+	assert.NoError(t, (*GenericObjectProperties)(nil).Validate())
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	ctx := context.Background()
+	var calls int
+	fake := NewFakeEngine().
+		On("Global", "OpenDoc", func(call *FakeCall) (interface{}, error) {
+			return map[string]interface{}{"qReturn": call.NewObject("Doc", "", "app")}, nil
+		}).
+		On("Doc", "CreateSessionObject", func(call *FakeCall) (interface{}, error) {
+			calls++
+			return map[string]interface{}{"qReturn": call.NewObject("GenericObject", "table", "table")}, nil
+		}).
+		On("GenericObject", "ApplyPatches", func(call *FakeCall) (interface{}, error) {
+			calls++
+			return nil, nil
+		})
+	global, err := Dialer{CreateSocket: fake.CreateSocket, Interceptors: []Interceptor{NewValidationInterceptor(nil)}}.Dial(ctx, "", nil)
+	assert.NoError(t, err)
+	defer global.DisconnectFromServer()
+	doc, err := global.OpenDoc(ctx, "app", "", "", "", false)
+	assert.NoError(t, err)
+
+	_, err = doc.CreateSessionObject(ctx, nil)
+	assert.EqualError(t, err, "Doc.CreateSessionObject(qProp): required parameter is missing")
+
+	_, err = doc.CreateSessionObject(ctx, &GenericObjectProperties{Info: &NxInfo{Type: "table"}, HyperCubeDef: &HyperCubeDef{Mode: "X"}})
+	assert.EqualError(t, err, `Doc.CreateSessionObject(qProp).qHyperCubeDef.qMode: unknown value "X"`)
+	_, err = CallNamed[*ObjectInterface](ctx, doc.RemoteObject, "CreateSessionObject", map[string]interface{}{})
+	assert.EqualError(t, err, "Doc.CreateSessionObject(qProp): required parameter is missing")
+	_, err = CallNamed[*ObjectInterface](ctx, doc.RemoteObject, "CreateSessionObject", map[string]interface{}{"qProp": &GenericObjectProperties{HyperCubeDef: &HyperCubeDef{Mode: "X"}}})
+	assert.EqualError(t, err, `Doc.CreateSessionObject(qProp).qHyperCubeDef.qMode: unknown value "X"`)
+	assert.Equal(t, 0, calls)
+
+	object, err := doc.CreateSessionObject(ctx, &GenericObjectProperties{Info: &NxInfo{Type: "table"}})
+	assert.NoError(t, err)
+
+	err = object.ApplyPatches(ctx, []*NxPatch{{Op: NxPatchOperationTypeReplace, Path: "/title", Value: `"A"`}, {Op: "Move", Path: "/title"}}, false)
+	assert.EqualError(t, err, `GenericObject.ApplyPatches(qPatches)[1].qOp: unknown value "Move"`)
+	assert.Equal(t, 1, calls)
+}
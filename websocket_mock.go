@@ -5,101 +5,534 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
 )
 
 type (
 	// MockSocket provides a dummy implementation of the Socket interface.
 	MockSocket struct {
-		expectedRequests chan *mocksocketRequest
+		options          MockSocketOptions
+		ignoredPaths     []redactionPath
+		mutex            sync.Mutex
+		expectedRequests []*mocksocketRequest
+		idMap            map[int]int
+		mismatches       []error
 		receivedMessages chan json.RawMessage
 		closed           chan struct{}
+		scheduled        []*mocksocketScheduledMessage
+		scheduleSeq      int
+		linkFreeAt       time.Time
+		wakeup           chan struct{}
+	}
+
+	// MockSocketOptions configures how a MockSocket matches sent messages against the expected requests
+	MockSocketOptions struct {
+		// IgnoreIDs matches requests regardless of their id. The ids of the recorded responses are rewritten to the
+		// ids of the requests actually sent so that replays survive shifted ids and reserved request ids.
+		IgnoreIDs bool
+
+		// Window is the number of upcoming expected requests a sent message is matched against. The first matching
+		// request is used which allows calls made from several goroutines to be reordered. Zero or one means strict order.
+		Window int
+
+		// IgnoreParamPaths lists paths into the params of requests that are left out of the comparison,
+		// using the same syntax as RedactionRule, for instance "$[0].qInfo.qId".
+		IgnoreParamPaths []string
+
+		// T, if set, gets every mismatch reported as a test failure as soon as it happens
+		T TestingT
+
+		// TimeScale, if greater than zero, delays every response by the time between the request and the response in the
+		// recording multiplied by TimeScale. Only traffic logs in the JSON Lines format contain timestamps.
+		TimeScale float64
+
+		// Latency delays every response by a fixed duration in addition to any recorded timing
+		Latency time.Duration
+
+		// Bandwidth, if greater than zero, is the simulated number of bytes per second. Responses share the bandwidth so a
+		// large response also delays the responses after it.
+		Bandwidth int
+	}
+
+	// TestingT is the subset of *testing.T used by MockSocket to report failures
+	TestingT interface {
+		Helper()
+		Errorf(format string, args ...interface{})
 	}
 
 	mocksocketRequest struct {
 		sentMessage json.RawMessage
-		responses   []json.RawMessage
+		sentAt      time.Time
+		id          int
+		responses   []mocksocketResponse
+	}
+
+	mocksocketResponse struct {
+		message    json.RawMessage
+		receivedAt time.Time
+	}
+
+	mocksocketScheduledMessage struct {
+		due     time.Time
+		seq     int
+		message json.RawMessage
+	}
+
+	mocksocketFrame struct {
+		Method string `json:"method"`
+		ID     int    `json:"id"`
 	}
 )
 
-func asCanonicalString(message json.RawMessage) string {
+func asCanonicalString(message json.RawMessage) (string, error) {
 	buffer := new(bytes.Buffer)
 	if err := json.Compact(buffer, message); err != nil {
-		fmt.Println(err)
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// invalidJSONError describes an expected request or a sent message that is not valid JSON
+func invalidJSONError(kind string, message []byte, err error) error {
+	return fmt.Errorf("mock socket: %s is not valid JSON: %s\n%s", kind, err, message)
+}
+
+// requestMatches tells whether a sent message matches an expected request. Strings equal to RedactedValue in the
+// expected request match any value so that redacted traffic logs can be replayed. Invalid JSON never matches and is
+// returned as an error.
+func requestMatches(expected json.RawMessage, actual json.RawMessage) (bool, error) {
+	expectedString, err := asCanonicalString(expected)
+	if err != nil {
+		return false, invalidJSONError("expected request", expected, err)
+	}
+	actualString, err := asCanonicalString(actual)
+	if err != nil {
+		return false, invalidJSONError("sent request", actual, err)
+	}
+	if expectedString == actualString {
+		return true, nil
+	}
+	var expectedValue, actualValue interface{}
+	if decodeJSONWithNumbers(expected, &expectedValue) != nil || decodeJSONWithNumbers(actual, &actualValue) != nil {
+		return false, nil
+	}
+	return jsonValuesMatch(expectedValue, actualValue), nil
+}
+
+func decodeJSONWithNumbers(message json.RawMessage, value *interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(message))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+func jsonValuesMatch(expected interface{}, actual interface{}) bool {
+	switch e := expected.(type) {
+	case string:
+		if e == RedactedValue {
+			return true
+		}
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for key, value := range e {
+			if actualValue, ok := a[key]; !ok || !jsonValuesMatch(value, actualValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok || len(a) != len(e) {
+			return false
+		}
+		for i := range e {
+			if !jsonValuesMatch(e[i], a[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+// matches compares a sent message with an expected request taking the options into account
+func (t *MockSocket) matches(expected json.RawMessage, actual json.RawMessage) (bool, error) {
+	if !t.options.IgnoreIDs && len(t.ignoredPaths) == 0 {
+		return requestMatches(expected, actual)
 	}
-	return buffer.String()
+	var expectedValue, actualValue interface{}
+	if err := decodeJSONWithNumbers(expected, &expectedValue); err != nil {
+		return false, invalidJSONError("expected request", expected, err)
+	}
+	if err := decodeJSONWithNumbers(actual, &actualValue); err != nil {
+		return false, invalidJSONError("sent request", actual, err)
+	}
+	for _, value := range []interface{}{expectedValue, actualValue} {
+		envelope, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t.options.IgnoreIDs {
+			delete(envelope, "id")
+		}
+		if params, ok := envelope["params"]; ok {
+			for _, p := range t.ignoredPaths {
+				params, _ = p.apply(params, func(interface{}) interface{} { return nil })
+			}
+			envelope["params"] = params
+		}
+	}
+	return jsonValuesMatch(expectedValue, actualValue), nil
+}
+
+// rewriteResponseID replaces the recorded id of a response with the id of the request actually sent
+func (t *MockSocket) rewriteResponseID(response json.RawMessage) json.RawMessage {
+	frame := &mocksocketFrame{}
+	if err := json.Unmarshal(response, frame); err != nil || frame.Method != "" || frame.ID == 0 {
+		return response
+	}
+	actualID, ok := t.idMap[frame.ID]
+	if !ok || actualID == frame.ID {
+		return response
+	}
+	envelope := map[string]json.RawMessage{}
+	if err := json.Unmarshal(response, &envelope); err != nil {
+		return response
+	}
+	envelope["id"] = json.RawMessage(fmt.Sprint(actualID))
+	rewritten, err := json.Marshal(envelope)
+	if err != nil {
+		return response
+	}
+	return rewritten
+}
+
+// jsonDiff returns a line diff between two JSON messages, both indented with sorted keys.
+// Lines only in expected are prefixed with "-" and lines only in actual with "+".
+func jsonDiff(expected json.RawMessage, actual json.RawMessage) string {
+	expectedLines := strings.Split(indentJSON(expected), "\n")
+	actualLines := strings.Split(indentJSON(actual), "\n")
+	// Longest common subsequence table, lcs[i][j] is the length for expectedLines[i:] and actualLines[j:]
+	lcs := make([][]int, len(expectedLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(actualLines)+1)
+	}
+	for i := len(expectedLines) - 1; i >= 0; i-- {
+		for j := len(actualLines) - 1; j >= 0; j-- {
+			if expectedLines[i] == actualLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	diff := &strings.Builder{}
+	i, j := 0, 0
+	for i < len(expectedLines) || j < len(actualLines) {
+		switch {
+		case i < len(expectedLines) && j < len(actualLines) && expectedLines[i] == actualLines[j]:
+			fmt.Fprintf(diff, "  %s\n", expectedLines[i])
+			i++
+			j++
+		case i < len(expectedLines) && (j == len(actualLines) || lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(diff, "- %s\n", expectedLines[i])
+			i++
+		default:
+			fmt.Fprintf(diff, "+ %s\n", actualLines[j])
+			j++
+		}
+	}
+	return diff.String()
+}
+
+func indentJSON(message json.RawMessage) string {
+	var value interface{}
+	if err := decodeJSONWithNumbers(message, &value); err != nil {
+		return string(message)
+	}
+	indented, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return string(message)
+	}
+	return string(indented)
+}
+
+// mismatch records an unexpected request, reports it to T if configured and returns an error response for the request.
+// It must be called with the mutex held.
+func (t *MockSocket) mismatch(message []byte, err error) json.RawMessage {
+	t.mismatches = append(t.mismatches, err)
+	if t.options.T != nil {
+		t.options.T.Helper()
+		t.options.T.Errorf("%s", err)
+	}
+	frame := &mocksocketFrame{}
+	json.Unmarshal(message, frame)
+	response, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      frame.ID,
+		"error":   &qixError{ErrorCode: -32600, ErrorParameter: frame.Method, ErrorMessage: err.Error()},
+	})
+	return response
+}
+
+// Mismatches returns the errors for all sent messages that did not match an expected request
+func (t *MockSocket) Mismatches() []error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]error(nil), t.mismatches...)
+}
+
+// AssertAllExpectationsMet reports a failure for every expected request that has not been sent and, unless they have
+// already been reported to the T in the options, for every sent message that did not match. It returns true if there were none.
+func (t *MockSocket) AssertAllExpectationsMet(tt TestingT) bool {
+	tt.Helper()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.options.T == nil {
+		for _, err := range t.mismatches {
+			tt.Errorf("%s", err)
+		}
+	}
+	for _, request := range t.expectedRequests {
+		tt.Errorf("mock socket: expected request was never sent:\n%s", indentJSON(request.sentMessage))
+	}
+	return len(t.mismatches) == 0 && len(t.expectedRequests) == 0
 }
 
 // ExpectCall sets a response message given a request message.
 func (t *MockSocket) ExpectCall(request string, response string) {
-	t.expectedRequests <- &mocksocketRequest{sentMessage: json.RawMessage(request), responses: []json.RawMessage{json.RawMessage(response)}}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.expectedRequests = append(t.expectedRequests, newMocksocketRequest(json.RawMessage(request), time.Time{}, mocksocketResponse{message: json.RawMessage(response)}))
 }
 
 // AddReceivedMessage adds a message to the received message queue immediately
 func (t *MockSocket) AddReceivedMessage(response string) {
-	t.receivedMessages <- json.RawMessage(response)
+	t.deliver(json.RawMessage(response))
+}
+
+func (t *MockSocket) deliver(message json.RawMessage) {
+	select {
+	case t.receivedMessages <- message:
+	case <-t.closed:
+	}
 }
 
 // WriteMessage implements the Socket interface
 func (t *MockSocket) WriteMessage(messageType int, message []byte) error {
-	select {
-	case expectedMessage := <-t.expectedRequests:
-		if asCanonicalString(expectedMessage.sentMessage) == asCanonicalString(message) {
-			// Transfer the response into the received messages channel
-			for _, response := range expectedMessage.responses {
-				t.receivedMessages <- response
-			}
+	t.mutex.Lock()
+	if len(t.expectedRequests) == 0 {
+		response := t.mismatch(message, fmt.Errorf("mock socket: unexpected request, no more requests expected:\n%s", indentJSON(message)))
+		t.mutex.Unlock()
+		t.deliver(response)
+		return nil
+	}
+	window := t.options.Window
+	if window < 1 {
+		window = 1
+	}
+	if window > len(t.expectedRequests) {
+		window = len(t.expectedRequests)
+	}
+	index := -1
+	for i := 0; i < window; i++ {
+		matched, err := t.matches(t.expectedRequests[i].sentMessage, message)
+		if err != nil {
+			response := t.mismatch(message, err)
+			t.mutex.Unlock()
+			t.deliver(response)
+			return nil
+		}
+		if matched {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		response := t.mismatch(message, fmt.Errorf("mock socket: unexpected request (-expected +actual):\n%s", jsonDiff(t.expectedRequests[0].sentMessage, message)))
+		t.mutex.Unlock()
+		t.deliver(response)
+		return nil
+	}
+	expectedMessage := t.expectedRequests[index]
+	t.expectedRequests = append(t.expectedRequests[:index:index], t.expectedRequests[index+1:]...)
+	if t.options.IgnoreIDs && expectedMessage.id != 0 {
+		frame := &mocksocketFrame{}
+		json.Unmarshal(message, frame)
+		t.idMap[expectedMessage.id] = frame.ID
+	}
+	responses := make([]json.RawMessage, 0, len(expectedMessage.responses))
+	for _, response := range expectedMessage.responses {
+		message := response.message
+		if t.options.IgnoreIDs {
+			message = t.rewriteResponseID(message)
+		}
+		if t.simulatesTiming() {
+			t.schedule(message, t.recordedDelay(expectedMessage, response))
 		} else {
-			fmt.Println("Unexpected response", asCanonicalString(message))
-			fmt.Println("Expected ", string(expectedMessage.sentMessage))
+			responses = append(responses, message)
 		}
-	default:
-		fmt.Println("No more responses registered, expecting", string(message))
 	}
+	t.mutex.Unlock()
 
+	// Transfer the responses into the received messages channel
+	for _, response := range responses {
+		t.deliver(response)
+	}
 	return nil
 }
 
+func (t *MockSocket) simulatesTiming() bool {
+	return t.options.TimeScale > 0 || t.options.Latency > 0 || t.options.Bandwidth > 0
+}
+
+func (t *MockSocket) recordedDelay(request *mocksocketRequest, response mocksocketResponse) time.Duration {
+	if t.options.TimeScale <= 0 || request.sentAt.IsZero() || response.receivedAt.IsZero() {
+		return 0
+	}
+	return time.Duration(float64(response.receivedAt.Sub(request.sentAt)) * t.options.TimeScale)
+}
+
+// schedule queues a message for delivery after the recorded delay, the latency and the transfer time given by the bandwidth.
+// It must be called with the mutex held.
+func (t *MockSocket) schedule(message json.RawMessage, recordedDelay time.Duration) {
+	due := time.Now().Add(recordedDelay + t.options.Latency)
+	if t.options.Bandwidth > 0 {
+		if t.linkFreeAt.After(due) {
+			due = t.linkFreeAt
+		}
+		due = due.Add(time.Duration(len(message)) * time.Second / time.Duration(t.options.Bandwidth))
+		t.linkFreeAt = due
+	}
+	t.scheduleSeq++
+	scheduled := &mocksocketScheduledMessage{due: due, seq: t.scheduleSeq, message: message}
+	// Keep the queue sorted by due time, messages due at the same time keep their order
+	index := len(t.scheduled)
+	for index > 0 && t.scheduled[index-1].due.After(due) {
+		index--
+	}
+	t.scheduled = append(t.scheduled, nil)
+	copy(t.scheduled[index+1:], t.scheduled[index:])
+	t.scheduled[index] = scheduled
+	if t.wakeup == nil {
+		t.wakeup = make(chan struct{}, 1)
+		go t.deliverScheduled()
+	}
+	select {
+	case t.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// deliverScheduled delivers the scheduled messages when they are due until the socket is closed
+func (t *MockSocket) deliverScheduled() {
+	for {
+		t.mutex.Lock()
+		var timer <-chan time.Time
+		if len(t.scheduled) > 0 {
+			next := t.scheduled[0]
+			if wait := time.Until(next.due); wait > 0 {
+				timer = time.After(wait)
+			} else {
+				t.scheduled = t.scheduled[1:]
+				t.mutex.Unlock()
+				t.deliver(next.message)
+				continue
+			}
+		}
+		t.mutex.Unlock()
+		select {
+		case <-timer:
+		case <-t.wakeup:
+		case <-t.closed:
+			return
+		}
+	}
+}
+
 // ReadMessage implements the Socket interface
 func (t *MockSocket) ReadMessage() (int, []byte, error) {
-	message, isOpen := <-t.receivedMessages
-	if !isOpen {
+	select {
+	case message := <-t.receivedMessages:
+		return 1, message, nil
+	case <-t.closed:
 		return 0, nil, errors.New("socket closed by test case")
 	}
-	return 1, message, nil
 }
 
 // Close implements the Socket interface
 func (t *MockSocket) Close() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 	select {
 	case <-t.closed:
 		// Do nothing
 	default:
 		close(t.closed)
-		close(t.expectedRequests)
-		close(t.receivedMessages)
 	}
 	return nil
 }
 
-// NewMockSocket creates a new MockSocket instance
+func newMocksocketRequest(sentMessage json.RawMessage, sentAt time.Time, responses ...mocksocketResponse) *mocksocketRequest {
+	frame := &mocksocketFrame{}
+	json.Unmarshal(sentMessage, frame)
+	return &mocksocketRequest{sentMessage: sentMessage, sentAt: sentAt, id: frame.ID, responses: responses}
+}
+
+// NewMockSocket creates a new MockSocket instance. If a file name is supplied the traffic recorded in it is replayed.
+// Both the JSON array format and the JSON Lines format, optionally gzip compressed, are supported.
 func NewMockSocket(fileName string) (*MockSocket, error) {
-	socket := &MockSocket{receivedMessages: make(chan json.RawMessage, 100), expectedRequests: make(chan *mocksocketRequest, 10000), closed: make(chan struct{})}
+	return NewMockSocketWithOptions(fileName, MockSocketOptions{})
+}
+
+// NewMockSocketWithOptions creates a new MockSocket instance that matches requests according to the options.
+// If a file name is supplied the traffic recorded in it is replayed. Recorded responses are returned when the
+// request with the same id is matched, other received messages when the request preceding them is matched.
+func NewMockSocketWithOptions(fileName string, options MockSocketOptions) (*MockSocket, error) {
+	socket := &MockSocket{options: options, idMap: make(map[int]int), receivedMessages: make(chan json.RawMessage, 100), closed: make(chan struct{})}
+	for _, p := range options.IgnoreParamPaths {
+		parsed, err := parseRedactionPath(p)
+		if err != nil {
+			return nil, err
+		}
+		socket.ignoredPaths = append(socket.ignoredPaths, parsed)
+	}
 	if fileName != "" {
 		var lastRequest *mocksocketRequest
-		messages := readTrafficLog(fileName)
+		awaitingResponse := make(map[int]*mocksocketRequest)
+		messages, err := readTrafficLog(fileName)
+		if err != nil {
+			return nil, err
+		}
 		for _, m := range messages {
 			if m.Sent != nil {
-				lastRequest = &mocksocketRequest{sentMessage: m.Sent}
-				socket.expectedRequests <- lastRequest
+				lastRequest = newMocksocketRequest(m.Sent, m.Timestamp)
+				socket.expectedRequests = append(socket.expectedRequests, lastRequest)
+				if lastRequest.id != 0 {
+					awaitingResponse[lastRequest.id] = lastRequest
+				}
 			} else if m.Received != nil {
-				if lastRequest == nil {
+				frame := &mocksocketFrame{}
+				json.Unmarshal(m.Received, frame)
+				response := mocksocketResponse{message: m.Received, receivedAt: m.Timestamp}
+				if request := awaitingResponse[frame.ID]; frame.Method == "" && request != nil {
+					// A response is returned together with its request
+					delete(awaitingResponse, frame.ID)
+					request.responses = append(request.responses, response)
+				} else if lastRequest == nil {
 					// When no request have been sent then push the received messages straight to the receivedMessage channel
 					socket.receivedMessages <- m.Received
 				} else {
 					// When a request has been sent then add the responses to that request
-					lastRequest.responses = append(lastRequest.responses, m.Received)
+					lastRequest.responses = append(lastRequest.responses, response)
 				}
-
 			}
 		}
 	}
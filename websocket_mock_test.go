@@ -0,0 +1,194 @@
+package enigma
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeMockSocketTestLog(t *testing.T) string {
+	fileName := filepath.Join(t.TempDir(), "mock.traffic")
+	os.WriteFile(fileName, []byte(`[
+	{"Sent": {"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":1,"params":[]}},
+	{"Sent": {"jsonrpc":"2.0","method":"GetProperties","handle":1,"id":2,"params":[]}},
+	{"Received": {"jsonrpc":"2.0","id":1,"result":{"qLayout":{}}}},
+	{"Received": {"jsonrpc":"2.0","id":2,"result":{"qProp":{}}}},
+	{"Sent": {"jsonrpc":"2.0","method":"CreateObject","handle":1,"id":3,"params":[{"qInfo":{"qId":"abc","qType":"chart"}}]}},
+	{"Received": {"jsonrpc":"2.0","id":3,"result":{"qReturn":{"qHandle":2}}}}
+]`), 0644)
+	return fileName
+}
+
+func readMockSocketMessage(t *testing.T, socket *MockSocket) string {
+	_, message, err := socket.ReadMessage()
+	assert.NoError(t, err)
+	return string(message)
+}
+
+func TestMockSocketReturnsResponsesWithTheirRequests(t *testing.T) {
+	socket, err := NewMockSocket(writeMockSocketTestLog(t))
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":1,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"qLayout":{}}}`, readMockSocketMessage(t, socket))
+}
+
+func TestMockSocketRemapsIDs(t *testing.T) {
+	socket, err := NewMockSocketWithOptions(writeMockSocketTestLog(t), MockSocketOptions{IgnoreIDs: true})
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":11,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":11,"result":{"qLayout":{}}}`, readMockSocketMessage(t, socket))
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetProperties","handle":1,"id":-5,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":-5,"result":{"qProp":{}}}`, readMockSocketMessage(t, socket))
+}
+
+func TestMockSocketOutOfOrderWindow(t *testing.T) {
+	socket, err := NewMockSocketWithOptions(writeMockSocketTestLog(t), MockSocketOptions{Window: 2})
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetProperties","handle":1,"id":2,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":2,"result":{"qProp":{}}}`, readMockSocketMessage(t, socket))
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":1,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{"qLayout":{}}}`, readMockSocketMessage(t, socket))
+}
+
+func TestMockSocketIgnoresParamPaths(t *testing.T) {
+	socket, err := NewMockSocketWithOptions(writeMockSocketTestLog(t), MockSocketOptions{Window: 3, IgnoreParamPaths: []string{"$[0].qInfo.qId"}})
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"CreateObject","handle":1,"id":3,"params":[{"qInfo":{"qId":"random","qType":"chart"}}]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":3,"result":{"qReturn":{"qHandle":2}}}`, readMockSocketMessage(t, socket))
+
+	// Other params are still compared
+	matched, err := socket.matches([]byte(`{"params":[{"qInfo":{"qId":"a","qType":"chart"}}]}`), []byte(`{"params":[{"qInfo":{"qId":"b","qType":"table"}}]}`))
+	assert.NoError(t, err)
+	assert.False(t, matched)
+}
+
+func TestMockSocketInvalidIgnoreParamPath(t *testing.T) {
+	_, err := NewMockSocketWithOptions("", MockSocketOptions{IgnoreParamPaths: []string{"qId"}})
+	assert.Error(t, err)
+}
+
+type recordingTestingT struct {
+	errors []string
+}
+
+func (r *recordingTestingT) Helper() {}
+
+func (r *recordingTestingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestMockSocketMismatchInjectsError(t *testing.T) {
+	recorder := &recordingTestingT{}
+	socket, err := NewMockSocketWithOptions("", MockSocketOptions{T: recorder})
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.ExpectCall(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":1,"params":[]}`, `{"jsonrpc":"2.0","id":1,"result":{}}`)
+
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":2,"id":1,"params":[]}`))
+	response := &socketInput{}
+	assert.NoError(t, json.Unmarshal([]byte(readMockSocketMessage(t, socket)), response))
+	assert.Equal(t, 1, response.ID)
+	assert.Equal(t, -32600, response.Error.Code())
+	assert.Equal(t, "GetLayout", response.Error.Parameter())
+	assert.Len(t, recorder.errors, 1)
+	assert.Contains(t, recorder.errors[0], "-   \"handle\": 1,\n+   \"handle\": 2,\n")
+	assert.Len(t, socket.Mismatches(), 1)
+
+	// The expected request is still pending after a mismatch
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":2,"params":[]}`))
+	assert.Contains(t, readMockSocketMessage(t, socket), `"code":-32600`)
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetLayout","handle":1,"id":1,"params":[]}`))
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{}}`, readMockSocketMessage(t, socket))
+
+	socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"GetObject","handle":1,"id":3,"params":[]}`))
+	assert.Contains(t, readMockSocketMessage(t, socket), "no more requests expected")
+	assert.Len(t, recorder.errors, 3)
+}
+
+func TestMockSocketInvalidJSONIsAMismatch(t *testing.T) {
+	_, err := requestMatches([]byte(`{"method":`), []byte(`{"method":`))
+	assert.Error(t, err)
+
+	recorder := &recordingTestingT{}
+	socket, err := NewMockSocketWithOptions("", MockSocketOptions{T: recorder})
+	assert.NoError(t, err)
+	defer socket.Close()
+	socket.ExpectCall(`{"method":`, `{"id":1,"result":{}}`)
+	socket.WriteMessage(1, []byte(`{"method":`))
+	assert.Contains(t, readMockSocketMessage(t, socket), `"code":-32600`)
+	assert.Len(t, recorder.errors, 1)
+	assert.Contains(t, recorder.errors[0], "expected request is not valid JSON")
+	assert.Len(t, socket.Mismatches(), 1)
+}
+
+func TestMockSocketAssertAllExpectationsMet(t *testing.T) {
+	socket, _ := NewMockSocket("")
+	defer socket.Close()
+	socket.ExpectCall(`{"method":"GetLayout","id":1}`, `{"id":1,"result":{}}`)
+	socket.ExpectCall(`{"method":"GetProperties","id":2}`, `{"id":2,"result":{}}`)
+	socket.WriteMessage(1, []byte(`{"method":"GetLayout","id":1}`))
+	socket.WriteMessage(1, []byte(`{"method":"Bad","id":2}`))
+
+	recorder := &recordingTestingT{}
+	assert.False(t, socket.AssertAllExpectationsMet(recorder))
+	assert.Len(t, recorder.errors, 2)
+	assert.Contains(t, recorder.errors[0], `+   "method": "Bad"`)
+	assert.Contains(t, recorder.errors[1], "expected request was never sent")
+
+	recorder = &recordingTestingT{}
+	socket, _ = NewMockSocket("")
+	defer socket.Close()
+	assert.True(t, socket.AssertAllExpectationsMet(recorder))
+	assert.Empty(t, recorder.errors)
+}
+
+func TestMockSocketSyntheticLatencyAndBandwidth(t *testing.T) {
+	socket, _ := NewMockSocketWithOptions("", MockSocketOptions{Latency: 30 * time.Millisecond})
+	defer socket.Close()
+	socket.ExpectCall(`{"method":"GetLayout","id":1}`, `{"id":1,"result":{}}`)
+	start := time.Now()
+	socket.WriteMessage(1, []byte(`{"method":"GetLayout","id":1}`))
+	readMockSocketMessage(t, socket)
+	assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+
+	// 100 bytes per second makes each 20 byte response take 200ms and the second one waits for the first
+	socket, _ = NewMockSocketWithOptions("", MockSocketOptions{Bandwidth: 100})
+	defer socket.Close()
+	socket.ExpectCall(`{"method":"GetLayout","id":1}`, `{"id":1,"result":{}}`)
+	socket.ExpectCall(`{"method":"GetLayout","id":2}`, `{"id":2,"result":{}}`)
+	start = time.Now()
+	socket.WriteMessage(1, []byte(`{"method":"GetLayout","id":1}`))
+	socket.WriteMessage(1, []byte(`{"method":"GetLayout","id":2}`))
+	readMockSocketMessage(t, socket)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	readMockSocketMessage(t, socket)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestMockSocketRecordedTiming(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "timed.jsonl")
+	os.WriteFile(fileName, []byte(`{"Sent":{"method":"Slow","id":1},"Timestamp":"2024-01-01T00:00:00Z"}
+{"Sent":{"method":"Fast","id":2},"Timestamp":"2024-01-01T00:00:00Z"}
+{"Received":{"id":2,"result":{}},"Timestamp":"2024-01-01T00:00:00.1Z"}
+{"Received":{"id":1,"result":{}},"Timestamp":"2024-01-01T00:00:00.4Z"}
+`), 0644)
+	socket, err := NewMockSocketWithOptions(fileName, MockSocketOptions{TimeScale: 0.5, Window: 2})
+	assert.NoError(t, err)
+	defer socket.Close()
+	start := time.Now()
+	socket.WriteMessage(1, []byte(`{"method":"Slow","id":1}`))
+	socket.WriteMessage(1, []byte(`{"method":"Fast","id":2}`))
+	assert.JSONEq(t, `{"id":2,"result":{}}`, readMockSocketMessage(t, socket))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.JSONEq(t, `{"id":1,"result":{}}`, readMockSocketMessage(t, socket))
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
@@ -1,15 +1,30 @@
 package enigma
 
 import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 )
 
 type (
 	trafficLogRow struct {
 		Sent     json.RawMessage `json:"Sent,omitempty"`
 		Received json.RawMessage `json:"Received,omitempty"`
+		// The fields below are only present in the JSON Lines format
+		Direction string    `json:"Direction,omitempty"`
+		Timestamp time.Time `json:"Timestamp,omitzero"`
+		SessionID string    `json:"SessionID,omitempty"`
+		Size      int       `json:"Size,omitempty"`
 	}
 
 	fileTrafficLog struct {
@@ -17,6 +32,35 @@ type (
 		Messages []trafficLogRow
 		mutex    sync.Mutex
 	}
+
+	// StreamingTrafficLogOptions configures a StreamingTrafficLogger
+	StreamingTrafficLogOptions struct {
+		// FileName is the path of the log file
+		FileName string
+		// SessionID is written on every line to tell sessions apart. A random id is used if empty.
+		SessionID string
+		// MaxSize rotates the log file once it has grown beyond MaxSize bytes. Zero disables size based rotation.
+		MaxSize int64
+		// MaxAge rotates the log file once it is older than MaxAge. Zero disables time based rotation.
+		MaxAge time.Duration
+		// Gzip compresses the log files
+		Gzip bool
+	}
+
+	// StreamingTrafficLogger is a TrafficLogger that writes one JSON object per line and frame as soon as the frame is sent or received.
+	// Each line contains the message under either the Sent or the Received key together with the direction, a timestamp, the session id and
+	// the size of the message in bytes. Rotated files are renamed with a timestamp inserted before the file extension.
+	StreamingTrafficLogger struct {
+		options    StreamingTrafficLogOptions
+		mutex      sync.Mutex
+		file       *os.File
+		gzipWriter *gzip.Writer
+		writer     io.Writer
+		size       int64
+		openedAt   time.Time
+		closed     bool
+		err        error
+	}
 )
 
 // Opened implements the TrafficLogger interface
@@ -48,9 +92,173 @@ func newFileTrafficLogger(filename string) *fileTrafficLog {
 	return &fileTrafficLog{FileName: filename, Messages: make([]trafficLogRow, 0, 1000)}
 }
 
-func readTrafficLog(fileName string) []trafficLogRow {
-	file, _ := ioutil.ReadFile(fileName)
+// NewStreamingTrafficLogger creates a StreamingTrafficLogger and opens the log file
+func NewStreamingTrafficLogger(options StreamingTrafficLogOptions) (*StreamingTrafficLogger, error) {
+	if options.SessionID == "" {
+		id := make([]byte, 8)
+		rand.Read(id)
+		options.SessionID = hex.EncodeToString(id)
+	}
+	t := &StreamingTrafficLogger{options: options}
+	if err := t.open(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// isStreamingTrafficLogFile tells if a traffic dump file name asks for the JSON Lines format
+func isStreamingTrafficLogFile(fileName string) bool {
+	return strings.HasSuffix(fileName, ".jsonl") || strings.HasSuffix(fileName, ".jsonl.gz")
+}
+
+func (t *StreamingTrafficLogger) open() error {
+	file, err := os.OpenFile(t.options.FileName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	t.file = file
+	t.writer = file
+	if t.options.Gzip {
+		t.gzipWriter = gzip.NewWriter(file)
+		t.writer = t.gzipWriter
+	}
+	t.size = 0
+	t.openedAt = time.Now()
+	return nil
+}
+
+func (t *StreamingTrafficLogger) closeFile() error {
+	var err error
+	if t.gzipWriter != nil {
+		err = t.gzipWriter.Close()
+		t.gzipWriter = nil
+	}
+	if closeErr := t.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// rotatedFileName inserts a timestamp before the file extension, for instance traffic.jsonl.gz becomes traffic.20060102T150405.000.jsonl.gz
+func (t *StreamingTrafficLogger) rotatedFileName(now time.Time) string {
+	dir, base := filepath.Split(t.options.FileName)
+	name, extension := base, ""
+	if index := strings.Index(base, "."); index > 0 {
+		name, extension = base[:index], base[index:]
+	}
+	return filepath.Join(dir, name+"."+now.Format("20060102T150405.000")+extension)
+}
+
+func (t *StreamingTrafficLogger) rotateIfNeeded(now time.Time) error {
+	if !(t.options.MaxSize > 0 && t.size >= t.options.MaxSize) && !(t.options.MaxAge > 0 && now.Sub(t.openedAt) >= t.options.MaxAge) {
+		return nil
+	}
+	if err := t.closeFile(); err != nil {
+		return err
+	}
+	if err := os.Rename(t.options.FileName, t.rotatedFileName(now)); err != nil {
+		return err
+	}
+	return t.open()
+}
+
+func (t *StreamingTrafficLogger) write(row trafficLogRow) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.closed || t.err != nil {
+		return
+	}
+	row.Timestamp = time.Now()
+	row.SessionID = t.options.SessionID
+	line, err := json.Marshal(row)
+	if err != nil {
+		t.err = err
+		return
+	}
+	if t.err = t.rotateIfNeeded(row.Timestamp); t.err != nil {
+		return
+	}
+	line = append(line, '\n')
+	if _, t.err = t.writer.Write(line); t.err != nil {
+		return
+	}
+	if t.gzipWriter != nil {
+		// Flush every line so that a crash loses as little as possible
+		t.err = t.gzipWriter.Flush()
+	}
+	t.size += int64(len(line))
+}
+
+// Opened implements the TrafficLogger interface
+func (t *StreamingTrafficLogger) Opened() {
+
+}
+
+// Sent implements the TrafficLogger interface
+func (t *StreamingTrafficLogger) Sent(message []byte) {
+	t.write(trafficLogRow{Sent: message, Direction: "sent", Size: len(message)})
+}
+
+// Received implements the TrafficLogger interface
+func (t *StreamingTrafficLogger) Received(message []byte) {
+	t.write(trafficLogRow{Received: message, Direction: "received", Size: len(message)})
+}
+
+// Closed implements the TrafficLogger interface
+func (t *StreamingTrafficLogger) Closed() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.closed {
+		return
+	}
+	t.closed = true
+	if err := t.closeFile(); t.err == nil {
+		t.err = err
+	}
+}
+
+// Err returns the first error that occurred while writing the log, if any
+func (t *StreamingTrafficLogger) Err() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.err
+}
+
+// readTrafficLog reads a traffic log in either the JSON array format or the JSON Lines format, optionally gzip compressed
+func readTrafficLog(fileName string) ([]trafficLogRow, error) {
+	file, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if len(file) > 2 && file[0] == 0x1f && file[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(file))
+		if err != nil {
+			return nil, err
+		}
+		// A log from a crashed process lacks the gzip footer so read as much as possible
+		file, err = ioutil.ReadAll(reader)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+	}
 	result := []trafficLogRow{}
-	_ = json.Unmarshal(file, &result)
-	return result
+	file = bytes.TrimSpace(file)
+	if len(file) > 0 && file[0] == '[' {
+		err := json.Unmarshal(file, &result)
+		return result, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	scanner.Buffer(nil, len(file)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		row := trafficLogRow{}
+		if err := json.Unmarshal(line, &row); err != nil {
+			return result, err
+		}
+		result = append(result, row)
+	}
+	return result, scanner.Err()
 }
@@ -0,0 +1,87 @@
+package enigma
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamingTrafficLogger(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "traffic.jsonl")
+	logger, err := NewStreamingTrafficLogger(StreamingTrafficLogOptions{FileName: fileName, SessionID: "session1"})
+	assert.NoError(t, err)
+	logger.Opened()
+	logger.Sent([]byte(`{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":["app"]}`))
+
+	// Every frame is on disk as soon as it has been logged
+	rows, err := readTrafficLog(fileName)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+
+	logger.Received([]byte(`{"jsonrpc":"2.0","id":1,"result":{}}`))
+	logger.Closed()
+	assert.NoError(t, logger.Err())
+
+	rows, err = readTrafficLog(fileName)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "sent", rows[0].Direction)
+	assert.Equal(t, "session1", rows[0].SessionID)
+	assert.Equal(t, 72, rows[0].Size)
+	assert.False(t, rows[0].Timestamp.IsZero())
+	assert.Equal(t, "received", rows[1].Direction)
+	assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{}}`, string(rows[1].Received))
+}
+
+func TestStreamingTrafficLoggerRotation(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "traffic.jsonl.gz")
+	logger, err := NewStreamingTrafficLogger(StreamingTrafficLogOptions{FileName: fileName, MaxSize: 10, Gzip: true})
+	assert.NoError(t, err)
+	logger.Sent([]byte(`{"id":1}`))
+	logger.Received([]byte(`{"id":1}`))
+	logger.Closed()
+	assert.NoError(t, logger.Err())
+
+	files, _ := filepath.Glob(filepath.Join(dir, "traffic.*.jsonl.gz"))
+	assert.Len(t, files, 1)
+	rotatedRows, err := readTrafficLog(files[0])
+	assert.NoError(t, err)
+	assert.Len(t, rotatedRows, 1)
+	assert.NotNil(t, rotatedRows[0].Sent)
+	rows, err := readTrafficLog(fileName)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.NotNil(t, rows[0].Received)
+}
+
+func TestMockSocketReplaysBothFormats(t *testing.T) {
+	dir := t.TempDir()
+	arrayFileName := filepath.Join(dir, "array.traffic")
+	os.WriteFile(arrayFileName, []byte(`[
+	{"Received": {"jsonrpc":"2.0","method":"OnConnected","params":{}}},
+	{"Sent": {"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":[]}},
+	{"Received": {"jsonrpc":"2.0","id":1,"result":{}}}
+]`), 0644)
+	linesFileName := filepath.Join(dir, "lines.jsonl")
+	os.WriteFile(linesFileName, []byte(`{"Received":{"jsonrpc":"2.0","method":"OnConnected","params":{}},"Direction":"received"}
+{"Sent":{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":[]},"Direction":"sent"}
+{"Received":{"jsonrpc":"2.0","id":1,"result":{}},"Direction":"received"}
+`), 0644)
+
+	for _, fileName := range []string{arrayFileName, linesFileName} {
+		socket, err := NewMockSocket(fileName)
+		assert.NoError(t, err)
+		_, message, _ := socket.ReadMessage()
+		assert.Contains(t, string(message), "OnConnected")
+		socket.WriteMessage(1, []byte(`{"jsonrpc":"2.0","method":"OpenDoc","handle":-1,"id":1,"params":[]}`))
+		_, message, _ = socket.ReadMessage()
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{}}`, string(message))
+		socket.Close()
+	}
+
+	_, err := NewMockSocket(filepath.Join(dir, "missing.traffic"))
+	assert.Error(t, err)
+}